@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cmsgov/easi-app/pkg/server"
+)
+
+var cedarCmd = &cobra.Command{
+	Use:   "cedar",
+	Short: "Manage EASi data in CEDAR",
+	Long:  `Manage EASi data in CEDAR`,
+}
+
+var cedarReconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Reconcile system intakes with CEDAR",
+	Long: `Submit submitted system intakes that have no alfabet ID to CEDAR,
+and compare the statuses of linked intakes against CEDAR.
+Prints a JSON report of what was found.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := viper.New()
+		config.AutomaticEnv()
+		server.ReconcileCedar(config, server.ReconcileCedarOptions{
+			DryRun:            dryRun,
+			RequestsPerSecond: requestsPerSecond,
+		})
+	},
+}
+
+var cedarGatewayCmd = &cobra.Command{
+	Use:   "gateway",
+	Short: "Serve a fake CEDAR API gateway",
+	Long: `Serve an in-memory stand-in for the CEDAR EASi and LDAP APIs.
+Point CEDAR_API_URL at it (e.g. http://localhost:8030) and set
+CEDAR_LOCAL_GATEWAY=true to use the real CEDAR clients locally.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := viper.New()
+		config.AutomaticEnv()
+		server.ServeCEDARGateway(config, gatewayAddress)
+	},
+}
+
+var dryRun bool
+var requestsPerSecond float64
+var gatewayAddress string
+
+func init() {
+	cedarReconcileCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only report what would be changed")
+	cedarReconcileCmd.Flags().Float64Var(&requestsPerSecond, "rate", 2, "Maximum requests per second to CEDAR")
+	cedarCmd.AddCommand(cedarReconcileCmd)
+	cedarGatewayCmd.Flags().StringVar(&gatewayAddress, "address", "localhost:8030", "Address to serve the gateway on")
+	cedarCmd.AddCommand(cedarGatewayCmd)
+}
@@ -25,6 +25,7 @@ func execute() {
 func init() {
 	rootCmd.AddCommand(serveCmd)
 	rootCmd.AddCommand(testCmd)
+	rootCmd.AddCommand(cedarCmd)
 }
 
 func main() {
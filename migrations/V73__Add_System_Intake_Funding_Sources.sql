@@ -0,0 +1,32 @@
+CREATE TABLE system_intake_funding_sources (
+    id uuid PRIMARY KEY NOT NULL,
+    system_intake_id uuid NOT NULL REFERENCES system_intakes(id),
+    source text,
+    funding_number text CHECK (funding_number ~ '^[0-9]{6}$'),
+    amount int CHECK (amount >= 0),
+    fiscal_year int CHECK (fiscal_year >= 1000 AND fiscal_year <= 9999),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX system_intake_funding_sources_intake_idx ON system_intake_funding_sources (system_intake_id);
+
+INSERT INTO system_intake_funding_sources (
+    id,
+    system_intake_id,
+    source,
+    funding_number,
+    created_at,
+    updated_at
+)
+SELECT
+    md5(random()::text || system_intakes.id::text)::uuid,
+    system_intakes.id,
+    system_intakes.funding_source,
+    system_intakes.funding_number,
+    coalesce(system_intakes.updated_at, current_timestamp),
+    coalesce(system_intakes.updated_at, current_timestamp)
+FROM system_intakes
+WHERE system_intakes.existing_funding IS TRUE
+    AND (system_intakes.funding_number IS NULL OR system_intakes.funding_number ~ '^[0-9]{6}$')
+    AND (system_intakes.funding_number IS NOT NULL OR system_intakes.funding_source IS NOT NULL);
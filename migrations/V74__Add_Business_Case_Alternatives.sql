@@ -0,0 +1,143 @@
+CREATE TYPE business_case_alternative_role AS ENUM ('AS_IS', 'PREFERRED', 'OTHER');
+
+CREATE TABLE business_case_alternatives (
+    id uuid PRIMARY KEY NOT NULL,
+    business_case_id uuid NOT NULL REFERENCES business_cases(id),
+    position int NOT NULL CHECK (position >= 0),
+    role business_case_alternative_role NOT NULL,
+    title text,
+    summary text,
+    acquisition_approach text,
+    security_is_approved boolean,
+    security_is_being_reviewed text,
+    hosting_type text,
+    hosting_location text,
+    hosting_cloud_service_type text,
+    has_ui text,
+    pros text,
+    cons text,
+    cost_savings text,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT business_case_alternatives_position_unique UNIQUE (business_case_id, position)
+);
+
+ALTER TABLE estimated_lifecycle_costs ADD COLUMN alternative_id uuid REFERENCES business_case_alternatives(id) ON DELETE CASCADE;
+ALTER TABLE estimated_lifecycle_costs ALTER solution DROP NOT NULL;
+
+INSERT INTO business_case_alternatives (
+    id, business_case_id, position, role, title, summary, pros, cons, cost_savings, created_at, updated_at
+)
+SELECT
+    md5(random()::text || business_cases.id::text)::uuid,
+    business_cases.id,
+    0,
+    'AS_IS',
+    as_is_title,
+    as_is_summary,
+    as_is_pros,
+    as_is_cons,
+    as_is_cost_savings,
+    coalesce(business_cases.created_at, current_timestamp),
+    coalesce(business_cases.updated_at, current_timestamp)
+FROM business_cases;
+
+INSERT INTO business_case_alternatives (
+    id, business_case_id, position, role, title, summary, acquisition_approach, security_is_approved,
+    security_is_being_reviewed, hosting_type, hosting_location, hosting_cloud_service_type, has_ui,
+    pros, cons, cost_savings, created_at, updated_at
+)
+SELECT
+    md5(random()::text || business_cases.id::text)::uuid,
+    business_cases.id,
+    1,
+    'PREFERRED',
+    preferred_title,
+    preferred_summary,
+    preferred_acquisition_approach,
+    preferred_security_is_approved,
+    preferred_security_is_being_reviewed,
+    preferred_hosting_type,
+    preferred_hosting_location,
+    preferred_hosting_cloud_service_type,
+    preferred_has_ui,
+    preferred_pros,
+    preferred_cons,
+    preferred_cost_savings,
+    coalesce(business_cases.created_at, current_timestamp),
+    coalesce(business_cases.updated_at, current_timestamp)
+FROM business_cases;
+
+INSERT INTO business_case_alternatives (
+    id, business_case_id, position, role, title, summary, acquisition_approach, security_is_approved,
+    security_is_being_reviewed, hosting_type, hosting_location, hosting_cloud_service_type, has_ui,
+    pros, cons, cost_savings, created_at, updated_at
+)
+SELECT
+    md5(random()::text || business_cases.id::text)::uuid,
+    business_cases.id,
+    2,
+    'OTHER',
+    alternative_a_title,
+    alternative_a_summary,
+    alternative_a_acquisition_approach,
+    alternative_a_security_is_approved,
+    alternative_a_security_is_being_reviewed,
+    alternative_a_hosting_type,
+    alternative_a_hosting_location,
+    alternative_a_hosting_cloud_service_type,
+    alternative_a_has_ui,
+    alternative_a_pros,
+    alternative_a_cons,
+    alternative_a_cost_savings,
+    coalesce(business_cases.created_at, current_timestamp),
+    coalesce(business_cases.updated_at, current_timestamp)
+FROM business_cases;
+
+INSERT INTO business_case_alternatives (
+    id, business_case_id, position, role, title, summary, acquisition_approach, security_is_approved,
+    security_is_being_reviewed, hosting_type, hosting_location, hosting_cloud_service_type, has_ui,
+    pros, cons, cost_savings, created_at, updated_at
+)
+SELECT
+    md5(random()::text || business_cases.id::text)::uuid,
+    business_cases.id,
+    3,
+    'OTHER',
+    alternative_b_title,
+    alternative_b_summary,
+    alternative_b_acquisition_approach,
+    alternative_b_security_is_approved,
+    alternative_b_security_is_being_reviewed,
+    alternative_b_hosting_type,
+    alternative_b_hosting_location,
+    alternative_b_hosting_cloud_service_type,
+    alternative_b_has_ui,
+    alternative_b_pros,
+    alternative_b_cons,
+    alternative_b_cost_savings,
+    coalesce(business_cases.created_at, current_timestamp),
+    coalesce(business_cases.updated_at, current_timestamp)
+FROM business_cases
+WHERE alternative_b_title IS NOT NULL
+    OR alternative_b_summary IS NOT NULL
+    OR alternative_b_acquisition_approach IS NOT NULL
+    OR alternative_b_pros IS NOT NULL
+    OR alternative_b_cons IS NOT NULL
+    OR alternative_b_cost_savings IS NOT NULL
+    OR EXISTS (
+        SELECT 1 FROM estimated_lifecycle_costs
+        WHERE estimated_lifecycle_costs.business_case = business_cases.id
+            AND estimated_lifecycle_costs.solution = 'B'
+    );
+
+UPDATE estimated_lifecycle_costs
+SET alternative_id = business_case_alternatives.id
+FROM business_case_alternatives
+WHERE business_case_alternatives.business_case_id = estimated_lifecycle_costs.business_case
+    AND business_case_alternatives.position = CASE estimated_lifecycle_costs.solution
+        WHEN 'As Is' THEN 0
+        WHEN 'Preferred' THEN 1
+        WHEN 'A' THEN 2
+        WHEN 'B' THEN 3
+    END;
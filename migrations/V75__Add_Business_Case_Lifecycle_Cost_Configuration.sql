@@ -0,0 +1,12 @@
+ALTER TABLE estimated_lifecycle_costs ALTER COLUMN year TYPE text USING year::text;
+ALTER TABLE estimated_lifecycle_costs ADD CONSTRAINT estimated_lifecycle_costs_year_check CHECK (year ~ '^[1-9][0-9]*$');
+ALTER TABLE estimated_lifecycle_costs ALTER COLUMN phase TYPE text USING phase::text;
+DROP TYPE lifecycle_cost_year;
+DROP TYPE lifecycle_cost_phase;
+
+ALTER TABLE business_cases ADD COLUMN lifecycle_cost_horizon int NOT NULL DEFAULT 5
+    CHECK (lifecycle_cost_horizon >= 1 AND lifecycle_cost_horizon <= 10);
+ALTER TABLE business_cases ADD COLUMN lifecycle_cost_phases jsonb NOT NULL
+    DEFAULT '["Development", "Operations and Maintenance", "Other"]';
+ALTER TABLE business_cases ADD COLUMN lifecycle_cost_fiscal_year int
+    CHECK (lifecycle_cost_fiscal_year >= 1000 AND lifecycle_cost_fiscal_year <= 9999);
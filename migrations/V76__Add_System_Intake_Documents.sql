@@ -0,0 +1,27 @@
+CREATE TYPE system_intake_document_type AS ENUM (
+    'VENDOR_QUOTE',
+    'ARCHITECTURE_DIAGRAM',
+    'COST_ESTIMATE',
+    'SECURITY_DOCUMENTATION',
+    'OTHER'
+);
+
+CREATE TABLE system_intake_documents (
+    id uuid PRIMARY KEY NOT NULL,
+    system_intake_id uuid NOT NULL REFERENCES system_intakes(id),
+    business_case_id uuid REFERENCES business_cases(id),
+    file_type text NOT NULL,
+    bucket text NOT NULL,
+    file_key text NOT NULL,
+    file_name text NOT NULL,
+    file_size int NOT NULL CHECK (file_size >= 0),
+    document_type system_intake_document_type NOT NULL,
+    other_type text,
+    virus_scanned boolean,
+    virus_clean boolean,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX system_intake_documents_system_intake_id_idx ON system_intake_documents (system_intake_id);
+CREATE INDEX system_intake_documents_business_case_id_idx ON system_intake_documents (business_case_id);
@@ -0,0 +1 @@
+ALTER TABLE business_cases ADD COLUMN cedar_id text;
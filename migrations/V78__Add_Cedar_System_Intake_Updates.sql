@@ -0,0 +1,12 @@
+CREATE TABLE cedar_system_intake_updates (
+    id uuid PRIMARY KEY NOT NULL,
+    system_intake_id uuid NOT NULL REFERENCES system_intakes(id),
+    attempts int NOT NULL DEFAULT 0,
+    last_error text,
+    next_attempt_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT cedar_system_intake_updates_intake_unique UNIQUE (system_intake_id)
+);
+
+CREATE INDEX cedar_system_intake_updates_next_attempt_at_idx ON cedar_system_intake_updates (next_attempt_at);
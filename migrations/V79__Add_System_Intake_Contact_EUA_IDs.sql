@@ -0,0 +1,6 @@
+ALTER TABLE system_intakes
+    ADD COLUMN business_owner_eua_id text,
+    ADD COLUMN isso_eua_id text,
+    ADD COLUMN trb_collaborator_eua_id text,
+    ADD COLUMN oit_security_collaborator_eua_id text,
+    ADD COLUMN ea_collaborator_eua_id text;
@@ -0,0 +1,6 @@
+ALTER TABLE system_intakes
+    ADD COLUMN isso_email text,
+    ADD COLUMN trb_collaborator_email text,
+    ADD COLUMN oit_security_collaborator_email text,
+    ADD COLUMN ea_collaborator_email text,
+    ADD COLUMN collaborator_warnings jsonb;
@@ -0,0 +1,18 @@
+CREATE TYPE system_intake_delegate_role AS ENUM (
+    'CO_OWNER',
+    'VIEWER'
+);
+
+CREATE TABLE system_intake_delegates (
+    id uuid PRIMARY KEY NOT NULL,
+    system_intake_id uuid NOT NULL REFERENCES system_intakes(id),
+    eua_user_id text NOT NULL CHECK (eua_user_id ~ '^[A-Z0-9]{4}$'),
+    common_name text NOT NULL,
+    email text NOT NULL,
+    role system_intake_delegate_role NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT unique_delegate_per_intake UNIQUE (system_intake_id, eua_user_id)
+);
+
+CREATE INDEX system_intake_delegates_eua_user_id_idx ON system_intake_delegates (eua_user_id);
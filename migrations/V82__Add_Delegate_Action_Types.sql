@@ -0,0 +1,2 @@
+ALTER TYPE action_type ADD VALUE 'ADD_DELEGATE';
+ALTER TYPE action_type ADD VALUE 'REMOVE_DELEGATE';
@@ -0,0 +1 @@
+ALTER TYPE action_type ADD VALUE 'TRANSFER_OWNERSHIP';
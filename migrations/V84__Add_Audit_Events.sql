@@ -0,0 +1,19 @@
+CREATE TYPE audit_event_outcome AS ENUM (
+    'ALLOWED',
+    'DENIED'
+);
+
+CREATE TABLE audit_events (
+    id uuid PRIMARY KEY NOT NULL,
+    eua_user_id text NOT NULL,
+    action text NOT NULL,
+    resource_type text NOT NULL,
+    resource_id text,
+    outcome audit_event_outcome NOT NULL,
+    trace_id uuid,
+    created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+CREATE INDEX audit_events_eua_user_id_idx ON audit_events (eua_user_id);
+CREATE INDEX audit_events_resource_idx ON audit_events (resource_type, resource_id);
@@ -0,0 +1,12 @@
+CREATE TABLE field_changes (
+    id uuid PRIMARY KEY NOT NULL,
+    resource_type text NOT NULL,
+    resource_id uuid NOT NULL,
+    field text NOT NULL,
+    old_value jsonb NOT NULL,
+    new_value jsonb NOT NULL,
+    eua_user_id text NOT NULL,
+    changed_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX field_changes_resource_idx ON field_changes (resource_type, resource_id, changed_at);
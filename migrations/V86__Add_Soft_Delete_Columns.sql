@@ -0,0 +1,15 @@
+ALTER TABLE system_intakes
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN deleted_by TEXT;
+
+ALTER TABLE business_cases
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN deleted_by TEXT;
+
+ALTER TABLE accessibility_requests
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN deleted_by TEXT;
+
+ALTER TABLE accessibility_request_documents
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN deleted_by TEXT;
@@ -0,0 +1,3 @@
+ALTER TABLE system_intakes ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE business_cases ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
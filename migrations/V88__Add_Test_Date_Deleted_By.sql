@@ -0,0 +1,2 @@
+ALTER TABLE test_dates
+    ADD COLUMN deleted_by TEXT;
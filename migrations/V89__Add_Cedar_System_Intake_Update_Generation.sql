@@ -0,0 +1 @@
+ALTER TABLE cedar_system_intake_updates ADD COLUMN generation INTEGER NOT NULL DEFAULT 0;
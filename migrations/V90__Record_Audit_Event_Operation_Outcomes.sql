@@ -0,0 +1,2 @@
+ALTER TYPE audit_event_outcome RENAME VALUE 'ALLOWED' TO 'SUCCEEDED';
+ALTER TYPE audit_event_outcome ADD VALUE 'FAILED';
@@ -0,0 +1,3 @@
+ALTER TABLE system_intake_documents
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN deleted_by TEXT;
@@ -0,0 +1,51 @@
+-- V73 only copied intakes whose funding number was already 6 digits. Copy the rest
+-- too, keeping their source, and report the funding numbers that couldn't be kept
+-- so they can be corrected on the intake.
+CREATE TABLE system_intake_funding_number_migration_report (
+    system_intake_id uuid PRIMARY KEY NOT NULL REFERENCES system_intakes(id),
+    funding_source text,
+    funding_number text NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+INSERT INTO system_intake_funding_number_migration_report (
+    system_intake_id,
+    funding_source,
+    funding_number,
+    created_at
+)
+SELECT
+    system_intakes.id,
+    system_intakes.funding_source,
+    system_intakes.funding_number,
+    current_timestamp
+FROM system_intakes
+WHERE system_intakes.existing_funding IS TRUE
+    AND system_intakes.funding_number IS NOT NULL
+    AND btrim(system_intakes.funding_number) !~ '^[0-9]{6}$';
+
+INSERT INTO system_intake_funding_sources (
+    id,
+    system_intake_id,
+    source,
+    funding_number,
+    created_at,
+    updated_at
+)
+SELECT
+    md5(random()::text || system_intakes.id::text)::uuid,
+    system_intakes.id,
+    system_intakes.funding_source,
+    CASE
+        WHEN btrim(system_intakes.funding_number) ~ '^[0-9]{6}$' THEN btrim(system_intakes.funding_number)
+    END,
+    coalesce(system_intakes.updated_at, current_timestamp),
+    coalesce(system_intakes.updated_at, current_timestamp)
+FROM system_intakes
+WHERE system_intakes.existing_funding IS TRUE
+    AND system_intakes.funding_number IS NOT NULL
+    AND system_intakes.funding_number !~ '^[0-9]{6}$'
+    AND NOT EXISTS (
+        SELECT 1 FROM system_intake_funding_sources
+        WHERE system_intake_funding_sources.system_intake_id = system_intakes.id
+    );
@@ -0,0 +1,22 @@
+ALTER TABLE business_case_alternatives
+    ADD COLUMN legacy_solution lifecycle_cost_solution CHECK (legacy_solution IN ('A', 'B'));
+
+CREATE UNIQUE INDEX business_case_alternatives_legacy_solution_unique
+    ON business_case_alternatives (business_case_id, legacy_solution)
+    WHERE legacy_solution IS NOT NULL;
+
+-- the first two other alternatives have been filling the A and B slots
+UPDATE business_case_alternatives
+SET legacy_solution = ranked.legacy_solution
+FROM (
+    SELECT
+        id,
+        CASE row_number() OVER (PARTITION BY business_case_id ORDER BY position)
+            WHEN 1 THEN 'A'::lifecycle_cost_solution
+            WHEN 2 THEN 'B'::lifecycle_cost_solution
+        END AS legacy_solution
+    FROM business_case_alternatives
+    WHERE role = 'OTHER'
+) ranked
+WHERE business_case_alternatives.id = ranked.id
+    AND ranked.legacy_solution IS NOT NULL;
@@ -147,6 +147,9 @@ const ClientProtocolKey = "CLIENT_PROTOCOL"
 // EmailTemplateDirectoryKey is the key for getting the email template directory
 const EmailTemplateDirectoryKey = "EMAIL_TEMPLATE_DIR"
 
+// PDFTemplateDirectoryKey is the key for getting the PDF template directory
+const PDFTemplateDirectoryKey = "PDF_TEMPLATE_DIR"
+
 // AWSS3FileUploadBucket is the key for the bucket we upload files to
 const AWSS3FileUploadBucket = "AWS_S3_FILE_UPLOAD_BUCKET"
 
@@ -165,6 +168,35 @@ const CEDARAPIURL = "CEDAR_API_URL"
 // CEDARAPIKey is the key for accessing CEDAR
 const CEDARAPIKey = "CEDAR_API_KEY"
 
+// CEDARRetryIntervalKey is the number of seconds between retries of failed CEDAR updates
+const CEDARRetryIntervalKey = "CEDAR_RETRY_INTERVAL"
+
+// CEDARTimeoutKey is the number of seconds a single CEDAR request may take
+const CEDARTimeoutKey = "CEDAR_TIMEOUT"
+
+// CEDARMaxRetriesKey is the number of times an idempotent CEDAR request is retried
+const CEDARMaxRetriesKey = "CEDAR_MAX_RETRIES"
+
+// CEDARBreakerFailuresKey is the number of consecutive CEDAR failures that open the circuit breaker
+const CEDARBreakerFailuresKey = "CEDAR_BREAKER_FAILURES"
+
+// CEDARBreakerCooldownKey is the number of seconds the CEDAR circuit breaker stays open
+const CEDARBreakerCooldownKey = "CEDAR_BREAKER_COOLDOWN"
+
+// CEDARLocalGatewayKey is the key for using the real CEDAR clients against CEDAR_API_URL
+// in local and test environments, e.g. when it points at `easi cedar gateway`
+const CEDARLocalGatewayKey = "CEDAR_LOCAL_GATEWAY"
+
+// CEDARLDAPCacheTTLKey is the number of seconds a CEDAR LDAP user lookup is cached
+const CEDARLDAPCacheTTLKey = "CEDAR_LDAP_CACHE_TTL"
+
+// CEDARLDAPCacheNegativeTTLKey is the number of seconds an unknown EUA ID is cached
+const CEDARLDAPCacheNegativeTTLKey = "CEDAR_LDAP_CACHE_NEGATIVE_TTL"
+
+// AuditEventRetentionDaysKey is the number of days audit events are kept.
+// Audit events are kept forever when it is unset.
+const AuditEventRetentionDaysKey = "AUDIT_EVENT_RETENTION_DAYS"
+
 // LDKey is the key for accessing LaunchDarkly
 const LDKey = "LD_SDK_KEY"
 
@@ -180,6 +212,29 @@ const LambdaEndpoint = "LAMBDA_ENDPOINT"
 // LambdaFunctionPrince is the name of the prince lambda function
 const LambdaFunctionPrince = "LAMBDA_FUNCTION_PRINCE"
 
+// PDFGeneratorKey indicates which generator renders PDFs
+const PDFGeneratorKey = "PDF_GENERATOR"
+
+// PDFGenerateTimeoutKey is the number of seconds to wait for a PDF
+const PDFGenerateTimeoutKey = "PDF_GENERATE_TIMEOUT"
+
+// PDFMaxHTMLBytesKey is the largest HTML document we render as a PDF
+const PDFMaxHTMLBytesKey = "PDF_MAX_HTML_BYTES"
+
+// PDFMaxBytesKey is the largest PDF we return
+const PDFMaxBytesKey = "PDF_MAX_BYTES"
+
+// PDFGeneratorOption represents a PDF generator
+type PDFGeneratorOption string
+
+const (
+	// PDFGeneratorLocal is LOCAL
+	PDFGeneratorLocal PDFGeneratorOption = "LOCAL"
+
+	// PDFGeneratorPrinceLambda is PRINCE_LAMBDA
+	PDFGeneratorPrinceLambda PDFGeneratorOption = "PRINCE_LAMBDA"
+)
+
 // FlagSourceOption represents an environment
 type FlagSourceOption string
 
@@ -56,6 +56,8 @@ type ResourceConflictError struct {
 	Err        error
 	Resource   interface{}
 	ResourceID string
+	// CurrentVersion is set when an update was refused for being made to an older version of the resource
+	CurrentVersion int
 }
 
 // Error provides the error as a string
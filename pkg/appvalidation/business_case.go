@@ -2,8 +2,11 @@ package appvalidation
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/validate"
@@ -15,7 +18,11 @@ func checkUniqLifecycleCosts(costs models.EstimatedLifecycleCosts) (string, stri
 
 	for _, cost := range costs {
 		if cost.Phase != nil {
-			attribute := string(cost.Solution) + string(cost.Year) + string(*cost.Phase)
+			solution := string(cost.Solution)
+			if cost.AlternativeID != nil {
+				solution = cost.AlternativeID.String()
+			}
+			attribute := solution + string(cost.Year) + string(*cost.Phase)
 			if costMap[attribute] {
 				return "LifecycleCostPhase", "cannot have multiple costs for the same phase, solution, and year"
 			}
@@ -53,6 +60,67 @@ func BusinessCaseForCreation(businessCase *models.BusinessCase, intake *models.S
 	if k != "" {
 		expectedErr.WithValidation(k, v)
 	}
+	validateLifecycleCostConfiguration(businessCase, &expectedErr)
+
+	if len(expectedErr.Validations) > 0 {
+		return &expectedErr
+	}
+	return nil
+}
+
+// validateLifecycleCostConfiguration checks the lifecycle cost horizon, phases and fiscal year
+// and that every cost line falls within them.
+// A horizon of 0 isn't configured, so updates keep the existing horizon and new business cases get the default.
+func validateLifecycleCostConfiguration(businessCase *models.BusinessCase, expectedErr *apperrors.ValidationError) {
+	horizon := businessCase.LifecycleCostHorizon
+	if horizon < 0 || horizon > models.MaxLifecycleCostHorizon {
+		expectedErr.WithValidation(
+			"LifecycleCostHorizon",
+			fmt.Sprintf("must be between 1 and %d, or 0 to keep the current horizon", models.MaxLifecycleCostHorizon),
+		)
+	}
+	if businessCase.LifecycleCostPhases != nil && len(businessCase.LifecycleCostPhases) == 0 {
+		expectedErr.WithValidation("LifecycleCostPhases", "must include at least one phase")
+	}
+	for _, phase := range businessCase.LifecycleCostPhases {
+		if validate.RequireString(string(phase)) {
+			expectedErr.WithValidation("LifecycleCostPhases", "cannot include an empty phase")
+		}
+	}
+	if businessCase.LifecycleCostFiscalYear != nil && validate.FiscalYearInvalid(*businessCase.LifecycleCostFiscalYear) {
+		expectedErr.WithValidation("LifecycleCostFiscalYear", "must be a 4 digit year")
+	}
+
+	years := map[models.LifecycleCostYear]bool{}
+	for _, year := range businessCase.LifecycleCostYears() {
+		years[year] = true
+	}
+	phases := map[models.LifecycleCostPhase]bool{}
+	for _, phase := range businessCase.AllowedLifecycleCostPhases() {
+		phases[phase] = true
+	}
+	for _, cost := range businessCase.LifecycleCostLines {
+		if !years[cost.Year] {
+			expectedErr.WithValidation(
+				"LifecycleCostYear",
+				fmt.Sprintf("must be between 1 and %d", len(years)),
+			)
+		}
+		if cost.Phase != nil && !phases[*cost.Phase] {
+			expectedErr.WithValidation("LifecycleCostPhase", "must be one of the lifecycle cost phases")
+		}
+	}
+}
+
+// LifecycleCostConfigurationForSave checks the lifecycle cost configuration of a business case to save
+func LifecycleCostConfigurationForSave(businessCase *models.BusinessCase) error {
+	expectedErr := apperrors.NewValidationError(
+		errors.New("business case failed validations"),
+		businessCase,
+		businessCase.ID.String(),
+	)
+
+	validateLifecycleCostConfiguration(businessCase, &expectedErr)
 
 	if len(expectedErr.Validations) > 0 {
 		return &expectedErr
@@ -73,6 +141,7 @@ func BusinessCaseForUpdate(businessCase *models.BusinessCase) error {
 	if k != "" {
 		expectedErr.WithValidation(k, v)
 	}
+	validateLifecycleCostConfiguration(businessCase, &expectedErr)
 
 	if len(expectedErr.Validations) > 0 {
 		return &expectedErr
@@ -91,22 +160,12 @@ func alternativeBRequired(businessCase *models.BusinessCase) bool {
 
 type solutionCostLines map[string]map[string]int
 
-func validateRequiredCost(lines solutionCostLines) string {
+func validateRequiredCost(lines solutionCostLines, requiredYears []models.LifecycleCostYear) string {
 	years := []string{}
-	if len(lines["1"]) == 0 {
-		years = append(years, "1")
-	}
-	if len(lines["2"]) == 0 {
-		years = append(years, "2")
-	}
-	if len(lines["3"]) == 0 {
-		years = append(years, "3")
-	}
-	if len(lines["4"]) == 0 {
-		years = append(years, "4")
-	}
-	if len(lines["5"]) == 0 {
-		years = append(years, "5")
+	for _, year := range requiredYears {
+		if len(lines[string(year)]) == 0 {
+			years = append(years, string(year))
+		}
 	}
 	noun := "year "
 	verb := " is "
@@ -126,6 +185,7 @@ func validateAllRequiredLifecycleCosts(businessCase *models.BusinessCase) map[st
 	preferredCosts := solutionCostLines{}
 	aCosts := solutionCostLines{}
 	bCosts := solutionCostLines{}
+	requiredYears := businessCase.LifecycleCostYears()
 
 	for _, cost := range businessCase.LifecycleCostLines {
 		valid := true
@@ -157,17 +217,17 @@ func validateAllRequiredLifecycleCosts(businessCase *models.BusinessCase) map[st
 			}
 		}
 	}
-	if v := validateRequiredCost(asIsCosts); v != "" {
+	if v := validateRequiredCost(asIsCosts, requiredYears); v != "" {
 		validations["asIsSolution"] = v
 	}
-	if v := validateRequiredCost(preferredCosts); v != "" {
+	if v := validateRequiredCost(preferredCosts, requiredYears); v != "" {
 		validations["preferredSolution"] = v
 	}
-	if v := validateRequiredCost(aCosts); v != "" {
+	if v := validateRequiredCost(aCosts, requiredYears); v != "" {
 		validations["alternativeASolution"] = v
 	}
 	if alternativeBRequired(businessCase) {
-		if v := validateRequiredCost(bCosts); v != "" {
+		if v := validateRequiredCost(bCosts, requiredYears); v != "" {
 			validations["alternativeBSolution"] = v
 		}
 	} else {
@@ -178,52 +238,147 @@ func validateAllRequiredLifecycleCosts(businessCase *models.BusinessCase) map[st
 	return validations
 }
 
-// BusinessCaseForSubmit checks if it's a valid business case to update
-func BusinessCaseForSubmit(businessCase *models.BusinessCase) error {
-	// We return an empty id in this error because the business case hasn't been created
-	expectedErr := apperrors.NewValidationError(
-		errors.New("business case failed validations"),
-		businessCase,
-		businessCase.ID.String(),
-	)
-
-	if businessCase.Status != models.BusinessCaseStatusOPEN {
-		expectedErr.WithValidation("Status", "must be OPEN")
+// alternativeKeyPrefixes returns the validation key prefix for each alternative,
+// reusing the fixed field names for the alternatives in the As Is, Preferred, A and B slots
+func alternativeKeyPrefixes(alternatives models.BusinessCaseAlternatives) []string {
+	fixedPrefixes := map[models.LifecycleCostSolution]string{
+		models.LifecycleCostSolutionASIS:      "AsIs",
+		models.LifecycleCostSolutionPREFERRED: "Preferred",
+		models.LifecycleCostSolutionA:         "AlternativeA",
+		models.LifecycleCostSolutionB:         "AlternativeB",
+	}
+	prefixes := make([]string, len(alternatives))
+	for i, solution := range alternatives.LegacySolutions() {
+		if prefix, ok := fixedPrefixes[solution]; ok {
+			prefixes[i] = prefix
+		} else {
+			prefixes[i] = fmt.Sprintf("Alternatives[%d].", i)
+		}
 	}
+	return prefixes
+}
 
-	if validate.RequireUUID(businessCase.ID) {
-		expectedErr.WithValidation("ID", "is required")
-	}
-	if validate.RequireString(businessCase.EUAUserID) {
-		expectedErr.WithValidation("EUAUserID", "is required")
-	}
-	if validate.RequireUUID(businessCase.SystemIntakeID) {
-		expectedErr.WithValidation("SystemIntakeID", "is required")
-	}
-	if validate.RequireNullString(businessCase.ProjectName) {
-		expectedErr.WithValidation("ProjectName", "is required")
-	}
-	if validate.RequireNullString(businessCase.Requester) {
-		expectedErr.WithValidation("Requester", "is required")
+// validateAlternatives checks the list of alternatives of a business case
+func validateAlternatives(businessCase *models.BusinessCase, expectedErr *apperrors.ValidationError) {
+	roleCounts := map[models.BusinessCaseAlternativeRole]int{}
+	positions := map[int]bool{}
+	prefixes := alternativeKeyPrefixes(businessCase.Alternatives)
+	for i, alternative := range businessCase.Alternatives {
+		prefix := prefixes[i]
+		roleCounts[alternative.Role]++
+		switch alternative.Role {
+		case models.BusinessCaseAlternativeRoleASIS,
+			models.BusinessCaseAlternativeRolePREFERRED,
+			models.BusinessCaseAlternativeRoleOTHER:
+		default:
+			expectedErr.WithValidation(prefix+"Role", "must be AS_IS, PREFERRED or OTHER")
+		}
+		if positions[alternative.Position] {
+			expectedErr.WithValidation(prefix+"Position", "must be unique")
+		}
+		positions[alternative.Position] = true
+
+		if validate.RequireNullString(alternative.Title) {
+			expectedErr.WithValidation(prefix+"Title", "is required")
+		}
+		if validate.RequireNullString(alternative.Summary) {
+			expectedErr.WithValidation(prefix+"Summary", "is required")
+		}
+		if alternative.Role != models.BusinessCaseAlternativeRoleASIS {
+			if validate.RequireNullString(alternative.AcquisitionApproach) {
+				expectedErr.WithValidation(prefix+"AcquisitionApproach", "is required")
+			}
+			if validate.RequireNullString(alternative.HostingType) {
+				expectedErr.WithValidation(prefix+"HostingType", "is required")
+			}
+			if validate.RequireNullString(alternative.HasUI) {
+				expectedErr.WithValidation(prefix+"HasUI", "is required")
+			}
+		}
+		if validate.RequireNullString(alternative.Pros) {
+			expectedErr.WithValidation(prefix+"Pros", "is required")
+		}
+		if validate.RequireNullString(alternative.Cons) {
+			expectedErr.WithValidation(prefix+"Cons", "is required")
+		}
+		if validate.RequireNullString(alternative.CostSavings) {
+			expectedErr.WithValidation(prefix+"CostSavings", "is required")
+		}
 	}
-	if validate.RequireNullString(businessCase.RequesterPhoneNumber) {
-		expectedErr.WithValidation("RequesterPhoneNumber", "is required")
+	if roleCounts[models.BusinessCaseAlternativeRoleASIS] != 1 {
+		expectedErr.WithValidation("Alternatives.AsIs", "must include exactly one as is solution")
 	}
-	if validate.RequireNullString(businessCase.BusinessOwner) {
-		expectedErr.WithValidation("BusinessOwner", "is required")
+	if roleCounts[models.BusinessCaseAlternativeRolePREFERRED] != 1 {
+		expectedErr.WithValidation("Alternatives.Preferred", "must include exactly one preferred solution")
 	}
-	if validate.RequireNullString(businessCase.BusinessNeed) {
-		expectedErr.WithValidation("BusinessNeed", "is required")
+	if roleCounts[models.BusinessCaseAlternativeRoleOTHER] == 0 {
+		expectedErr.WithValidation("Alternatives.Other", "must include at least one other alternative")
 	}
-	if validate.RequireNullString(businessCase.CMSBenefit) {
-		expectedErr.WithValidation("CMSBenefit", "is required")
+}
+
+// validateAlternativeLifecycleCosts checks every alternative has costs for each year
+func validateAlternativeLifecycleCosts(businessCase *models.BusinessCase) map[string]string {
+	validations := map[string]string{}
+	solutions := businessCase.Alternatives.LegacySolutions()
+	fixedSolutionKeys := map[models.LifecycleCostSolution]string{
+		models.LifecycleCostSolutionASIS:      "asIsSolution",
+		models.LifecycleCostSolutionPREFERRED: "preferredSolution",
+		models.LifecycleCostSolutionA:         "alternativeASolution",
+		models.LifecycleCostSolutionB:         "alternativeBSolution",
+	}
+	requiredYears := businessCase.LifecycleCostYears()
+	alternativeIndexes := map[uuid.UUID]int{}
+	costs := make([]solutionCostLines, len(businessCase.Alternatives))
+	for i, alternative := range businessCase.Alternatives {
+		alternativeIndexes[alternative.ID] = i
+		costs[i] = solutionCostLines{}
 	}
-	if validate.RequireNullString(businessCase.PriorityAlignment) {
-		expectedErr.WithValidation("PriorityAlignment", "is required")
+
+	for _, cost := range businessCase.LifecycleCostLines {
+		i, ok := 0, false
+		if cost.AlternativeID != nil {
+			i, ok = alternativeIndexes[*cost.AlternativeID]
+		}
+		if !ok {
+			validations["LifecycleCostLines"] = "must reference an alternative"
+			continue
+		}
+		label := string(solutions[i])
+		if label == "" {
+			label = fmt.Sprintf("Alternatives[%d]", i)
+		}
+
+		valid := true
+		phase := ""
+		if validate.RequireCostPhase(cost.Phase) {
+			validations[label+string(cost.Year)] = "requires a phase"
+			valid = false
+		} else {
+			phase = string(*cost.Phase)
+		}
+		if validate.RequireInt(cost.Cost) {
+			validations[label+string(cost.Year)+phase] = "requires a cost"
+			valid = false
+		}
+		if valid {
+			costs[i][string(cost.Year)] = map[string]int{phase: *cost.Cost}
+		}
 	}
-	if validate.RequireNullString(businessCase.SuccessIndicators) {
-		expectedErr.WithValidation("SuccessIndicators", "is required")
+	for i := range businessCase.Alternatives {
+		key, ok := fixedSolutionKeys[solutions[i]]
+		if !ok {
+			key = fmt.Sprintf("Alternatives[%d].Solution", i)
+		}
+		if v := validateRequiredCost(costs[i], requiredYears); v != "" {
+			validations[key] = v
+		}
 	}
+	return validations
+}
+
+// validateFixedAlternatives checks the As Is, Preferred, A and B fields
+// of a business case that doesn't have a list of alternatives
+func validateFixedAlternatives(businessCase *models.BusinessCase, expectedErr *apperrors.ValidationError) {
 	if validate.RequireNullString(businessCase.AsIsTitle) {
 		expectedErr.WithValidation("AsIsTitle", "is required")
 	}
@@ -287,12 +442,6 @@ func BusinessCaseForSubmit(businessCase *models.BusinessCase) error {
 	if validate.RequireNullString(businessCase.AlternativeACostSavings) {
 		expectedErr.WithValidation("AlternativeACostSavings", "is required")
 	}
-	if businessCase.InitialSubmittedAt != nil && validate.RequireTime(*businessCase.InitialSubmittedAt) {
-		expectedErr.WithValidation("InitialSubmittedAt", "cannot be zero")
-	}
-	if businessCase.LastSubmittedAt != nil && validate.RequireTime(*businessCase.LastSubmittedAt) {
-		expectedErr.WithValidation("LastSubmittedAt", "cannot be zero")
-	}
 	if alternativeBRequired(businessCase) {
 		if validate.RequireNullString(businessCase.AlternativeBTitle) {
 			expectedErr.WithValidation("AlternativeBTitle", "is required")
@@ -319,13 +468,75 @@ func BusinessCaseForSubmit(businessCase *models.BusinessCase) error {
 			expectedErr.WithValidation("AlternativeBCostSavings", "is required")
 		}
 	}
+}
+
+// BusinessCaseForSubmit checks if it's a valid business case to update
+func BusinessCaseForSubmit(businessCase *models.BusinessCase) error {
+	// We return an empty id in this error because the business case hasn't been created
+	expectedErr := apperrors.NewValidationError(
+		errors.New("business case failed validations"),
+		businessCase,
+		businessCase.ID.String(),
+	)
+
+	if businessCase.Status != models.BusinessCaseStatusOPEN {
+		expectedErr.WithValidation("Status", "must be OPEN")
+	}
+
+	if validate.RequireUUID(businessCase.ID) {
+		expectedErr.WithValidation("ID", "is required")
+	}
+	if validate.RequireString(businessCase.EUAUserID) {
+		expectedErr.WithValidation("EUAUserID", "is required")
+	}
+	if validate.RequireUUID(businessCase.SystemIntakeID) {
+		expectedErr.WithValidation("SystemIntakeID", "is required")
+	}
+	if validate.RequireNullString(businessCase.ProjectName) {
+		expectedErr.WithValidation("ProjectName", "is required")
+	}
+	if validate.RequireNullString(businessCase.Requester) {
+		expectedErr.WithValidation("Requester", "is required")
+	}
+	if validate.RequireNullString(businessCase.RequesterPhoneNumber) {
+		expectedErr.WithValidation("RequesterPhoneNumber", "is required")
+	}
+	if validate.RequireNullString(businessCase.BusinessOwner) {
+		expectedErr.WithValidation("BusinessOwner", "is required")
+	}
+	if validate.RequireNullString(businessCase.BusinessNeed) {
+		expectedErr.WithValidation("BusinessNeed", "is required")
+	}
+	if validate.RequireNullString(businessCase.CMSBenefit) {
+		expectedErr.WithValidation("CMSBenefit", "is required")
+	}
+	if validate.RequireNullString(businessCase.PriorityAlignment) {
+		expectedErr.WithValidation("PriorityAlignment", "is required")
+	}
+	if validate.RequireNullString(businessCase.SuccessIndicators) {
+		expectedErr.WithValidation("SuccessIndicators", "is required")
+	}
+	if businessCase.InitialSubmittedAt != nil && validate.RequireTime(*businessCase.InitialSubmittedAt) {
+		expectedErr.WithValidation("InitialSubmittedAt", "cannot be zero")
+	}
+	if businessCase.LastSubmittedAt != nil && validate.RequireTime(*businessCase.LastSubmittedAt) {
+		expectedErr.WithValidation("LastSubmittedAt", "cannot be zero")
+	}
+	if len(businessCase.Alternatives) == 0 {
+		validateFixedAlternatives(businessCase, &expectedErr)
+	} else {
+		validateAlternatives(businessCase, &expectedErr)
+	}
+	validateLifecycleCostConfiguration(businessCase, &expectedErr)
 	if k, v := checkUniqLifecycleCosts(businessCase.LifecycleCostLines); k != "" {
 		expectedErr.WithValidation(k, v)
 	}
-	if lifecycleValidations := validateAllRequiredLifecycleCosts(businessCase); len(lifecycleValidations) != 0 {
-		for k, v := range lifecycleValidations {
-			expectedErr.WithValidation(k, v)
-		}
+	lifecycleValidations := validateAllRequiredLifecycleCosts(businessCase)
+	if len(businessCase.Alternatives) != 0 {
+		lifecycleValidations = validateAlternativeLifecycleCosts(businessCase)
+	}
+	for k, v := range lifecycleValidations {
+		expectedErr.WithValidation(k, v)
 	}
 
 	if len(expectedErr.Validations) > 0 {
@@ -337,3 +337,127 @@ func (s AppValidateTestSuite) TestBusinessCaseForSubmit() {
 		s.Equal(expectedError, err.Error())
 	})
 }
+
+func (s AppValidateTestSuite) TestBusinessCaseForSubmitWithAlternatives() {
+	newBusinessCase := func() models.BusinessCase {
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.LifecycleCostLines = testhelpers.NewValidLifecycleCosts(&businessCase.ID)
+		businessCase.SyncAlternatives(nil)
+		return businessCase
+	}
+
+	s.Run("golden path", func() {
+		businessCase := newBusinessCase()
+		s.Len(businessCase.Alternatives, 4)
+		s.NoError(BusinessCaseForSubmit(&businessCase))
+	})
+
+	s.Run("validates alternatives beyond the fixed four", func() {
+		businessCase := newBusinessCase()
+		businessCase.Alternatives = append(businessCase.Alternatives, models.BusinessCaseAlternative{
+			ID:       uuid.New(),
+			Position: 4,
+			Role:     models.BusinessCaseAlternativeRoleOTHER,
+			Title:    null.StringFrom("Alternative C"),
+		})
+		expectedError := `Could not validate *models.BusinessCase ` +
+			fmt.Sprintf("%s: ", businessCase.ID) +
+			`{"Alternatives[4].AcquisitionApproach":"is required",` +
+			`"Alternatives[4].Cons":"is required",` +
+			`"Alternatives[4].CostSavings":"is required",` +
+			`"Alternatives[4].HasUI":"is required",` +
+			`"Alternatives[4].HostingType":"is required",` +
+			`"Alternatives[4].Pros":"is required",` +
+			`"Alternatives[4].Solution":"years 1, 2, 3, 4, 5 are required",` +
+			`"Alternatives[4].Summary":"is required"}`
+
+		err := BusinessCaseForSubmit(&businessCase)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(expectedError, err.Error())
+	})
+
+	s.Run("requires one as is and one preferred solution", func() {
+		businessCase := newBusinessCase()
+		businessCase.Alternatives[0].Role = models.BusinessCaseAlternativeRolePREFERRED
+
+		err := BusinessCaseForSubmit(&businessCase)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		validations := err.(*apperrors.ValidationError).Validations
+		s.Equal("must include exactly one as is solution", validations["Alternatives.AsIs"])
+		s.Equal("must include exactly one preferred solution", validations["Alternatives.Preferred"])
+	})
+
+	s.Run("requires cost lines to reference an alternative", func() {
+		businessCase := newBusinessCase()
+		unknownID := uuid.New()
+		businessCase.LifecycleCostLines[0].AlternativeID = &unknownID
+
+		err := BusinessCaseForSubmit(&businessCase)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(
+			"must reference an alternative",
+			err.(*apperrors.ValidationError).Validations["LifecycleCostLines"],
+		)
+	})
+}
+
+func (s AppValidateTestSuite) TestLifecycleCostConfigurationForSave() {
+	s.Run("existing business cases default to a five year horizon", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		s.NoError(LifecycleCostConfigurationForSave(&businessCase))
+		s.Len(businessCase.LifecycleCostYears(), 5)
+	})
+
+	s.Run("allows costs up to a ten year horizon", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		year10 := models.LifecycleCostYear("10")
+		fiscalYear := 2022
+		businessCase.LifecycleCostHorizon = 10
+		businessCase.LifecycleCostFiscalYear = &fiscalYear
+		businessCase.LifecycleCostLines = models.EstimatedLifecycleCosts{
+			testhelpers.NewEstimatedLifecycleCost(testhelpers.EstimatedLifecycleCostOptions{Year: &year10}),
+		}
+		s.NoError(LifecycleCostConfigurationForSave(&businessCase))
+		s.Equal(2031, *businessCase.FiscalYear(year10))
+	})
+
+	s.Run("returns validations for an invalid configuration", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		fiscalYear := 22
+		planning := models.LifecycleCostPhase("Planning")
+		year6 := models.LifecycleCostYear("6")
+		businessCase.LifecycleCostHorizon = 11
+		businessCase.LifecycleCostPhases = models.LifecycleCostPhases{planning}
+		businessCase.LifecycleCostFiscalYear = &fiscalYear
+		businessCase.LifecycleCostLines = models.EstimatedLifecycleCosts{
+			testhelpers.NewEstimatedLifecycleCost(testhelpers.EstimatedLifecycleCostOptions{Year: &year6}),
+		}
+
+		err := LifecycleCostConfigurationForSave(&businessCase)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrMap := map[string]string{
+			"LifecycleCostHorizon":    "must be between 1 and 10, or 0 to keep the current horizon",
+			"LifecycleCostFiscalYear": "must be a 4 digit year",
+			"LifecycleCostPhase":      "must be one of the lifecycle cost phases",
+		}
+		s.Equal(expectedErrMap, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("requires costs for every year of the horizon on submit", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.LifecycleCostLines = testhelpers.NewValidLifecycleCosts(&businessCase.ID)
+		businessCase.LifecycleCostHorizon = 6
+
+		err := BusinessCaseForSubmit(&businessCase)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(
+			"year 6 is required",
+			err.(*apperrors.ValidationError).Validations["asIsSolution"],
+		)
+	})
+}
@@ -0,0 +1,112 @@
+package appvalidation
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/validate"
+)
+
+// SystemIntakeFundingSourceForSave checks if it's a valid funding source to create or update
+func SystemIntakeFundingSourceForSave(fundingSource *models.SystemIntakeFundingSource) error {
+	expectedErr := apperrors.NewValidationError(
+		errors.New("funding source failed validations"),
+		fundingSource,
+		fundingSource.ID.String(),
+	)
+
+	if validate.RequireUUID(fundingSource.SystemIntakeID) {
+		expectedErr.WithValidation("SystemIntakeID", "is required")
+	}
+	if validate.RequireNullString(fundingSource.Source) {
+		expectedErr.WithValidation("Source", "is required")
+	}
+	if validate.RequireNullString(fundingSource.FundingNumber) {
+		expectedErr.WithValidation("FundingNumber", "is required")
+	} else if validate.FundingNumberInvalid(fundingSource.FundingNumber.String) {
+		expectedErr.WithValidation("FundingNumber", "must be a 6 digit string")
+	}
+	if fundingSource.Amount != nil && *fundingSource.Amount < 0 {
+		expectedErr.WithValidation("Amount", "cannot be negative")
+	}
+	if fundingSource.FiscalYear != nil && validate.FiscalYearInvalid(*fundingSource.FiscalYear) {
+		expectedErr.WithValidation("FiscalYear", "must be a 4 digit year")
+	}
+
+	if len(expectedErr.Validations) > 0 {
+		return &expectedErr
+	}
+	return nil
+}
+
+// SystemIntakeDocumentForCreation checks if it's a valid document to attach to a system intake,
+// uploaded to a key directly under the intake's key prefix
+func SystemIntakeDocumentForCreation(document *models.SystemIntakeDocument, keyPrefix string) error {
+	expectedErr := apperrors.NewValidationError(
+		errors.New("system intake document failed validations"),
+		document,
+		document.ID.String(),
+	)
+
+	if validate.RequireUUID(document.SystemIntakeID) {
+		expectedErr.WithValidation("SystemIntakeID", "is required")
+	}
+	if validate.RequireString(document.FileType) {
+		expectedErr.WithValidation("FileType", "is required")
+	}
+	if validate.RequireString(document.Key) {
+		expectedErr.WithValidation("FileKey", "is required")
+	} else if name := strings.TrimPrefix(document.Key, keyPrefix); name == document.Key || name == "" || strings.Contains(name, "/") {
+		expectedErr.WithValidation("FileKey", "must be uploaded for this system intake")
+	}
+	if validate.RequireString(document.Name) {
+		expectedErr.WithValidation("Name", "is required")
+	}
+	if document.Size < 0 {
+		expectedErr.WithValidation("Size", "cannot be negative")
+	}
+	if !document.DocumentType.IsValid() {
+		expectedErr.WithValidation("DocumentType", "is invalid")
+	} else if document.DocumentType == models.SystemIntakeDocumentTypeOther && validate.RequireNullString(document.OtherType) {
+		expectedErr.WithValidation("OtherType", "is required when DocumentType is OTHER")
+	}
+
+	if len(expectedErr.Validations) > 0 {
+		return &expectedErr
+	}
+	return nil
+}
+
+// SystemIntakeForUpdate checks if a system intake is valid to save over the stored one
+func SystemIntakeForUpdate(intake *models.SystemIntake) error {
+	expectedErr := apperrors.NewValidationError(
+		errors.New("system intake failed validations"),
+		intake,
+		intake.ID.String(),
+	)
+
+	switch intake.RequestType {
+	case models.SystemIntakeRequestTypeNEW,
+		models.SystemIntakeRequestTypeMAJORCHANGES,
+		models.SystemIntakeRequestTypeRECOMPETE,
+		models.SystemIntakeRequestTypeSHUTDOWN:
+	default:
+		expectedErr.WithValidation("RequestType", "is invalid")
+	}
+	if validate.RequireString(intake.Requester) {
+		expectedErr.WithValidation("Requester", "is required")
+	}
+	if intake.FundingNumber.ValueOrZero() != "" && validate.FundingNumberInvalid(intake.FundingNumber.String) {
+		expectedErr.WithValidation("FundingNumber", "must be a 6 digit string")
+	}
+	if intake.LifecycleID.ValueOrZero() != "" && intake.LifecycleExpiresAt == nil {
+		expectedErr.WithValidation("LifecycleExpiresAt", "is required when there is a lifecycle ID")
+	}
+
+	if len(expectedErr.Validations) > 0 {
+		return &expectedErr
+	}
+	return nil
+}
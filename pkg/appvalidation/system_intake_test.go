@@ -0,0 +1,149 @@
+package appvalidation
+
+import (
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s AppValidateTestSuite) TestSystemIntakeFundingSourceForSave() {
+	newFundingSource := func() *models.SystemIntakeFundingSource {
+		amount := 5000
+		fiscalYear := 2021
+		return &models.SystemIntakeFundingSource{
+			ID:             uuid.New(),
+			SystemIntakeID: uuid.New(),
+			Source:         null.StringFrom("CLIA"),
+			FundingNumber:  null.StringFrom("123456"),
+			Amount:         &amount,
+			FiscalYear:     &fiscalYear,
+		}
+	}
+
+	s.Run("a valid funding source passes validation", func() {
+		s.NoError(SystemIntakeFundingSourceForSave(newFundingSource()))
+	})
+
+	s.Run("amount and fiscal year are optional", func() {
+		fundingSource := newFundingSource()
+		fundingSource.Amount = nil
+		fundingSource.FiscalYear = nil
+		s.NoError(SystemIntakeFundingSourceForSave(fundingSource))
+	})
+
+	s.Run("an empty funding source fails validation", func() {
+		err := SystemIntakeFundingSourceForSave(&models.SystemIntakeFundingSource{})
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrMap := map[string]string{
+			"SystemIntakeID": "is required",
+			"Source":         "is required",
+			"FundingNumber":  "is required",
+		}
+		s.Equal(expectedErrMap, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("invalid values fail validation", func() {
+		fundingSource := newFundingSource()
+		negative := -1
+		shortYear := 21
+		fundingSource.FundingNumber = null.StringFrom("12345a")
+		fundingSource.Amount = &negative
+		fundingSource.FiscalYear = &shortYear
+		err := SystemIntakeFundingSourceForSave(fundingSource)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrMap := map[string]string{
+			"FundingNumber": "must be a 6 digit string",
+			"Amount":        "cannot be negative",
+			"FiscalYear":    "must be a 4 digit year",
+		}
+		s.Equal(expectedErrMap, err.(*apperrors.ValidationError).Validations.Map())
+	})
+}
+
+func (s AppValidateTestSuite) TestSystemIntakeDocumentForCreation() {
+	const keyPrefix = "system-intakes/123/"
+	newDocument := func() *models.SystemIntakeDocument {
+		return &models.SystemIntakeDocument{
+			SystemIntakeID: uuid.New(),
+			FileType:       "application/pdf",
+			Key:            keyPrefix + "abc.pdf",
+			Name:           "quote.pdf",
+			Size:           1024,
+			DocumentType:   models.SystemIntakeDocumentTypeVendorQuote,
+		}
+	}
+
+	s.Run("a valid document passes validation", func() {
+		s.NoError(SystemIntakeDocumentForCreation(newDocument(), keyPrefix))
+	})
+
+	s.Run("an empty document fails validation", func() {
+		err := SystemIntakeDocumentForCreation(&models.SystemIntakeDocument{}, keyPrefix)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrMap := map[string]string{
+			"SystemIntakeID": "is required",
+			"FileType":       "is required",
+			"FileKey":        "is required",
+			"Name":           "is required",
+			"DocumentType":   "is invalid",
+		}
+		s.Equal(expectedErrMap, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("other documents require a description", func() {
+		document := newDocument()
+		document.DocumentType = models.SystemIntakeDocumentTypeOther
+		err := SystemIntakeDocumentForCreation(document, keyPrefix)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrMap := map[string]string{
+			"OtherType": "is required when DocumentType is OTHER",
+		}
+		s.Equal(expectedErrMap, err.(*apperrors.ValidationError).Validations.Map())
+
+		document.OtherType = null.StringFrom("Meeting notes")
+		s.NoError(SystemIntakeDocumentForCreation(document, keyPrefix))
+	})
+
+	s.Run("the key must be directly under the intake's key prefix", func() {
+		for _, key := range []string{"abc.pdf", "system-intakes/456/abc.pdf", keyPrefix, keyPrefix + "nested/abc.pdf", "pdf-cache/abc.pdf"} {
+			document := newDocument()
+			document.Key = key
+			err := SystemIntakeDocumentForCreation(document, keyPrefix)
+			s.IsType(&apperrors.ValidationError{}, err, key)
+			s.Equal(
+				map[string]string{"FileKey": "must be uploaded for this system intake"},
+				err.(*apperrors.ValidationError).Validations.Map(),
+			)
+		}
+	})
+}
+
+func (s AppValidateTestSuite) TestSystemIntakeForUpdate() {
+	s.Run("a valid system intake passes validation", func() {
+		intake := &models.SystemIntake{
+			RequestType:   models.SystemIntakeRequestTypeNEW,
+			Requester:     "Test Requester",
+			FundingNumber: null.StringFrom("123456"),
+		}
+		s.NoError(SystemIntakeForUpdate(intake))
+	})
+
+	s.Run("invalid values fail validation", func() {
+		intake := &models.SystemIntake{
+			RequestType:   "OTHER",
+			FundingNumber: null.StringFrom("12345"),
+			LifecycleID:   null.StringFrom("123456"),
+		}
+		err := SystemIntakeForUpdate(intake)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrMap := map[string]string{
+			"RequestType":        "is invalid",
+			"Requester":          "is required",
+			"FundingNumber":      "must be a 6 digit string",
+			"LifecycleExpiresAt": "is required when there is a lifecycle ID",
+		}
+		s.Equal(expectedErrMap, err.(*apperrors.ValidationError).Validations.Map())
+	})
+}
@@ -0,0 +1,38 @@
+package appvalidation
+
+import (
+	"errors"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/validate"
+)
+
+// maxTestDateScore matches the check on test_dates.score, which is in tenths of a percent
+const maxTestDateScore = 1000
+
+// TestDateForSave checks if it's a valid 508 test date to create or update
+func TestDateForSave(testDate *models.TestDate) error {
+	expectedErr := apperrors.NewValidationError(
+		errors.New("test date failed validations"),
+		testDate,
+		testDate.ID.String(),
+	)
+
+	if validate.RequireTime(testDate.Date) {
+		expectedErr.WithValidation("input.date", "is required")
+	}
+	switch testDate.TestType {
+	case models.TestDateTestTypeInitial, models.TestDateTestTypeRemediation:
+	default:
+		expectedErr.WithValidation("input.testType", "is invalid")
+	}
+	if testDate.Score != nil && (*testDate.Score < 0 || *testDate.Score > maxTestDateScore) {
+		expectedErr.WithValidation("input.score", "must be between 0 and 1000")
+	}
+
+	if len(expectedErr.Validations) > 0 {
+		return &expectedErr
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package appvalidation
+
+import (
+	"time"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s AppValidateTestSuite) TestTestDateForSave() {
+	score := 1000
+	validTestDate := func() *models.TestDate {
+		return &models.TestDate{
+			TestType: models.TestDateTestTypeInitial,
+			Date:     time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+			Score:    &score,
+		}
+	}
+
+	s.Run("passes a valid test date, with or without a score", func() {
+		s.NoError(TestDateForSave(validTestDate()))
+
+		testDate := validTestDate()
+		testDate.Score = nil
+		s.NoError(TestDateForSave(testDate))
+	})
+
+	s.Run("fails a missing date, unknown test type and out of range score", func() {
+		outOfRange := 1001
+		testDate := validTestDate()
+		testDate.Date = time.Time{}
+		testDate.TestType = "FINAL"
+		testDate.Score = &outOfRange
+
+		err := TestDateForSave(testDate)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(map[string]string{
+			"input.date":     "is required",
+			"input.testType": "is invalid",
+			"input.score":    "must be between 0 and 1000",
+		}, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("fails a negative score", func() {
+		negative := -1
+		testDate := validTestDate()
+		testDate.Score = &negative
+
+		s.IsType(&apperrors.ValidationError{}, TestDateForSave(testDate))
+	})
+}
@@ -0,0 +1,70 @@
+// Package audit records who tried to do what to which resource, and how it turned out
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// Recorder saves an audit event
+type Recorder func(context.Context, *models.AuditEvent) error
+
+type contextKey int
+
+const recorderKey contextKey = iota
+
+// WithRecorder returns a context whose audit events are saved with the recorder
+func WithRecorder(ctx context.Context, recorder Recorder) context.Context {
+	return context.WithValue(ctx, recorderKey, recorder)
+}
+
+// Record saves an audit event for the context's principal taking the action on a resource,
+// once the action is done. The outcome follows from the error the action returned:
+// DENIED for an UnauthorizedError, FAILED for any other error and SUCCEEDED for none.
+// An empty resource ID is saved as null, for a new resource that failed to be created.
+// Contexts without a recorder, such as background jobs, are not audited.
+// A failure to save is logged rather than returned, so it can't block the request.
+func Record(ctx context.Context, action authz.Action, resourceType string, resourceID string, err error) {
+	recorder, ok := ctx.Value(recorderKey).(Recorder)
+	if !ok {
+		return
+	}
+	event := &models.AuditEvent{
+		EUAUserID:    appcontext.Principal(ctx).ID(),
+		Action:       string(action),
+		ResourceType: resourceType,
+		ResourceID:   null.NewString(resourceID, resourceID != ""),
+		Outcome:      outcome(err),
+	}
+	if traceID, ok := appcontext.Trace(ctx); ok {
+		event.TraceID = &traceID
+	}
+	if saveErr := recorder(ctx, event); saveErr != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to record audit event",
+			zap.Error(saveErr),
+			zap.String("action", event.Action),
+			zap.String("resource", event.ResourceType),
+		)
+	}
+}
+
+func outcome(err error) models.AuditEventOutcome {
+	var unauthorizedErr *apperrors.UnauthorizedError
+	switch {
+	case err == nil:
+		return models.AuditEventOutcomeSUCCEEDED
+	case errors.As(err, &unauthorizedErr):
+		return models.AuditEventOutcomeDENIED
+	default:
+		return models.AuditEventOutcomeFAILED
+	}
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+}
+
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
+
+func (s AuditTestSuite) TestRecord() {
+	principal := &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true}
+
+	s.Run("fills in the principal and trace", func() {
+		var recorded *models.AuditEvent
+		recorder := func(_ context.Context, event *models.AuditEvent) error {
+			recorded = event
+			return nil
+		}
+		ctx, traceID := appcontext.WithTrace(appcontext.WithPrincipal(context.Background(), principal))
+		ctx = WithRecorder(ctx, recorder)
+
+		Record(ctx, authz.ActionRead, "SystemIntake", "6c2d1c0e-cfb6-4d7d-bc6c-a8a1e1b0f2f6", nil)
+
+		s.Equal("ABCD", recorded.EUAUserID)
+		s.Equal(&traceID, recorded.TraceID)
+		s.Equal("read", recorded.Action)
+		s.Equal("SystemIntake", recorded.ResourceType)
+		s.Equal(null.StringFrom("6c2d1c0e-cfb6-4d7d-bc6c-a8a1e1b0f2f6"), recorded.ResourceID)
+	})
+
+	s.Run("saves the outcome of the action", func() {
+		var recorded *models.AuditEvent
+		recorder := func(_ context.Context, event *models.AuditEvent) error {
+			recorded = event
+			return nil
+		}
+		ctx := WithRecorder(appcontext.WithPrincipal(context.Background(), principal), recorder)
+
+		Record(ctx, authz.ActionUpdate, "BusinessCase", "1", nil)
+		s.Equal(models.AuditEventOutcomeSUCCEEDED, recorded.Outcome)
+
+		Record(ctx, authz.ActionUpdate, "BusinessCase", "1", &apperrors.UnauthorizedError{Err: errors.New("denied")})
+		s.Equal(models.AuditEventOutcomeDENIED, recorded.Outcome)
+
+		Record(ctx, authz.ActionUpdate, "BusinessCase", "1", &apperrors.ResourceConflictError{Err: errors.New("conflict")})
+		s.Equal(models.AuditEventOutcomeFAILED, recorded.Outcome)
+
+		Record(ctx, authz.ActionCreate, "BusinessCase", "", errors.New("failed"))
+		s.False(recorded.ResourceID.Valid)
+	})
+
+	s.Run("does nothing without a recorder", func() {
+		s.NotPanics(func() {
+			Record(context.Background(), authz.ActionRead, "SystemIntake", "", nil)
+		})
+	})
+
+	s.Run("logs a failure to record", func() {
+		core, logs := observer.New(zapcore.ErrorLevel)
+		recorder := func(context.Context, *models.AuditEvent) error {
+			return errors.New("forced error")
+		}
+		ctx := WithRecorder(appcontext.WithLogger(context.Background(), zap.New(core)), recorder)
+
+		Record(ctx, authz.ActionRead, "SystemIntake", "", nil)
+
+		s.Equal(1, logs.Len())
+	})
+}
@@ -0,0 +1,163 @@
+// Package authz decides what an EASi principal may do to a resource
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// Action is something a principal can do to a resource
+type Action string
+
+const (
+	// ActionCreate is for creating a resource
+	ActionCreate Action = "create"
+	// ActionRead is for viewing a resource
+	ActionRead Action = "read"
+	// ActionUpdate is for editing a resource
+	ActionUpdate Action = "update"
+	// ActionDelete is for archiving or removing a resource
+	ActionDelete Action = "delete"
+	// ActionSubmit is for a requester sending their request to the GRT
+	ActionSubmit Action = "submit"
+	// ActionReview is for the GRT's governance decisions on a request
+	ActionReview Action = "review"
+	// ActionShare is for changing who else can work on a request
+	ActionShare Action = "share"
+	// ActionRemove is for the GRT soft deleting a request or document, or restoring it
+	ActionRemove Action = "remove"
+)
+
+// Can reports whether the principal may take the action on the resource.
+//
+// Resources are pointers to models. A nil pointer stands for any resource of that type,
+// so it only passes rules that depend on job codes alone. Actions taken on a request are
+// governed by submitting or reviewing the request's intake. A request's documents have no
+// rule of their own: callers authorize them against the request they belong to.
+// Delegates the requester has shared an intake with get the same access to the intake
+// and its business case as their role allows.
+func Can(principal authn.Principal, action Action, resource interface{}) bool {
+	if principal == nil {
+		return false
+	}
+	switch r := resource.(type) {
+	case *models.SystemIntake:
+		return canSystemIntake(principal, action, r)
+	case *models.BusinessCase:
+		return canBusinessCase(principal, action, r)
+	case *models.Note:
+		return (action == ActionRead || action == ActionCreate) && principal.AllowGRT()
+	case *models.Action:
+		return action == ActionRead && principal.AllowGRT()
+	case *models.AccessibilityRequest, *models.AccessibilityRequestDocument:
+		return canAccessibility(principal, action)
+	case models.SystemIntakes, models.BusinessCases:
+		// lists are scoped to what the principal may read when they are fetched
+		return action == ActionRead && principal.AllowEASi()
+	case *models.AuditEvent, *models.FieldChange:
+		return action == ActionRead && principal.AllowGRT()
+	}
+	return false
+}
+
+// Authorize reports whether the context's principal may take the action on the resource,
+// logging it when it is denied. Services audit the action itself once it's done.
+func Authorize(ctx context.Context, action Action, resource interface{}) bool {
+	principal := appcontext.Principal(ctx)
+	allowed := Can(principal, action, resource)
+	if !allowed {
+		appcontext.ZLogger(ctx).Info(
+			"authorization denied",
+			zap.String("principal", principal.ID()),
+			zap.String("action", string(action)),
+			zap.String("resource", fmt.Sprintf("%T", resource)),
+			zap.Bool("Authorized", false),
+		)
+	}
+	return allowed
+}
+
+// requestRole is how a principal is involved with a request
+type requestRole int
+
+const (
+	roleNone requestRole = iota
+	roleViewer
+	roleCoOwner
+	roleOwner
+)
+
+func roleFor(principal authn.Principal, euaUserIDs []string, delegates models.SystemIntakeDelegates) requestRole {
+	if !principal.AllowEASi() || principal.ID() == "" {
+		return roleNone
+	}
+	for _, euaUserID := range euaUserIDs {
+		if principal.ID() == euaUserID {
+			return roleOwner
+		}
+	}
+	switch delegates.RoleFor(principal.ID()) {
+	case models.SystemIntakeDelegateRoleCOOWNER:
+		return roleCoOwner
+	case models.SystemIntakeDelegateRoleVIEWER:
+		return roleViewer
+	}
+	return roleNone
+}
+
+func canSystemIntake(principal authn.Principal, action Action, intake *models.SystemIntake) bool {
+	role := roleNone
+	if intake != nil {
+		role = roleFor(principal, []string{intake.EUAUserID.ValueOrZero()}, intake.Delegates)
+	}
+	switch action {
+	case ActionCreate:
+		return principal.AllowEASi()
+	case ActionRead:
+		return role >= roleViewer || principal.AllowGRT()
+	case ActionUpdate:
+		return role >= roleCoOwner || principal.AllowGRT()
+	case ActionSubmit:
+		return role >= roleCoOwner
+	case ActionDelete, ActionShare:
+		return role == roleOwner
+	case ActionReview, ActionRemove:
+		return principal.AllowGRT()
+	}
+	return false
+}
+
+func canBusinessCase(principal authn.Principal, action Action, businessCase *models.BusinessCase) bool {
+	role := roleNone
+	if businessCase != nil {
+		owners := []string{businessCase.EUAUserID, businessCase.SystemIntakeEUAUserID.ValueOrZero()}
+		role = roleFor(principal, owners, businessCase.Delegates)
+	}
+	switch action {
+	case ActionRead:
+		return role >= roleViewer || principal.AllowGRT()
+	case ActionCreate, ActionUpdate, ActionSubmit:
+		return role >= roleCoOwner
+	case ActionReview, ActionRemove:
+		return principal.AllowGRT()
+	}
+	return false
+}
+
+func canAccessibility(principal authn.Principal, action Action) bool {
+	switch action {
+	case ActionRead, ActionCreate:
+		return principal.Allow508User() || principal.Allow508Tester()
+	case ActionUpdate, ActionDelete:
+		return principal.Allow508Tester()
+	case ActionRemove:
+		return principal.AllowGRT()
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type AuthzTestSuite struct {
+	suite.Suite
+}
+
+func TestAuthzTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthzTestSuite))
+}
+
+var (
+	requester = &authn.EUAPrincipal{EUAID: "REQ", JobCodeEASi: true}
+	stranger  = &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true}
+	reviewer  = &authn.EUAPrincipal{EUAID: "REV", JobCodeEASi: true, JobCodeGRT: true}
+	// a requester whose EASi job code has been removed
+	formerRequester = &authn.EUAPrincipal{EUAID: "REQ"}
+	coOwner         = &authn.EUAPrincipal{EUAID: "COOW", JobCodeEASi: true}
+	viewer          = &authn.EUAPrincipal{EUAID: "VIEW", JobCodeEASi: true}
+	formerCoOwner   = &authn.EUAPrincipal{EUAID: "COOW"}
+	tester          = &authn.EUAPrincipal{EUAID: "TEST", JobCode508Tester: true}
+	user508         = &authn.EUAPrincipal{EUAID: "A11Y", JobCode508User: true}
+)
+
+func (s AuthzTestSuite) TestCan() {
+	intake := &models.SystemIntake{EUAUserID: null.StringFrom("REQ")}
+	unownedIntake := &models.SystemIntake{}
+	businessCase := &models.BusinessCase{EUAUserID: "REQ"}
+	delegates := models.SystemIntakeDelegates{
+		{EUAUserID: "COOW", Role: models.SystemIntakeDelegateRoleCOOWNER},
+		{EUAUserID: "VIEW", Role: models.SystemIntakeDelegateRoleVIEWER},
+	}
+	sharedIntake := &models.SystemIntake{EUAUserID: null.StringFrom("REQ"), Delegates: delegates}
+	// a business case started by a co-owner still belongs to the intake's requester
+	sharedBusinessCase := &models.BusinessCase{EUAUserID: "COOW", SystemIntakeEUAUserID: null.StringFrom("REQ"), Delegates: delegates}
+	accessibilityRequest := &models.AccessibilityRequest{}
+	document := &models.AccessibilityRequestDocument{}
+
+	testCases := []struct {
+		principal authn.Principal
+		action    Action
+		resource  interface{}
+		allowed   bool
+	}{
+		{requester, ActionCreate, (*models.SystemIntake)(nil), true},
+		{tester, ActionCreate, (*models.SystemIntake)(nil), false},
+		{requester, ActionRead, intake, true},
+		{stranger, ActionRead, intake, false},
+		{reviewer, ActionRead, intake, true},
+		{formerRequester, ActionRead, intake, false},
+		{authn.ANON, ActionRead, unownedIntake, false},
+		{requester, ActionUpdate, intake, true},
+		{reviewer, ActionUpdate, intake, true},
+		{stranger, ActionUpdate, intake, false},
+		{requester, ActionSubmit, intake, true},
+		{reviewer, ActionSubmit, intake, false},
+		{requester, ActionDelete, intake, true},
+		{reviewer, ActionDelete, intake, false},
+		{requester, ActionReview, intake, false},
+		{reviewer, ActionReview, (*models.SystemIntake)(nil), true},
+
+		{requester, ActionRead, businessCase, true},
+		{stranger, ActionRead, businessCase, false},
+		{reviewer, ActionRead, businessCase, true},
+		{requester, ActionUpdate, businessCase, true},
+		{reviewer, ActionUpdate, businessCase, false},
+		{requester, ActionReview, businessCase, false},
+		{reviewer, ActionReview, businessCase, true},
+
+		{coOwner, ActionRead, sharedIntake, true},
+		{coOwner, ActionUpdate, sharedIntake, true},
+		{coOwner, ActionSubmit, sharedIntake, true},
+		{coOwner, ActionDelete, sharedIntake, false},
+		{coOwner, ActionShare, sharedIntake, false},
+		{coOwner, ActionUpdate, intake, false},
+		{viewer, ActionRead, sharedIntake, true},
+		{viewer, ActionUpdate, sharedIntake, false},
+		{viewer, ActionSubmit, sharedIntake, false},
+		{requester, ActionShare, sharedIntake, true},
+		{reviewer, ActionShare, sharedIntake, false},
+		{formerCoOwner, ActionRead, sharedIntake, false},
+		{requester, ActionUpdate, sharedBusinessCase, true},
+		{coOwner, ActionUpdate, sharedBusinessCase, true},
+		{viewer, ActionRead, sharedBusinessCase, true},
+		{viewer, ActionUpdate, sharedBusinessCase, false},
+
+		{reviewer, ActionRead, &models.Note{}, true},
+		{reviewer, ActionCreate, (*models.Note)(nil), true},
+		{requester, ActionRead, &models.Note{}, false},
+		{reviewer, ActionDelete, &models.Note{}, false},
+
+		{reviewer, ActionRead, (*models.Action)(nil), true},
+		{requester, ActionRead, (*models.Action)(nil), false},
+
+		{user508, ActionRead, accessibilityRequest, true},
+		{user508, ActionCreate, (*models.AccessibilityRequest)(nil), true},
+		{user508, ActionDelete, accessibilityRequest, false},
+		{tester, ActionUpdate, accessibilityRequest, true},
+		{requester, ActionRead, accessibilityRequest, false},
+		{reviewer, ActionRead, (*models.AccessibilityRequest)(nil), false},
+		{user508, ActionCreate, document, true},
+		{tester, ActionDelete, document, true},
+		{user508, ActionDelete, document, false},
+
+		{reviewer, ActionRemove, (*models.SystemIntake)(nil), true},
+		{requester, ActionRemove, intake, false},
+		{reviewer, ActionRemove, (*models.BusinessCase)(nil), true},
+		{requester, ActionRemove, businessCase, false},
+		{reviewer, ActionRemove, (*models.AccessibilityRequest)(nil), true},
+		{tester, ActionRemove, accessibilityRequest, false},
+		{reviewer, ActionRemove, (*models.AccessibilityRequestDocument)(nil), true},
+
+		{requester, ActionRead, models.SystemIntakes(nil), true},
+		{requester, ActionRead, models.BusinessCases(nil), true},
+		{tester, ActionRead, models.SystemIntakes(nil), false},
+		{requester, ActionUpdate, models.SystemIntakes(nil), false},
+
+		{reviewer, ActionRead, (*models.AuditEvent)(nil), true},
+		{requester, ActionRead, (*models.AuditEvent)(nil), false},
+		{reviewer, ActionRead, (*models.FieldChange)(nil), true},
+		{requester, ActionRead, (*models.FieldChange)(nil), false},
+
+		{reviewer, ActionRead, &models.System{}, false},
+		{nil, ActionRead, intake, false},
+	}
+
+	for _, tc := range testCases {
+		name := fmt.Sprintf("%v %s %T", tc.principal, tc.action, tc.resource)
+		s.Run(name, func() {
+			s.Equal(tc.allowed, Can(tc.principal, tc.action, tc.resource))
+		})
+	}
+}
+
+func (s AuthzTestSuite) TestAuthorize() {
+	core, logs := observer.New(zapcore.InfoLevel)
+	ctx := appcontext.WithLogger(context.Background(), zap.New(core))
+
+	s.Run("allows without logging", func() {
+		reviewerCtx := appcontext.WithPrincipal(ctx, reviewer)
+
+		s.True(Authorize(reviewerCtx, ActionReview, &models.SystemIntake{}))
+		s.Equal(0, logs.Len())
+	})
+
+	s.Run("logs denials", func() {
+		requesterCtx := appcontext.WithPrincipal(ctx, requester)
+
+		s.False(Authorize(requesterCtx, ActionReview, &models.SystemIntake{}))
+
+		entries := logs.TakeAll()
+		s.Len(entries, 1)
+		fields := entries[0].ContextMap()
+		s.Equal("REQ", fields["principal"])
+		s.Equal("review", fields["action"])
+		s.Equal("*models.SystemIntake", fields["resource"])
+	})
+
+	s.Run("denies a context without a principal", func() {
+		s.False(Authorize(ctx, ActionRead, &models.SystemIntake{}))
+		s.Equal(1, logs.Len())
+	})
+}
@@ -0,0 +1,118 @@
+package cedareasi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/guregu/null"
+	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/cedar/resilience"
+	"github.com/cmsgov/easi-app/pkg/local/cedargateway"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s CedarEasiTestSuite) TestTranslatedClientAgainstGateway() {
+	ctx := context.Background()
+	gateway := cedargateway.NewGateway("fake-key")
+	server := httptest.NewServer(gateway.Handler())
+	defer server.Close()
+
+	ldClient, err := ld.MakeCustomClient("fake", ld.Config{Offline: true}, 0)
+	s.NoError(err)
+	newClient := func(apiKey string, transport http.RoundTripper) TranslatedClient {
+		client := NewTranslatedClient(server.URL, apiKey, ldClient, transport)
+		client.emitToCedar = func(context.Context) bool { return true }
+		return client
+	}
+	client := newClient("fake-key", nil)
+
+	s.Run("checks the connection", func() {
+		s.NoError(client.CheckConnection(ctx))
+		s.Error(newClient("wrong-key", nil).CheckConnection(ctx))
+	})
+
+	intake := testhelpers.NewSystemIntake()
+	intake.Status = models.SystemIntakeStatusINTAKESUBMITTED
+	submittedAt := time.Now()
+	intake.SubmittedAt = &submittedAt
+
+	s.Run("submits, updates and fetches a system intake", func() {
+		alfabetID, err := client.ValidateAndSubmitSystemIntake(ctx, &intake)
+		s.NoError(err)
+		s.NotEmpty(alfabetID)
+		stored, ok := gateway.Intake(alfabetID)
+		s.True(ok)
+		s.Equal(intake.ID.String(), *stored.ID)
+
+		intake.AlfabetID = null.StringFrom(alfabetID)
+		intake.Status = models.SystemIntakeStatusLCIDISSUED
+		s.NoError(client.UpdateSystemIntake(ctx, &intake))
+
+		status, err := client.FetchSystemIntakeStatus(ctx, alfabetID)
+		s.NoError(err)
+		s.Equal(string(models.SystemIntakeStatusLCIDISSUED), status)
+	})
+
+	s.Run("doesn't update intakes while emitting to CEDAR is off", func() {
+		notEmitting := newClient("fake-key", nil)
+		notEmitting.emitToCedar = func(context.Context) bool { return false }
+
+		err := notEmitting.UpdateSystemIntake(ctx, &intake)
+
+		s.True(errors.Is(err, ErrNotEmitted))
+	})
+
+	s.Run("maps gateway failures to external API errors", func() {
+		gateway.InjectFault(cedargateway.Fault{
+			Operation: cedargateway.OperationUpdateIntake,
+			Status:    http.StatusInternalServerError,
+			Count:     1,
+		})
+
+		err := client.UpdateSystemIntake(ctx, &intake)
+
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+	})
+
+	s.Run("retries through the resilient transport", func() {
+		gateway.InjectFault(cedargateway.Fault{
+			Operation: cedargateway.OperationFetchIntake,
+			Status:    http.StatusBadGateway,
+			Count:     1,
+		})
+		calls := gateway.Calls(cedargateway.OperationFetchIntake)
+		resilientClient := newClient("fake-key", resilience.NewTransport("test", resilience.Config{
+			MaxRetries:     2,
+			RetryBaseDelay: time.Millisecond,
+		}, nil))
+
+		_, err := resilientClient.FetchSystemIntakeStatus(ctx, intake.AlfabetID.String)
+
+		s.NoError(err)
+		s.Equal(calls+2, gateway.Calls(cedargateway.OperationFetchIntake))
+	})
+
+	s.Run("fails fast once the circuit breaker opens", func() {
+		gateway.InjectFault(cedargateway.Fault{
+			Operation: cedargateway.OperationFetchIntake,
+			Status:    http.StatusInternalServerError,
+		})
+		defer gateway.ClearFaults()
+		resilientClient := newClient("fake-key", resilience.NewTransport("test", resilience.Config{
+			RetryBaseDelay:          time.Millisecond,
+			BreakerFailureThreshold: 1,
+		}, nil))
+
+		_, err := resilientClient.FetchSystemIntakeStatus(ctx, intake.AlfabetID.String)
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+		_, err = resilientClient.FetchSystemIntakeStatus(ctx, intake.AlfabetID.String)
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+		s.True(errors.Is(err, resilience.ErrCircuitOpen))
+	})
+}
@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -48,6 +50,9 @@ type GovernanceIntake struct {
 	// funding source
 	FundingSource string `json:"funding_source,omitempty"`
 
+	// funding sources
+	FundingSources []*GovernanceIntakeFundingSource `json:"funding_sources"`
+
 	// PRIMARY KEY, e.g. unique constraint
 	// Required: true
 	ID *string `json:"id"`
@@ -101,6 +106,10 @@ func (m *GovernanceIntake) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFundingSources(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -124,6 +133,31 @@ func (m *GovernanceIntake) validateEuaUserID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *GovernanceIntake) validateFundingSources(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.FundingSources) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.FundingSources); i++ {
+		if swag.IsZero(m.FundingSources[i]) { // not required
+			continue
+		}
+
+		if m.FundingSources[i] != nil {
+			if err := m.FundingSources[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("funding_sources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *GovernanceIntake) validateID(formats strfmt.Registry) error {
 
 	if err := validate.Required("id", "body", m.ID); err != nil {
@@ -3,6 +3,8 @@ package cedareasi
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/cedar"
 	apiclient "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/client"
 	apioperations "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/client/operations"
 	apimodels "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/models"
@@ -27,6 +30,9 @@ const (
 	dateTimeLayout = "2006-01-02 15:04:05"
 )
 
+// ErrNotEmitted is returned for an update that wasn't sent because emitting to CEDAR is turned off
+var ErrNotEmitted = errors.New("emitting to CEDAR is turned off")
+
 // TranslatedClient is an API client for CEDAR EASi using EASi language
 type TranslatedClient struct {
 	client        *apiclient.EASiCoreAPI
@@ -38,12 +44,20 @@ type TranslatedClient struct {
 type Client interface {
 	CheckConnection(context.Context) error
 	ValidateAndSubmitSystemIntake(context.Context, *models.SystemIntake) (string, error)
+	ValidateAndSubmitBusinessCase(context.Context, *models.BusinessCase) (string, error)
+	UpdateSystemIntake(context.Context, *models.SystemIntake) error
+	FetchSystemIntakeStatus(context.Context, string) (string, error)
 }
 
-// NewTranslatedClient returns an API client for CEDAR EASi using EASi language
-func NewTranslatedClient(cedarHost string, cedarAPIKey string, ldClient *ld.LDClient) TranslatedClient {
+// NewTranslatedClient returns an API client for CEDAR EASi using EASi language,
+// sending requests through roundTripper when it is given
+func NewTranslatedClient(cedarHost string, cedarAPIKey string, ldClient *ld.LDClient, roundTripper http.RoundTripper) TranslatedClient {
 	// create the transport
-	transport := httptransport.New(cedarHost, apiclient.DefaultBasePath, []string{"https"})
+	host, schemes := cedar.HostAndSchemes(cedarHost)
+	transport := httptransport.New(host, apiclient.DefaultBasePath, schemes)
+	if roundTripper != nil {
+		transport.Transport = roundTripper
+	}
 
 	// create the API client, with the transport
 	client := apiclient.New(transport, strfmt.Default)
@@ -117,7 +131,7 @@ func ValidateSystemIntakeForCedar(ctx context.Context, intake *models.SystemInta
 	if validate.RequireNullBool(intake.ExistingFunding) {
 		expectedError.WithValidation("ExistingFunding", validationMessage)
 	}
-	if intake.ExistingFunding.Bool {
+	if intake.ExistingFunding.Bool && len(intake.FundingSources) == 0 {
 		if validate.RequireNullString(intake.FundingNumber) {
 			expectedError.WithValidation("FundingNumber", validationMessage)
 		}
@@ -125,6 +139,23 @@ func ValidateSystemIntakeForCedar(ctx context.Context, intake *models.SystemInta
 			expectedError.WithValidation("FundingNumber", "must be a 6 digit string")
 		}
 	}
+	for i, fundingSource := range intake.FundingSources {
+		key := fmt.Sprintf("FundingSources[%d]", i)
+		if validate.RequireNullString(fundingSource.Source) {
+			expectedError.WithValidation(key+".Source", validationMessage)
+		}
+		if validate.RequireNullString(fundingSource.FundingNumber) {
+			expectedError.WithValidation(key+".FundingNumber", validationMessage)
+		} else if validate.FundingNumberInvalid(fundingSource.FundingNumber.String) {
+			expectedError.WithValidation(key+".FundingNumber", "must be a 6 digit string")
+		}
+		if fundingSource.Amount != nil && *fundingSource.Amount < 0 {
+			expectedError.WithValidation(key+".Amount", "cannot be negative")
+		}
+		if fundingSource.FiscalYear != nil && validate.FiscalYearInvalid(*fundingSource.FiscalYear) {
+			expectedError.WithValidation(key+".FiscalYear", "must be a 4 digit year")
+		}
+	}
 	if validate.RequireNullString(intake.BusinessNeed) {
 		expectedError.WithValidation("BusinessNeed", validationMessage)
 	}
@@ -160,6 +191,7 @@ func ValidateSystemIntakeForCedar(ctx context.Context, intake *models.SystemInta
 
 func systemIntakeToGovernanceIntake(si *models.SystemIntake) *apimodels.GovernanceIntake {
 	id := si.ID.String()
+	status := string(si.Status)
 	gi := &apimodels.GovernanceIntake{
 		BusinessNeeds:           si.BusinessNeed.ValueOrZero(),
 		BusinessOwner:           si.BusinessOwner.ValueOrZero(),
@@ -179,9 +211,27 @@ func systemIntakeToGovernanceIntake(si *models.SystemIntake) *apimodels.Governan
 		Requester:               si.Requester,
 		RequesterComponent:      si.Component.ValueOrZero(),
 		Solution:                si.Solution.ValueOrZero(),
+		Status:                  &status,
 		SystemName:              si.ProjectName.ValueOrZero(),
 		TrbCollaborator:         si.TRBCollaborator.ValueOrZero(),
 	}
+	for _, fundingSource := range si.FundingSources {
+		gfs := &apimodels.GovernanceIntakeFundingSource{
+			FundingNumber: fundingSource.FundingNumber.ValueOrZero(),
+			Source:        fundingSource.Source.ValueOrZero(),
+		}
+		if fundingSource.Amount != nil {
+			gfs.Amount = int64(*fundingSource.Amount)
+		}
+		if fundingSource.FiscalYear != nil {
+			gfs.FiscalYear = int64(*fundingSource.FiscalYear)
+		}
+		gi.FundingSources = append(gi.FundingSources, gfs)
+	}
+	// CEDAR still reads the single funding source field, so keep it populated
+	if gi.FundingSource == "" && len(si.FundingSources) > 0 {
+		gi.FundingSource = si.FundingSources[0].Source.ValueOrZero()
+	}
 	if si.SubmittedAt != nil {
 		gi.SubmittedAt = si.SubmittedAt.Format(dateTimeLayout)
 	}
@@ -250,3 +300,262 @@ func (c TranslatedClient) ValidateAndSubmitSystemIntake(ctx context.Context, int
 	}
 	return alfabetID, nil
 }
+
+// UpdateSystemIntake sends the current state of an already submitted system intake to CEDAR.
+// It returns ErrNotEmitted when we aren't sending to CEDAR, so the update can be kept for later.
+func (c TranslatedClient) UpdateSystemIntake(ctx context.Context, intake *models.SystemIntake) error {
+	if !intake.AlfabetID.Valid {
+		return &apperrors.ResourceConflictError{
+			Err:        errors.New("intake has not been submitted to CEDAR"),
+			ResourceID: intake.ID.String(),
+			Resource:   intake,
+		}
+	}
+	// we may not be sending SystemIntakes to CEDAR currently
+	if !c.emitToCedar(ctx) {
+		return ErrNotEmitted
+	}
+	params := apioperations.NewIntakegovernanceidPUT6ParamsWithContext(ctx)
+	params.ID = intake.AlfabetID.String
+	params.Body = &apimodels.IntakeUpdate{
+		Governance: systemIntakeToGovernanceIntake(intake),
+	}
+	resp, err := c.client.Operations.IntakegovernanceidPUT6(params, c.apiAuthHeader)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to update intake in CEDAR", zap.Error(err))
+		return &apperrors.ExternalAPIError{
+			Err:       err,
+			Model:     intake,
+			ModelID:   intake.ID.String(),
+			Operation: apperrors.Submit,
+			Source:    "CEDAR",
+		}
+	}
+	if *resp.Payload.Response.Result != "success" {
+		return &apperrors.ExternalAPIError{
+			Err:       errors.New("CEDAR return result: " + *resp.Payload.Response.Result),
+			ModelID:   intake.ID.String(),
+			Model:     intake,
+			Operation: apperrors.Submit,
+			Source:    "CEDAR",
+		}
+	}
+	return nil
+}
+
+// FetchSystemIntakeStatus returns the status CEDAR has for the intake with the given alfabet ID
+func (c TranslatedClient) FetchSystemIntakeStatus(ctx context.Context, alfabetID string) (string, error) {
+	params := apioperations.NewIntakegovernanceidGET6ParamsWithContext(ctx)
+	params.ID = alfabetID
+	resp, err := c.client.Operations.IntakegovernanceidGET6(params, c.apiAuthHeader)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to fetch intake from CEDAR", zap.Error(err))
+		return "", &apperrors.ExternalAPIError{
+			Err:       err,
+			ModelID:   alfabetID,
+			Operation: apperrors.Fetch,
+			Source:    "CEDAR",
+		}
+	}
+	if resp.Payload.Intake == nil || resp.Payload.Intake.Governance == nil || resp.Payload.Intake.Governance.Status == nil {
+		return "", nil
+	}
+	return *resp.Payload.Intake.Governance.Status, nil
+}
+
+// ValidateBusinessCaseForCedar validates all required fields to ensure we won't get errors for contents of the request
+func ValidateBusinessCaseForCedar(ctx context.Context, businessCase *models.BusinessCase) error {
+	expectedError := apperrors.ValidationError{
+		Err:         errors.New("validation failed"),
+		Validations: apperrors.Validations{},
+		ModelID:     businessCase.ID.String(),
+		Model:       businessCase,
+	}
+	const validationMessage = "is required"
+	if validate.RequireUUID(businessCase.ID) {
+		expectedError.WithValidation("ID", validationMessage)
+	}
+	if validate.RequireString(businessCase.EUAUserID) {
+		expectedError.WithValidation("EUAUserID", validationMessage)
+	}
+	if validate.RequireUUID(businessCase.SystemIntakeID) {
+		expectedError.WithValidation("SystemIntakeID", validationMessage)
+	}
+	if validate.RequireString(string(businessCase.Status)) {
+		expectedError.WithValidation("Status", validationMessage)
+	}
+	if validate.RequireNullString(businessCase.ProjectName) {
+		expectedError.WithValidation("ProjectName", validationMessage)
+	}
+	if validate.RequireNullString(businessCase.Requester) {
+		expectedError.WithValidation("Requester", validationMessage)
+	}
+	if validate.RequireNullString(businessCase.BusinessOwner) {
+		expectedError.WithValidation("BusinessOwner", validationMessage)
+	}
+	if validate.RequireNullString(businessCase.BusinessNeed) {
+		expectedError.WithValidation("BusinessNeed", validationMessage)
+	}
+	if len(businessCase.Alternatives) == 0 {
+		expectedError.WithValidation("Alternatives", validationMessage)
+	}
+	if businessCase.LastSubmittedAt == nil || validate.RequireTime(*businessCase.LastSubmittedAt) {
+		expectedError.WithValidation("LastSubmittedAt", validationMessage)
+	}
+	if len(expectedError.Validations) > 0 {
+		return &expectedError
+	}
+	return nil
+}
+
+func businessCaseToCedarBusinessCase(bc *models.BusinessCase) *apimodels.BusinessCase {
+	id := bc.ID.String()
+	governanceID := bc.SystemIntakeID.String()
+	euaUserID := bc.EUAUserID
+	status := string(bc.Status)
+	cbc := &apimodels.BusinessCase{
+		BusinessNeed:         bc.BusinessNeed.ValueOrZero(),
+		BusinessOwner:        bc.BusinessOwner.ValueOrZero(),
+		CmsBenefit:           bc.CMSBenefit.ValueOrZero(),
+		EuaUserID:            &euaUserID,
+		GovernanceID:         &governanceID,
+		ID:                   &id,
+		PriorityAlignment:    bc.PriorityAlignment.ValueOrZero(),
+		ProjectName:          bc.ProjectName.ValueOrZero(),
+		Requester:            bc.Requester.ValueOrZero(),
+		RequesterPhoneNumber: bc.RequesterPhoneNumber.ValueOrZero(),
+		Solutions:            []*apimodels.BusinessCaseSolution{},
+		Status:               &status,
+		SuccessIndicators:    bc.SuccessIndicators.ValueOrZero(),
+	}
+	for _, alternative := range bc.Alternatives {
+		cbc.Solutions = append(cbc.Solutions, alternativeToCedarSolution(alternative, bc.LifecycleCostLines))
+	}
+	// CEDAR only has a single field for hosting and user interface, so send the preferred solution's
+	for _, alternative := range bc.Alternatives {
+		if alternative.Role == models.BusinessCaseAlternativeRolePREFERRED {
+			cbc.HostingNeeds = alternative.HostingType.ValueOrZero()
+			cbc.UserInterface = alternative.HasUI.ValueOrZero()
+		}
+	}
+	if bc.InitialSubmittedAt != nil {
+		cbc.InitialSubmittedAt = bc.InitialSubmittedAt.Format(dateTimeLayout)
+	}
+	if bc.LastSubmittedAt != nil {
+		cbc.LastSubmittedAt = bc.LastSubmittedAt.Format(dateTimeLayout)
+	}
+	if bc.ArchivedAt != nil {
+		cbc.WithdrawnAt = bc.ArchivedAt.Format(dateTimeLayout)
+	}
+	return cbc
+}
+
+func alternativeToCedarSolution(alternative models.BusinessCaseAlternative, lines models.EstimatedLifecycleCosts) *apimodels.BusinessCaseSolution {
+	id := alternative.ID.String()
+	solution := &apimodels.BusinessCaseSolution{
+		Cons:               alternative.Cons.ValueOrZero(),
+		CostSavings:        alternative.CostSavings.ValueOrZero(),
+		ID:                 &id,
+		LifecycleCostLines: []*apimodels.LifecycleCostLine{},
+		Pros:               alternative.Pros.ValueOrZero(),
+		Summary:            alternative.Summary.ValueOrZero(),
+		Title:              alternative.Title.ValueOrZero(),
+		Type:               string(alternative.Role),
+	}
+	for _, line := range lines {
+		if line.AlternativeID == nil || *line.AlternativeID != alternative.ID {
+			continue
+		}
+		lineID := line.ID.String()
+		cedarLine := &apimodels.LifecycleCostLine{
+			ID:   &lineID,
+			Year: string(line.Year),
+		}
+		if line.Phase != nil {
+			cedarLine.Phase = string(*line.Phase)
+		}
+		if line.Cost != nil {
+			cedarLine.Cost = int32(*line.Cost)
+		}
+		solution.LifecycleCostLines = append(solution.LifecycleCostLines, cedarLine)
+	}
+	return solution
+}
+
+func submitBusinessCase(ctx context.Context, validatedBusinessCase *models.BusinessCase, c TranslatedClient) (string, error) {
+	var response *apimodels.Response1
+	var err error
+	// business cases already known to CEDAR are updated in place
+	if validatedBusinessCase.CedarID.Valid {
+		params := apioperations.NewIntakebusinessCaseidPUT8ParamsWithContext(ctx)
+		params.ID = validatedBusinessCase.CedarID.String
+		params.Body = &apimodels.Intake3{
+			BusinessCase: businessCaseToCedarBusinessCase(validatedBusinessCase),
+		}
+		resp, putErr := c.client.Operations.IntakebusinessCaseidPUT8(params, c.apiAuthHeader)
+		if putErr == nil {
+			response = resp.Payload.Response
+		}
+		err = putErr
+	} else {
+		params := apioperations.NewIntakebusinessCasePOST7ParamsWithContext(ctx)
+		params.Body = &apimodels.Intake2{
+			BusinessCase: businessCaseToCedarBusinessCase(validatedBusinessCase),
+		}
+		resp, postErr := c.client.Operations.IntakebusinessCasePOST7(params, c.apiAuthHeader)
+		if postErr == nil {
+			response = resp.Payload.Response
+		}
+		err = postErr
+	}
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to submit business case for CEDAR", zap.Error(err))
+		return "", &apperrors.ExternalAPIError{
+			Err:       err,
+			Model:     validatedBusinessCase,
+			ModelID:   validatedBusinessCase.ID.String(),
+			Operation: apperrors.Submit,
+			Source:    "CEDAR",
+		}
+	}
+	if *response.Result != "success" {
+		return "", &apperrors.ExternalAPIError{
+			Err:       errors.New("CEDAR return result: " + *response.Result),
+			ModelID:   validatedBusinessCase.ID.String(),
+			Model:     validatedBusinessCase,
+			Operation: apperrors.Submit,
+			Source:    "CEDAR",
+		}
+	}
+	if len(response.Message) == 0 {
+		return validatedBusinessCase.CedarID.ValueOrZero(), nil
+	}
+	return response.Message[0], nil
+}
+
+// ValidateAndSubmitBusinessCase submits a business case to CEDAR
+func (c TranslatedClient) ValidateAndSubmitBusinessCase(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+	err := ValidateBusinessCaseForCedar(ctx, businessCase)
+	if err != nil {
+		return "", err
+	}
+	// we may not be sending BusinessCases to CEDAR currently
+	if !c.emitToCedar(ctx) {
+		return "", nil
+	}
+	cedarID, err := submitBusinessCase(ctx, businessCase, c)
+	if err != nil {
+		return "", err
+	}
+	// if we are submitting to CEDAR, we expect a non-empty value back
+	if cedarID == "" {
+		return "", &apperrors.ExternalAPIError{
+			Err:       errors.New("submission was not successful"),
+			Model:     businessCase,
+			ModelID:   businessCase.ID.String(),
+			Operation: apperrors.Submit,
+			Source:    "CEDAR EASi",
+		}
+	}
+	return cedarID, nil
+}
@@ -125,6 +125,28 @@ func (s CedarEasiTestSuite) TestValidateSystemIntakeForCedar() {
 		s.NoError(err)
 	})
 
+	s.Run("An intake with funding sources validates each entry instead of the funding number", func() {
+		intake.ExistingFunding = null.BoolFrom(true)
+		intake.FundingSources = models.SystemIntakeFundingSources{
+			{Source: null.StringFrom("CLIA"), FundingNumber: null.StringFrom("123456")},
+			{Source: null.StringFrom("Fed Admin"), FundingNumber: null.StringFrom("12")},
+		}
+		err := ValidateSystemIntakeForCedar(ctx, &intake)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrString := fmt.Sprintf(
+			"Could not validate *models.SystemIntake %s: {\"FundingSources[1].FundingNumber\":\"must be a 6 digit string\"}",
+			id.String(),
+		)
+		s.EqualError(err, expectedErrString)
+
+		intake.FundingSources[1].FundingNumber = null.StringFrom("654321")
+		s.NoError(ValidateSystemIntakeForCedar(ctx, &intake))
+
+		// Reset intake fields
+		intake.ExistingFunding = null.BoolFrom(false)
+		intake.FundingSources = nil
+	})
+
 	s.Run("An intake without a required string fails", func() {
 		intake.EUAUserID = null.StringFrom("")
 		err := ValidateSystemIntakeForCedar(ctx, &intake)
@@ -167,3 +189,136 @@ func (s CedarEasiTestSuite) TestValidateSystemIntakeForCedar() {
 		intake.SubmittedAt = &clockTime
 	})
 }
+
+func (s CedarEasiTestSuite) TestSystemIntakeToGovernanceIntake() {
+	amount := 1000
+	fiscalYear := 2021
+	intake := models.SystemIntake{
+		ID:        uuid.New(),
+		EUAUserID: null.StringFrom("FAKE"),
+		Status:    models.SystemIntakeStatusLCIDISSUED,
+		FundingSources: models.SystemIntakeFundingSources{
+			{
+				Source:        null.StringFrom("CLIA"),
+				FundingNumber: null.StringFrom("123456"),
+				Amount:        &amount,
+				FiscalYear:    &fiscalYear,
+			},
+			{
+				Source:        null.StringFrom("Fed Admin"),
+				FundingNumber: null.StringFrom("654321"),
+			},
+		},
+	}
+
+	gi := systemIntakeToGovernanceIntake(&intake)
+
+	s.Len(gi.FundingSources, 2)
+	s.Equal("CLIA", gi.FundingSources[0].Source)
+	s.Equal("123456", gi.FundingSources[0].FundingNumber)
+	s.Equal(int64(1000), gi.FundingSources[0].Amount)
+	s.Equal(int64(2021), gi.FundingSources[0].FiscalYear)
+	s.Equal(int64(0), gi.FundingSources[1].Amount)
+	s.Equal("CLIA", gi.FundingSource)
+	s.Equal("LCID_ISSUED", *gi.Status)
+}
+
+func (s CedarEasiTestSuite) TestValidateBusinessCaseForCedar() {
+	ctx := context.Background()
+	clockTime := clock.NewMock().Now()
+	id := uuid.New()
+	businessCase := models.BusinessCase{
+		ID:              id,
+		EUAUserID:       "FAKE",
+		SystemIntakeID:  uuid.New(),
+		Status:          models.BusinessCaseStatusOPEN,
+		ProjectName:     null.StringFrom("Fake Project Name"),
+		Requester:       null.StringFrom("Fake Requester"),
+		BusinessOwner:   null.StringFrom("Fake Business Owner"),
+		BusinessNeed:    null.StringFrom("Fake Business Need"),
+		LastSubmittedAt: &clockTime,
+		Alternatives: models.BusinessCaseAlternatives{
+			{ID: uuid.New(), Role: models.BusinessCaseAlternativeRoleASIS},
+		},
+	}
+
+	s.Run("A valid business case passes validation", func() {
+		s.NoError(ValidateBusinessCaseForCedar(ctx, &businessCase))
+	})
+
+	s.Run("A business case without a required field fails", func() {
+		businessCase.BusinessNeed = null.String{}
+		err := ValidateBusinessCaseForCedar(ctx, &businessCase)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrString := fmt.Sprintf(
+			"Could not validate *models.BusinessCase %s: {\"BusinessNeed\":\"is required\"}",
+			id.String(),
+		)
+		s.EqualError(err, expectedErrString)
+
+		// Reset business case fields
+		businessCase.BusinessNeed = null.StringFrom("Fake Business Need")
+	})
+
+	s.Run("A business case without alternatives fails", func() {
+		alternatives := businessCase.Alternatives
+		businessCase.Alternatives = nil
+		err := ValidateBusinessCaseForCedar(ctx, &businessCase)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrString := fmt.Sprintf(
+			"Could not validate *models.BusinessCase %s: {\"Alternatives\":\"is required\"}",
+			id.String(),
+		)
+		s.EqualError(err, expectedErrString)
+
+		// Reset business case fields
+		businessCase.Alternatives = alternatives
+	})
+}
+
+func (s CedarEasiTestSuite) TestBusinessCaseToCedarBusinessCase() {
+	asIsID := uuid.New()
+	preferredID := uuid.New()
+	development := models.LifecycleCostPhaseDEVELOPMENT
+	cost := 1200
+	businessCase := models.BusinessCase{
+		ID:             uuid.New(),
+		EUAUserID:      "FAKE",
+		SystemIntakeID: uuid.New(),
+		Status:         models.BusinessCaseStatusOPEN,
+		ProjectName:    null.StringFrom("Fake Project Name"),
+		Alternatives: models.BusinessCaseAlternatives{
+			{ID: asIsID, Role: models.BusinessCaseAlternativeRoleASIS, Title: null.StringFrom("As Is")},
+			{
+				ID:          preferredID,
+				Role:        models.BusinessCaseAlternativeRolePREFERRED,
+				Title:       null.StringFrom("Preferred"),
+				HostingType: null.StringFrom("cloud"),
+				HasUI:       null.StringFrom("YES"),
+			},
+		},
+		LifecycleCostLines: models.EstimatedLifecycleCosts{
+			{ID: uuid.New(), AlternativeID: &preferredID, Phase: &development, Year: models.LifecycleCostYear1, Cost: &cost},
+			{ID: uuid.New(), AlternativeID: &preferredID, Year: models.LifecycleCostYear2},
+			{ID: uuid.New(), AlternativeID: &asIsID, Year: models.LifecycleCostYear1},
+			{},
+		},
+	}
+
+	cbc := businessCaseToCedarBusinessCase(&businessCase)
+
+	s.Equal(businessCase.ID.String(), *cbc.ID)
+	s.Equal(businessCase.SystemIntakeID.String(), *cbc.GovernanceID)
+	s.Equal("OPEN", *cbc.Status)
+	s.Equal("Fake Project Name", cbc.ProjectName)
+	s.Equal("cloud", cbc.HostingNeeds)
+	s.Equal("YES", cbc.UserInterface)
+	s.Len(cbc.Solutions, 2)
+	s.Equal("AS_IS", cbc.Solutions[0].Type)
+	s.Len(cbc.Solutions[0].LifecycleCostLines, 1)
+	s.Equal("Preferred", cbc.Solutions[1].Title)
+	s.Len(cbc.Solutions[1].LifecycleCostLines, 2)
+	s.Equal("Development", cbc.Solutions[1].LifecycleCostLines[0].Phase)
+	s.Equal(int32(1200), cbc.Solutions[1].LifecycleCostLines[0].Cost)
+	s.Equal("2", cbc.Solutions[1].LifecycleCostLines[1].Year)
+}
@@ -0,0 +1,185 @@
+package cedarldap
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// CacheConfig configures how long user lookups are cached
+type CacheConfig struct {
+	// TTL is how long a found user is cached
+	TTL time.Duration
+	// NegativeTTL is how long an unknown EUA ID is cached
+	NegativeTTL time.Duration
+	// LookupTimeout bounds a lookup shared between callers, which none of their contexts can cancel
+	LookupTimeout time.Duration
+}
+
+// DefaultCacheConfig returns the cache settings used unless configured
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		TTL:           time.Hour,
+		NegativeTTL:   5 * time.Minute,
+		LookupTimeout: 30 * time.Second,
+	}
+}
+
+// pruneThreshold is the number of entries above which expired entries are removed on write
+const pruneThreshold = 10000
+
+type cacheEntry struct {
+	userInfo  *models.UserInfo
+	err       error
+	expiresAt time.Time
+}
+
+// CachedClient is a Client that caches user lookups, including unknown EUA IDs,
+// and shares a single lookup between concurrent callers
+type CachedClient struct {
+	client Client
+	config CacheConfig
+	clock  clock.Clock
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+	group   singleflight.Group
+
+	hits         uint64
+	negativeHits uint64
+	misses       uint64
+	evictions    uint64
+}
+
+// NewCachedClient wraps a Client with a cache
+func NewCachedClient(client Client, config CacheConfig) *CachedClient {
+	if config.LookupTimeout <= 0 {
+		config.LookupTimeout = DefaultCacheConfig().LookupTimeout
+	}
+	return &CachedClient{
+		client:  client,
+		config:  config,
+		clock:   clock.New(),
+		entries: map[string]cacheEntry{},
+	}
+}
+
+func cacheKey(euaID string) string {
+	return strings.ToUpper(strings.TrimSpace(euaID))
+}
+
+// detachedContext keeps the values of its parent, like the logger, but not its deadline or cancellation
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (d detachedContext) Value(key interface{}) interface{} { return d.parent.Value(key) }
+
+func copyUserInfo(userInfo *models.UserInfo) *models.UserInfo {
+	userInfoCopy := *userInfo
+	return &userInfoCopy
+}
+
+// FetchUserInfo fetches a user's personal details, from the cache if possible
+func (c *CachedClient) FetchUserInfo(ctx context.Context, euaID string) (*models.UserInfo, error) {
+	key := cacheKey(euaID)
+
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok && c.clock.Now().Before(entry.expiresAt) {
+		if entry.err != nil {
+			atomic.AddUint64(&c.negativeHits, 1)
+			return nil, entry.err
+		}
+		atomic.AddUint64(&c.hits, 1)
+		return copyUserInfo(entry.userInfo), nil
+	}
+
+	atomic.AddUint64(&c.misses, 1)
+	// the lookup is shared, so one caller giving up mustn't fail the others waiting on it
+	results := c.group.DoChan(key, func() (interface{}, error) {
+		lookupCtx, cancel := context.WithTimeout(detachedContext{parent: ctx}, c.config.LookupTimeout)
+		defer cancel()
+		userInfo, err := c.client.FetchUserInfo(lookupCtx, euaID)
+		switch {
+		case err == nil:
+			c.store(key, cacheEntry{userInfo: userInfo, expiresAt: c.clock.Now().Add(c.config.TTL)})
+		case errors.Is(err, ErrUserNotFound):
+			c.store(key, cacheEntry{err: err, expiresAt: c.clock.Now().Add(c.config.NegativeTTL)})
+		}
+		return userInfo, err
+	})
+	var result singleflight.Result
+	select {
+	case result = <-results:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if result.Shared {
+		appcontext.ZLogger(ctx).Debug("Shared CEDAR LDAP lookup", zap.String("euaID", key))
+	}
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return copyUserInfo(result.Val.(*models.UserInfo)), nil
+}
+
+func (c *CachedClient) store(key string, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= pruneThreshold {
+		now := c.clock.Now()
+		for existingKey, existing := range c.entries {
+			if !now.Before(existing.expiresAt) {
+				delete(c.entries, existingKey)
+			}
+		}
+	}
+	c.entries[key] = entry
+}
+
+// SearchPeople searches CEDAR LDAP directly, as typeahead queries rarely repeat
+func (c *CachedClient) SearchPeople(ctx context.Context, query string) ([]*models.UserInfo, error) {
+	return c.client.SearchPeople(ctx, query)
+}
+
+// Evict removes a user from the cache, reporting whether they were cached
+func (c *CachedClient) Evict(_ context.Context, euaID string) (bool, error) {
+	key := cacheKey(euaID)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.entries[key]
+	if ok {
+		delete(c.entries, key)
+		atomic.AddUint64(&c.evictions, 1)
+	}
+	return ok, nil
+}
+
+// Stats returns the cache's hit and miss counts
+func (c *CachedClient) Stats(context.Context) (*models.UserInfoCacheStats, error) {
+	c.mu.RLock()
+	entries := len(c.entries)
+	c.mu.RUnlock()
+	return &models.UserInfoCacheStats{
+		Entries:      entries,
+		Hits:         atomic.LoadUint64(&c.hits),
+		NegativeHits: atomic.LoadUint64(&c.negativeHits),
+		Misses:       atomic.LoadUint64(&c.misses),
+		Evictions:    atomic.LoadUint64(&c.evictions),
+	}, nil
+}
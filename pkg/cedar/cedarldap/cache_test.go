@@ -0,0 +1,202 @@
+package cedarldap
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type CacheTestSuite struct {
+	suite.Suite
+}
+
+func TestCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheTestSuite))
+}
+
+type fakeClient struct {
+	calls   int32
+	release chan struct{}
+	err     error
+}
+
+func (f *fakeClient) FetchUserInfo(ctx context.Context, euaID string) (*models.UserInfo, error) {
+	atomic.AddInt32(&f.calls, 1)
+	if f.release != nil {
+		select {
+		case <-f.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	if euaID == "NONE" {
+		return nil, &apperrors.ExternalAPIError{Err: ErrUserNotFound, ModelID: euaID, Source: "CEDAR LDAP"}
+	}
+	return &models.UserInfo{EuaUserID: euaID, CommonName: "Name " + euaID}, nil
+}
+
+func (f *fakeClient) SearchPeople(context.Context, string) ([]*models.UserInfo, error) {
+	return nil, nil
+}
+
+func newTestCache(client Client) (*CachedClient, *clock.Mock) {
+	mockClock := clock.NewMock()
+	cache := NewCachedClient(client, CacheConfig{TTL: time.Hour, NegativeTTL: time.Minute})
+	cache.clock = mockClock
+	return cache, mockClock
+}
+
+func (s CacheTestSuite) TestFetchUserInfo() {
+	ctx := context.Background()
+
+	s.Run("caches users until the TTL passes", func() {
+		client := &fakeClient{}
+		cache, mockClock := newTestCache(client)
+
+		for i := 0; i < 3; i++ {
+			userInfo, err := cache.FetchUserInfo(ctx, "abcd")
+			s.NoError(err)
+			s.Equal("abcd", userInfo.EuaUserID)
+		}
+		s.Equal(int32(1), atomic.LoadInt32(&client.calls))
+
+		mockClock.Add(time.Hour)
+		_, err := cache.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+		s.Equal(int32(2), atomic.LoadInt32(&client.calls))
+
+		stats, err := cache.Stats(ctx)
+		s.NoError(err)
+		s.Equal(uint64(2), stats.Hits)
+		s.Equal(uint64(2), stats.Misses)
+		s.Equal(1, stats.Entries)
+	})
+
+	s.Run("caches unknown EUA IDs for the negative TTL", func() {
+		client := &fakeClient{}
+		cache, mockClock := newTestCache(client)
+
+		_, err := cache.FetchUserInfo(ctx, "NONE")
+		s.True(errors.Is(err, ErrUserNotFound))
+		_, err = cache.FetchUserInfo(ctx, "NONE")
+		s.True(errors.Is(err, ErrUserNotFound))
+		s.Equal(int32(1), atomic.LoadInt32(&client.calls))
+
+		mockClock.Add(time.Minute)
+		_, err = cache.FetchUserInfo(ctx, "NONE")
+		s.Error(err)
+		s.Equal(int32(2), atomic.LoadInt32(&client.calls))
+
+		stats, err := cache.Stats(ctx)
+		s.NoError(err)
+		s.Equal(uint64(1), stats.NegativeHits)
+	})
+
+	s.Run("does not cache other failures", func() {
+		client := &fakeClient{err: errors.New("CEDAR is down")}
+		cache, _ := newTestCache(client)
+
+		_, err := cache.FetchUserInfo(ctx, "ABCD")
+		s.Error(err)
+		_, err = cache.FetchUserInfo(ctx, "ABCD")
+		s.Error(err)
+		s.Equal(int32(2), atomic.LoadInt32(&client.calls))
+	})
+
+	s.Run("shares concurrent lookups", func() {
+		client := &fakeClient{release: make(chan struct{})}
+		cache, _ := newTestCache(client)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				userInfo, err := cache.FetchUserInfo(ctx, "ABCD")
+				s.NoError(err)
+				s.Equal("ABCD", userInfo.EuaUserID)
+			}()
+		}
+		// let the lookups pile up behind the first before it finishes
+		s.Eventually(func() bool { return atomic.LoadInt32(&client.calls) == 1 }, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(client.release)
+		wg.Wait()
+
+		s.Equal(int32(1), atomic.LoadInt32(&client.calls))
+	})
+
+	s.Run("a caller giving up doesn't fail the others sharing its lookup", func() {
+		client := &fakeClient{release: make(chan struct{})}
+		cache, _ := newTestCache(client)
+
+		firstCtx, cancel := context.WithCancel(ctx)
+		firstErr := make(chan error)
+		go func() {
+			_, err := cache.FetchUserInfo(firstCtx, "ABCD")
+			firstErr <- err
+		}()
+		s.Eventually(func() bool { return atomic.LoadInt32(&client.calls) == 1 }, time.Second, time.Millisecond)
+
+		second := make(chan *models.UserInfo)
+		go func() {
+			userInfo, err := cache.FetchUserInfo(ctx, "ABCD")
+			s.NoError(err)
+			second <- userInfo
+		}()
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+		s.Equal(context.Canceled, <-firstErr)
+
+		close(client.release)
+		s.Equal("ABCD", (<-second).EuaUserID)
+		s.Equal(int32(1), atomic.LoadInt32(&client.calls))
+	})
+
+	s.Run("returns copies of cached users", func() {
+		cache, _ := newTestCache(&fakeClient{})
+
+		userInfo, err := cache.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+		userInfo.CommonName = "Changed"
+
+		cached, err := cache.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+		s.Equal("Name ABCD", cached.CommonName)
+	})
+}
+
+func (s CacheTestSuite) TestEvict() {
+	ctx := context.Background()
+	client := &fakeClient{}
+	cache, _ := newTestCache(client)
+
+	_, err := cache.FetchUserInfo(ctx, "ABCD")
+	s.NoError(err)
+
+	evicted, err := cache.Evict(ctx, "abcd")
+	s.NoError(err)
+	s.True(evicted)
+	evicted, err = cache.Evict(ctx, "ABCD")
+	s.NoError(err)
+	s.False(evicted)
+
+	_, err = cache.FetchUserInfo(ctx, "ABCD")
+	s.NoError(err)
+	s.Equal(int32(2), atomic.LoadInt32(&client.calls))
+	stats, err := cache.Stats(ctx)
+	s.NoError(err)
+	s.Equal(uint64(1), stats.Evictions)
+}
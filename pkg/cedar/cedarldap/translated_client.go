@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/cedar"
 	apiclient "github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/client"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/client/operations"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/models"
@@ -27,12 +32,24 @@ type TranslatedClient struct {
 // Client is an interface for helping test dependencies
 type Client interface {
 	FetchUserInfo(context.Context, string) (*models2.UserInfo, error)
+	SearchPeople(context.Context, string) ([]*models2.UserInfo, error)
 }
 
-// NewTranslatedClient returns an API client for CEDAR LDAP using EASi language
-func NewTranslatedClient(cedarHost string, cedarAPIKey string) TranslatedClient {
+// ErrUserNotFound is returned when CEDAR LDAP has no one with the EUA ID
+var ErrUserNotFound = errors.New("failed to return person from CEDAR LDAP")
+
+// searchCountLimit is the most people returned by a single LDAP search
+const searchCountLimit = "20"
+
+// NewTranslatedClient returns an API client for CEDAR LDAP using EASi language,
+// sending requests through roundTripper when it is given
+func NewTranslatedClient(cedarHost string, cedarAPIKey string, roundTripper http.RoundTripper) TranslatedClient {
 	// create the transport
-	transport := httptransport.New(cedarHost, apiclient.DefaultBasePath, []string{"https"})
+	host, schemes := cedar.HostAndSchemes(cedarHost)
+	transport := httptransport.New(host, apiclient.DefaultBasePath, schemes)
+	if roundTripper != nil {
+		transport.Transport = roundTripper
+	}
 
 	// create the API client, with the transport
 	client := apiclient.New(transport, strfmt.Default)
@@ -45,7 +62,7 @@ func NewTranslatedClient(cedarHost string, cedarAPIKey string) TranslatedClient
 
 // FetchUserInfo fetches a user's personal details
 func (c TranslatedClient) FetchUserInfo(ctx context.Context, euaID string) (*models2.UserInfo, error) {
-	params := operations.NewPersonIDParams()
+	params := operations.NewPersonIDParamsWithContext(ctx)
 	params.ID = euaID
 	resp, err := c.client.Operations.PersonID(params, c.apiAuthHeader)
 	if err != nil {
@@ -60,7 +77,7 @@ func (c TranslatedClient) FetchUserInfo(ctx context.Context, euaID string) (*mod
 	}
 	if resp.Payload == nil || resp.Payload.UserName == "" {
 		return nil, &apperrors.ExternalAPIError{
-			Err:       errors.New("failed to return person from CEDAR LDAP"),
+			Err:       ErrUserNotFound,
 			ModelID:   euaID,
 			Model:     models.Person{},
 			Operation: apperrors.Fetch,
@@ -74,3 +91,77 @@ func (c TranslatedClient) FetchUserInfo(ctx context.Context, euaID string) (*mod
 		EuaUserID:  resp.Payload.UserName,
 	}, nil
 }
+
+// personSearches translates a typeahead query into LDAP searches: an email address,
+// a first and last name, or a single name matched against either
+func personSearches(ctx context.Context, query string) []*operations.PersonParams {
+	newSearch := func() *operations.PersonParams {
+		params := operations.NewPersonParamsWithContext(ctx)
+		countLimit := searchCountLimit
+		params.CountLimit = &countLimit
+		return params
+	}
+	// LDAP filters use * as a wildcard, so strip any from the query
+	prefix := func(term string) *string {
+		value := strings.ReplaceAll(term, "*", "") + "*"
+		return &value
+	}
+
+	terms := strings.Fields(query)
+	switch {
+	case len(terms) == 0:
+		return nil
+	case strings.Contains(query, "@"):
+		search := newSearch()
+		search.Email = prefix(strings.TrimSpace(query))
+		return []*operations.PersonParams{search}
+	case len(terms) > 1:
+		search := newSearch()
+		search.FirstName = prefix(terms[0])
+		search.LastName = prefix(strings.Join(terms[1:], " "))
+		return []*operations.PersonParams{search}
+	default:
+		byFirstName := newSearch()
+		byFirstName.FirstName = prefix(terms[0])
+		byLastName := newSearch()
+		byLastName.LastName = prefix(terms[0])
+		return []*operations.PersonParams{byFirstName, byLastName}
+	}
+}
+
+// SearchPeople finds people by the start of their name or email address
+func (c TranslatedClient) SearchPeople(ctx context.Context, query string) ([]*models2.UserInfo, error) {
+	people := []*models2.UserInfo{}
+	found := map[string]bool{}
+	for _, params := range personSearches(ctx, query) {
+		resp, err := c.client.Operations.Person(params, c.apiAuthHeader)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error("Failed to search people in CEDAR LDAP", zap.Error(err))
+			return nil, &apperrors.ExternalAPIError{
+				Err:       err,
+				Model:     models.PersonList{},
+				ModelID:   query,
+				Operation: apperrors.Fetch,
+				Source:    "CEDAR LDAP",
+			}
+		}
+		if resp.Payload == nil {
+			continue
+		}
+		for _, person := range resp.Payload.PersonList {
+			if person == nil || person.UserName == "" || found[person.UserName] {
+				continue
+			}
+			found[person.UserName] = true
+			people = append(people, &models2.UserInfo{
+				CommonName: person.CommonName,
+				Email:      person.Email,
+				EuaUserID:  person.UserName,
+			})
+		}
+	}
+	sort.Slice(people, func(i, j int) bool {
+		return people[i].CommonName < people[j].CommonName
+	})
+	return people, nil
+}
@@ -0,0 +1,104 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+// BreakerState is the state of a circuit breaker
+type BreakerState string
+
+const (
+	// BreakerStateClosed lets requests through
+	BreakerStateClosed BreakerState = "closed"
+	// BreakerStateOpen fails requests without sending them
+	BreakerStateOpen BreakerState = "open"
+	// BreakerStateHalfOpen lets a single trial request through after the cooldown
+	BreakerStateHalfOpen BreakerState = "half-open"
+)
+
+// Breaker is a circuit breaker that opens after a number of consecutive failures
+// and lets a trial request through once its cooldown has passed
+type Breaker struct {
+	failureThreshold int
+	cooldown         time.Duration
+	now              func() time.Time
+
+	mu                  sync.Mutex
+	state               BreakerState
+	consecutiveFailures int
+	openedAt            time.Time
+	trialInFlight       bool
+}
+
+// NewBreaker returns a closed circuit breaker
+func NewBreaker(failureThreshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		now:              time.Now,
+		state:            BreakerStateClosed,
+	}
+}
+
+// State returns the current state of the breaker
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == BreakerStateOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return BreakerStateHalfOpen
+	}
+	return b.state
+}
+
+// Allow reports whether a request may be sent
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerStateOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = BreakerStateHalfOpen
+		b.trialInFlight = true
+		return true
+	case BreakerStateHalfOpen:
+		if b.trialInFlight {
+			return false
+		}
+		b.trialInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Success records a successful request, closing the breaker
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = BreakerStateClosed
+	b.consecutiveFailures = 0
+	b.trialInFlight = false
+}
+
+// Abandon records a request the caller gave up on, which counts as neither
+// a success nor a failure but frees the trial slot of a half-open breaker
+func (b *Breaker) Abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trialInFlight = false
+}
+
+// Failure records a failed request, opening the breaker when there have been too many
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.consecutiveFailures++
+	b.trialInFlight = false
+	if b.state == BreakerStateHalfOpen || b.consecutiveFailures >= b.failureThreshold {
+		b.state = BreakerStateOpen
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,204 @@
+// Package resilience protects calls to the CEDAR gateway with timeouts, retries and a circuit breaker
+package resilience
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending a request while the circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Config configures a resilient Transport
+type Config struct {
+	// Timeout is how long a single attempt may take
+	Timeout time.Duration
+	// MaxRetries is how many times an idempotent request is retried after the first attempt
+	MaxRetries int
+	// RetryBaseDelay is the base of the jittered exponential backoff between retries
+	RetryBaseDelay time.Duration
+	// BreakerFailureThreshold is how many consecutive failures open the breaker
+	BreakerFailureThreshold int
+	// BreakerCooldown is how long the breaker stays open before a trial request
+	BreakerCooldown time.Duration
+}
+
+// DefaultConfig returns the settings used for the CEDAR gateway unless configured
+func DefaultConfig() Config {
+	return Config{
+		Timeout:                 10 * time.Second,
+		MaxRetries:              2,
+		RetryBaseDelay:          200 * time.Millisecond,
+		BreakerFailureThreshold: 5,
+		BreakerCooldown:         30 * time.Second,
+	}
+}
+
+// WithDefaults fills in any unset settings with the defaults
+func (c Config) WithDefaults() Config {
+	defaults := DefaultConfig()
+	if c.Timeout <= 0 {
+		c.Timeout = defaults.Timeout
+	}
+	if c.MaxRetries < 0 {
+		c.MaxRetries = defaults.MaxRetries
+	}
+	if c.RetryBaseDelay <= 0 {
+		c.RetryBaseDelay = defaults.RetryBaseDelay
+	}
+	if c.BreakerFailureThreshold <= 0 {
+		c.BreakerFailureThreshold = defaults.BreakerFailureThreshold
+	}
+	if c.BreakerCooldown <= 0 {
+		c.BreakerCooldown = defaults.BreakerCooldown
+	}
+	return c
+}
+
+// Transport is an http.RoundTripper that times out, retries and circuit breaks requests
+type Transport struct {
+	name    string
+	config  Config
+	next    http.RoundTripper
+	breaker *Breaker
+	sleep   func(context.Context, time.Duration) error
+}
+
+// NewTransport wraps next with the resilience settings in config
+func NewTransport(name string, config Config, next http.RoundTripper) *Transport {
+	config = config.WithDefaults()
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Transport{
+		name:    name,
+		config:  config,
+		next:    next,
+		breaker: NewBreaker(config.BreakerFailureThreshold, config.BreakerCooldown),
+		sleep:   sleepContext,
+	}
+}
+
+// Name returns the name of the service the transport calls
+func (t *Transport) Name() string {
+	return t.name
+}
+
+// BreakerState returns the state of the transport's circuit breaker
+func (t *Transport) BreakerState() BreakerState {
+	return t.breaker.State()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts := 1
+	if isIdempotent(req.Method) {
+		attempts += t.config.MaxRetries
+	}
+
+	// keep the body around so it can be sent again
+	var body []byte
+	if req.Body != nil && attempts > 1 {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			// full jitter: anywhere between nothing and the exponential backoff
+			backoff := t.config.RetryBaseDelay << uint(attempt-1)
+			if err := t.sleep(req.Context(), time.Duration(rand.Int63n(int64(backoff)+1))); err != nil {
+				return nil, err
+			}
+		}
+		if !t.breaker.Allow() {
+			return nil, fmt.Errorf("%s: %w", t.name, ErrCircuitOpen)
+		}
+
+		attemptReq := req
+		if body != nil {
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+			attemptReq.ContentLength = int64(len(body))
+		}
+		resp, err := t.roundTripWithTimeout(attemptReq)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			t.breaker.Success()
+			return resp, nil
+		}
+		// the caller gave up, so don't try again, and don't hold it against CEDAR
+		if req.Context().Err() != nil {
+			t.breaker.Abandon()
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return nil, req.Context().Err()
+		}
+		t.breaker.Failure()
+		if attempt == attempts-1 {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+			lastErr = fmt.Errorf("%s: server error %d", t.name, resp.StatusCode)
+		} else {
+			lastErr = err
+		}
+	}
+	return nil, lastErr
+}
+
+func (t *Transport) roundTripWithTimeout(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.config.Timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the timeout covers reading the body, so only cancel once it's closed
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,203 @@
+package resilience
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ResilienceTestSuite struct {
+	suite.Suite
+}
+
+func TestResilienceTestSuite(t *testing.T) {
+	suite.Run(t, new(ResilienceTestSuite))
+}
+
+func testConfig() Config {
+	return Config{
+		Timeout:                 time.Second,
+		MaxRetries:              2,
+		RetryBaseDelay:          time.Millisecond,
+		BreakerFailureThreshold: 3,
+		BreakerCooldown:         time.Minute,
+	}
+}
+
+func newTestTransport(config Config) *Transport {
+	transport := NewTransport("test", config, nil)
+	transport.sleep = func(context.Context, time.Duration) error { return nil }
+	return transport
+}
+
+func (s ResilienceTestSuite) TestRetries() {
+	s.Run("retries idempotent requests with their body on server errors", func() {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			s.Equal("payload", string(body))
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		client := http.Client{Transport: newTestTransport(testConfig())}
+
+		req, err := http.NewRequest(http.MethodPut, server.URL, bytes.NewBufferString("payload"))
+		s.NoError(err)
+		resp, err := client.Do(req)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.NoError(resp.Body.Close())
+		s.Equal(int32(3), atomic.LoadInt32(&calls))
+	})
+
+	s.Run("returns the last response when retries run out", func() {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		client := http.Client{Transport: newTestTransport(testConfig())}
+
+		resp, err := client.Get(server.URL)
+
+		s.NoError(err)
+		s.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		s.NoError(resp.Body.Close())
+		s.Equal(int32(3), atomic.LoadInt32(&calls))
+	})
+
+	s.Run("does not retry posts", func() {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		client := http.Client{Transport: newTestTransport(testConfig())}
+
+		resp, err := client.Post(server.URL, "application/json", bytes.NewBufferString("{}"))
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, resp.StatusCode)
+		s.NoError(resp.Body.Close())
+		s.Equal(int32(1), atomic.LoadInt32(&calls))
+	})
+
+	s.Run("does not retry client errors", func() {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		client := http.Client{Transport: newTestTransport(testConfig())}
+
+		resp, err := client.Get(server.URL)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, resp.StatusCode)
+		s.NoError(resp.Body.Close())
+		s.Equal(int32(1), atomic.LoadInt32(&calls))
+	})
+}
+
+func (s ResilienceTestSuite) TestTimeout() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	config := testConfig()
+	config.Timeout = 10 * time.Millisecond
+	client := http.Client{Transport: newTestTransport(config)}
+
+	_, err := client.Get(server.URL)
+
+	s.Error(err)
+	s.True(errors.Is(err, context.DeadlineExceeded))
+	s.Equal(int32(3), atomic.LoadInt32(&calls))
+}
+
+func (s ResilienceTestSuite) TestCircuitBreaker() {
+	var calls int32
+	healthy := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if !healthy {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	config := testConfig()
+	config.MaxRetries = 0
+	transport := newTestTransport(config)
+	now := time.Now()
+	transport.breaker.now = func() time.Time { return now }
+	client := http.Client{Transport: transport}
+
+	for i := 0; i < config.BreakerFailureThreshold; i++ {
+		resp, err := client.Get(server.URL)
+		s.NoError(err)
+		s.NoError(resp.Body.Close())
+	}
+	s.Equal(BreakerStateOpen, transport.BreakerState())
+
+	// open breakers fail without sending the request
+	_, err := client.Get(server.URL)
+	s.True(errors.Is(err, ErrCircuitOpen))
+	s.Equal(int32(config.BreakerFailureThreshold), atomic.LoadInt32(&calls))
+
+	// after the cooldown a failed trial opens it again
+	now = now.Add(config.BreakerCooldown)
+	s.Equal(BreakerStateHalfOpen, transport.BreakerState())
+	resp, err := client.Get(server.URL)
+	s.NoError(err)
+	s.NoError(resp.Body.Close())
+	s.Equal(BreakerStateOpen, transport.BreakerState())
+
+	// and a successful one closes it
+	now = now.Add(config.BreakerCooldown)
+	healthy = true
+	resp, err = client.Get(server.URL)
+	s.NoError(err)
+	s.NoError(resp.Body.Close())
+	s.Equal(BreakerStateClosed, transport.BreakerState())
+}
+
+func (s ResilienceTestSuite) TestCancelledRequestsDontOpenTheBreaker() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	config := testConfig()
+	config.MaxRetries = 0
+	transport := newTestTransport(config)
+	client := http.Client{Transport: transport}
+
+	for i := 0; i < config.BreakerFailureThreshold; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		s.NoError(err)
+		_, err = client.Do(req)
+		cancel()
+		s.Error(err)
+	}
+	s.Equal(BreakerStateClosed, transport.BreakerState())
+}
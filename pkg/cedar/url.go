@@ -0,0 +1,16 @@
+// Package cedar holds helpers shared by the CEDAR API clients
+package cedar
+
+import "strings"
+
+// HostAndSchemes splits a configured CEDAR API URL into the host and schemes
+// the generated clients expect. A bare host is reached over https.
+func HostAndSchemes(cedarURL string) (string, []string) {
+	for _, scheme := range []string{"http", "https"} {
+		prefix := scheme + "://"
+		if strings.HasPrefix(cedarURL, prefix) {
+			return strings.TrimSuffix(strings.TrimPrefix(cedarURL, prefix), "/"), []string{scheme}
+		}
+	}
+	return cedarURL, []string{"https"}
+}
@@ -32,6 +32,7 @@ type templates struct {
 	unnamedRequestWithdrawTemplate templateCaller
 	issueLCIDTemplate              templateCaller
 	rejectRequestTemplate          templateCaller
+	ownershipTransferTemplate      templateCaller
 }
 
 // sender is an interface for swapping out email provider implementations
@@ -108,6 +109,13 @@ func NewClient(config Config, sender sender) (Client, error) {
 	}
 	appTemplates.rejectRequestTemplate = rejectRequestTemplate
 
+	ownershipTransferTemplateName := "ownership_transfer.gohtml"
+	ownershipTransferTemplate := rawTemplates.Lookup(ownershipTransferTemplateName)
+	if ownershipTransferTemplate == nil {
+		return Client{}, templateError(ownershipTransferTemplateName)
+	}
+	appTemplates.ownershipTransferTemplate = ownershipTransferTemplate
+
 	client := Client{
 		config:    config,
 		templates: appTemplates,
@@ -0,0 +1,61 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+type ownershipTransfer struct {
+	RequestName   string
+	PreviousOwner string
+	NewOwner      string
+	Reason        string
+}
+
+func (c Client) ownershipTransferBody(requestName string, previousOwner string, newOwner string, reason string) (string, error) {
+	data := ownershipTransfer{
+		RequestName:   requestName,
+		PreviousOwner: previousOwner,
+		NewOwner:      newOwner,
+		Reason:        reason,
+	}
+	var b bytes.Buffer
+	if c.templates.ownershipTransferTemplate == nil {
+		return "", errors.New("ownership transfer template is nil")
+	}
+	err := c.templates.ownershipTransferTemplate.Execute(&b, data)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// SendOwnershipTransferEmail sends an email for transferring a request to a new requester
+func (c Client) SendOwnershipTransferEmail(
+	ctx context.Context,
+	recipient string,
+	requestName string,
+	previousOwner string,
+	newOwner string,
+	reason string,
+) error {
+	subject := fmt.Sprintf("Request Transferred: %s", requestName)
+	body, err := c.ownershipTransferBody(requestName, previousOwner, newOwner, reason)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	err = c.sender.Send(
+		ctx,
+		recipient,
+		subject,
+		body,
+	)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	return nil
+}
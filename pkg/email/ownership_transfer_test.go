@@ -0,0 +1,60 @@
+package email
+
+import (
+	"context"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+func (s *EmailTestSuite) TestSendOwnershipTransferEmail() {
+	sender := mockSender{}
+	ctx := context.Background()
+	recipient := "fake@fake.com"
+
+	s.Run("successful call has the right content", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		expectedEmail := "<p>Hello,</p>\n\n" +
+			"<p>\n  The Governance Review Team has transferred the Easy Access request\n" +
+			"  from Old Owner to New Owner.\n" +
+			"  New Owner is now the requester for the request and its business case.\n" +
+			"</p>\n\n" +
+			"<p>Reason: left CMS</p>\n"
+		err = client.SendOwnershipTransferEmail(ctx, recipient, "Easy Access", "Old Owner", "New Owner", "left CMS")
+
+		s.NoError(err)
+		s.Equal(recipient, sender.toAddress)
+		s.Equal("Request Transferred: Easy Access", sender.subject)
+		s.Equal(expectedEmail, sender.body)
+	})
+
+	s.Run("if the template is nil, we get the error from it", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		client.templates = templates{}
+
+		err = client.SendOwnershipTransferEmail(ctx, recipient, "Easy Access", "Old Owner", "New Owner", "left CMS")
+
+		s.Error(err)
+		s.IsType(err, &apperrors.NotificationError{})
+		e := err.(*apperrors.NotificationError)
+		s.Equal(apperrors.DestinationTypeEmail, e.DestinationType)
+		s.Equal("ownership transfer template is nil", e.Err.Error())
+	})
+
+	s.Run("if the sender fails, we get the error from it", func() {
+		sender := mockFailedSender{}
+
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		err = client.SendOwnershipTransferEmail(ctx, recipient, "Easy Access", "Old Owner", "New Owner", "left CMS")
+
+		s.Error(err)
+		s.IsType(err, &apperrors.NotificationError{})
+		e := err.(*apperrors.NotificationError)
+		s.Equal(apperrors.DestinationTypeEmail, e.DestinationType)
+		s.Equal("sender had an error", e.Err.Error())
+	})
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/graph/model"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// applyBusinessCaseInput sets the editable fields of a business case that the input gives.
+// Fields the input leaves out are kept as they are, as are the lifecycle cost lines when they aren't given.
+func applyBusinessCaseInput(businessCase *models.BusinessCase, input model.UpdateBusinessCaseInput) {
+	setString(&businessCase.ProjectName, input.ProjectName)
+	setString(&businessCase.Requester, input.Requester)
+	setString(&businessCase.RequesterPhoneNumber, input.RequesterPhoneNumber)
+	setString(&businessCase.BusinessOwner, input.BusinessOwner)
+	setString(&businessCase.BusinessNeed, input.BusinessNeed)
+	setString(&businessCase.CMSBenefit, input.CmsBenefit)
+	setString(&businessCase.PriorityAlignment, input.PriorityAlignment)
+	setString(&businessCase.SuccessIndicators, input.SuccessIndicators)
+	setString(&businessCase.AsIsTitle, input.AsIsTitle)
+	setString(&businessCase.AsIsSummary, input.AsIsSummary)
+	setString(&businessCase.AsIsPros, input.AsIsPros)
+	setString(&businessCase.AsIsCons, input.AsIsCons)
+	setString(&businessCase.AsIsCostSavings, input.AsIsCostSavings)
+	setString(&businessCase.PreferredTitle, input.PreferredTitle)
+	setString(&businessCase.PreferredSummary, input.PreferredSummary)
+	setString(&businessCase.PreferredAcquisitionApproach, input.PreferredAcquisitionApproach)
+	setBool(&businessCase.PreferredSecurityIsApproved, input.PreferredSecurityIsApproved)
+	setString(&businessCase.PreferredSecurityIsBeingReviewed, input.PreferredSecurityIsBeingReviewed)
+	setString(&businessCase.PreferredHostingType, input.PreferredHostingType)
+	setString(&businessCase.PreferredHostingLocation, input.PreferredHostingLocation)
+	setString(&businessCase.PreferredHostingCloudServiceType, input.PreferredHostingCloudServiceType)
+	setString(&businessCase.PreferredHasUI, input.PreferredHasUI)
+	setString(&businessCase.PreferredPros, input.PreferredPros)
+	setString(&businessCase.PreferredCons, input.PreferredCons)
+	setString(&businessCase.PreferredCostSavings, input.PreferredCostSavings)
+	setString(&businessCase.AlternativeATitle, input.AlternativeATitle)
+	setString(&businessCase.AlternativeASummary, input.AlternativeASummary)
+	setString(&businessCase.AlternativeAAcquisitionApproach, input.AlternativeAAcquisitionApproach)
+	setBool(&businessCase.AlternativeASecurityIsApproved, input.AlternativeASecurityIsApproved)
+	setString(&businessCase.AlternativeASecurityIsBeingReviewed, input.AlternativeASecurityIsBeingReviewed)
+	setString(&businessCase.AlternativeAHostingType, input.AlternativeAHostingType)
+	setString(&businessCase.AlternativeAHostingLocation, input.AlternativeAHostingLocation)
+	setString(&businessCase.AlternativeAHostingCloudServiceType, input.AlternativeAHostingCloudServiceType)
+	setString(&businessCase.AlternativeAHasUI, input.AlternativeAHasUI)
+	setString(&businessCase.AlternativeAPros, input.AlternativeAPros)
+	setString(&businessCase.AlternativeACons, input.AlternativeACons)
+	setString(&businessCase.AlternativeACostSavings, input.AlternativeACostSavings)
+	setString(&businessCase.AlternativeBTitle, input.AlternativeBTitle)
+	setString(&businessCase.AlternativeBSummary, input.AlternativeBSummary)
+	setString(&businessCase.AlternativeBAcquisitionApproach, input.AlternativeBAcquisitionApproach)
+	setBool(&businessCase.AlternativeBSecurityIsApproved, input.AlternativeBSecurityIsApproved)
+	setString(&businessCase.AlternativeBSecurityIsBeingReviewed, input.AlternativeBSecurityIsBeingReviewed)
+	setString(&businessCase.AlternativeBHostingType, input.AlternativeBHostingType)
+	setString(&businessCase.AlternativeBHostingLocation, input.AlternativeBHostingLocation)
+	setString(&businessCase.AlternativeBHostingCloudServiceType, input.AlternativeBHostingCloudServiceType)
+	setString(&businessCase.AlternativeBHasUI, input.AlternativeBHasUI)
+	setString(&businessCase.AlternativeBPros, input.AlternativeBPros)
+	setString(&businessCase.AlternativeBCons, input.AlternativeBCons)
+	setString(&businessCase.AlternativeBCostSavings, input.AlternativeBCostSavings)
+
+	// saving rebuilds the fixed As Is, Preferred, A and B fields from the alternatives,
+	// so without them the fields above are saved over the existing alternatives
+	businessCase.Alternatives = nil
+
+	if input.LifecycleCostFiscalYear != nil {
+		businessCase.LifecycleCostFiscalYear = input.LifecycleCostFiscalYear
+	}
+	if input.LifecycleCostHorizon != nil {
+		businessCase.LifecycleCostHorizon = *input.LifecycleCostHorizon
+	}
+	if input.LifecycleCostPhases != nil {
+		businessCase.LifecycleCostPhases = models.LifecycleCostPhases{}
+		for _, phase := range input.LifecycleCostPhases {
+			businessCase.LifecycleCostPhases = append(businessCase.LifecycleCostPhases, models.LifecycleCostPhase(phase))
+		}
+	}
+	if input.LifecycleCostLines != nil {
+		businessCase.LifecycleCostLines = models.EstimatedLifecycleCosts{}
+		for _, line := range input.LifecycleCostLines {
+			cost := models.EstimatedLifecycleCost{
+				BusinessCaseID: businessCase.ID,
+				AlternativeID:  line.AlternativeID,
+				Year:           models.LifecycleCostYear(line.Year),
+				Cost:           line.Cost,
+			}
+			if line.Solution != nil {
+				cost.Solution = models.LifecycleCostSolution(*line.Solution)
+			}
+			if line.Phase != nil {
+				phase := models.LifecycleCostPhase(*line.Phase)
+				cost.Phase = &phase
+			}
+			businessCase.LifecycleCostLines = append(businessCase.LifecycleCostLines, cost)
+		}
+	}
+	if input.Version != nil {
+		businessCase.Version = *input.Version
+	}
+}
+
+func setString(field *null.String, value *string) {
+	if value != nil {
+		*field = null.StringFrom(*value)
+	}
+}
+
+func setBool(field *null.Bool, value *bool) {
+	if value != nil {
+		*field = null.BoolFrom(*value)
+	}
+}
@@ -12,6 +12,11 @@ import (
 	"github.com/google/uuid"
 )
 
+// Parameters for soft deleting or restoring an AccessibilityRequestDocument
+type AccessibilityRequestDocumentIDInput struct {
+	ID uuid.UUID `json:"id"`
+}
+
 // Document type of an Accessibility Request document
 type AccessibilityRequestDocumentType struct {
 	CommonType           models.AccessibilityRequestDocumentCommonType `json:"commonType"`
@@ -24,12 +29,26 @@ type AccessibilityRequestEdge struct {
 	Node   *models.AccessibilityRequest `json:"node"`
 }
 
+// Parameters for soft deleting or restoring an AccessibilityRequest
+type AccessibilityRequestIDInput struct {
+	ID uuid.UUID `json:"id"`
+}
+
 // A collection of AccessibilityRequests
 type AccessibilityRequestsConnection struct {
 	Edges      []*AccessibilityRequestEdge `json:"edges"`
 	TotalCount int                         `json:"totalCount"`
 }
 
+// Parameters for actions that move a SystemIntake to another status, like those
+// the GRT takes to ask for a business case or to close a request
+type BasicActionInput struct {
+	ActionType          models.ActionType `json:"actionType"`
+	Feedback            *string           `json:"feedback"`
+	IntakeID            uuid.UUID         `json:"intakeId"`
+	NotifyCollaborators *bool             `json:"notifyCollaborators"`
+}
+
 // Parameters for createAccessibilityRequestDocument
 type CreateAccessibilityRequestDocumentInput struct {
 	CommonDocumentType           models.AccessibilityRequestDocumentCommonType `json:"commonDocumentType"`
@@ -59,6 +78,19 @@ type CreateAccessibilityRequestPayload struct {
 	UserErrors           []*UserError                 `json:"userErrors"`
 }
 
+// Parameters for createNote
+type CreateNoteInput struct {
+	AuthorName string    `json:"authorName"`
+	Content    string    `json:"content"`
+	IntakeID   uuid.UUID `json:"intakeId"`
+}
+
+// Result of createNote
+type CreateNotePayload struct {
+	Note       *models.Note `json:"note"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
 // Parameters for creating a test date
 type CreateTestDateInput struct {
 	Date      time.Time               `json:"date"`
@@ -73,6 +105,38 @@ type CreateTestDatePayload struct {
 	UserErrors []*UserError     `json:"userErrors"`
 }
 
+// Result of deleteAccessibilityRequestDocument
+type DeleteAccessibilityRequestDocumentPayload struct {
+	ID         *uuid.UUID   `json:"id"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+// Result of deleteAccessibilityRequest
+type DeleteAccessibilityRequestPayload struct {
+	ID         *uuid.UUID   `json:"id"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+// Parameters for deleteTestDate
+type DeleteTestDateInput struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// Result of deleteTestDate
+type DeleteTestDatePayload struct {
+	TestDate   *models.TestDate `json:"testDate"`
+	UserErrors []*UserError     `json:"userErrors"`
+}
+
+// An estimated cost of one phase of a business case solution in one year
+type EstimatedLifecycleCostInput struct {
+	AlternativeID *uuid.UUID `json:"alternativeId"`
+	Cost          *int       `json:"cost"`
+	Phase         *string    `json:"phase"`
+	Solution      *string    `json:"solution"`
+	Year          string     `json:"year"`
+}
+
 // Parameters required to generate a presigned upload URL
 type GeneratePresignedUploadURLInput struct {
 	FileName string `json:"fileName"`
@@ -86,6 +150,43 @@ type GeneratePresignedUploadURLPayload struct {
 	UserErrors []*UserError `json:"userErrors"`
 }
 
+// Parameters for issueLifecycleId. A lifecycle ID is generated when lcid is not given
+type IssueLifecycleIDInput struct {
+	ExpiresAt           time.Time `json:"expiresAt"`
+	Feedback            string    `json:"feedback"`
+	IntakeID            uuid.UUID `json:"intakeId"`
+	Lcid                *string   `json:"lcid"`
+	NextSteps           string    `json:"nextSteps"`
+	NotifyCollaborators *bool     `json:"notifyCollaborators"`
+	Scope               string    `json:"scope"`
+}
+
+// Parameters for rejectIntake
+type RejectIntakeInput struct {
+	Feedback            string    `json:"feedback"`
+	IntakeID            uuid.UUID `json:"intakeId"`
+	NextSteps           string    `json:"nextSteps"`
+	NotifyCollaborators *bool     `json:"notifyCollaborators"`
+	Reason              string    `json:"reason"`
+}
+
+// Result of restoreAccessibilityRequestDocument
+type RestoreAccessibilityRequestDocumentPayload struct {
+	AccessibilityRequestDocument *models.AccessibilityRequestDocument `json:"accessibilityRequestDocument"`
+	UserErrors                   []*UserError                         `json:"userErrors"`
+}
+
+// Result of restoreAccessibilityRequest
+type RestoreAccessibilityRequestPayload struct {
+	AccessibilityRequest *models.AccessibilityRequest `json:"accessibilityRequest"`
+	UserErrors           []*UserError                 `json:"userErrors"`
+}
+
+// Parameters for submitIntake
+type SubmitIntakeInput struct {
+	ID uuid.UUID `json:"id"`
+}
+
 // A collection of Systems
 type SystemConnection struct {
 	Edges      []*SystemEdge `json:"edges"`
@@ -98,6 +199,79 @@ type SystemEdge struct {
 	Node   *models.System `json:"node"`
 }
 
+// Parameters for updateBusinessCase. The fields given replace those of the business case,
+// and fields that are omitted or null are kept as they are.
+// A version other than the current one is refused as a stale update.
+type UpdateBusinessCaseInput struct {
+	AlternativeAAcquisitionApproach     *string                        `json:"alternativeAAcquisitionApproach"`
+	AlternativeACons                    *string                        `json:"alternativeACons"`
+	AlternativeACostSavings             *string                        `json:"alternativeACostSavings"`
+	AlternativeAHasUI                   *string                        `json:"alternativeAHasUI"`
+	AlternativeAHostingCloudServiceType *string                        `json:"alternativeAHostingCloudServiceType"`
+	AlternativeAHostingLocation         *string                        `json:"alternativeAHostingLocation"`
+	AlternativeAHostingType             *string                        `json:"alternativeAHostingType"`
+	AlternativeAPros                    *string                        `json:"alternativeAPros"`
+	AlternativeASecurityIsApproved      *bool                          `json:"alternativeASecurityIsApproved"`
+	AlternativeASecurityIsBeingReviewed *string                        `json:"alternativeASecurityIsBeingReviewed"`
+	AlternativeASummary                 *string                        `json:"alternativeASummary"`
+	AlternativeATitle                   *string                        `json:"alternativeATitle"`
+	AlternativeBAcquisitionApproach     *string                        `json:"alternativeBAcquisitionApproach"`
+	AlternativeBCons                    *string                        `json:"alternativeBCons"`
+	AlternativeBCostSavings             *string                        `json:"alternativeBCostSavings"`
+	AlternativeBHasUI                   *string                        `json:"alternativeBHasUI"`
+	AlternativeBHostingCloudServiceType *string                        `json:"alternativeBHostingCloudServiceType"`
+	AlternativeBHostingLocation         *string                        `json:"alternativeBHostingLocation"`
+	AlternativeBHostingType             *string                        `json:"alternativeBHostingType"`
+	AlternativeBPros                    *string                        `json:"alternativeBPros"`
+	AlternativeBSecurityIsApproved      *bool                          `json:"alternativeBSecurityIsApproved"`
+	AlternativeBSecurityIsBeingReviewed *string                        `json:"alternativeBSecurityIsBeingReviewed"`
+	AlternativeBSummary                 *string                        `json:"alternativeBSummary"`
+	AlternativeBTitle                   *string                        `json:"alternativeBTitle"`
+	AsIsCons                            *string                        `json:"asIsCons"`
+	AsIsCostSavings                     *string                        `json:"asIsCostSavings"`
+	AsIsPros                            *string                        `json:"asIsPros"`
+	AsIsSummary                         *string                        `json:"asIsSummary"`
+	AsIsTitle                           *string                        `json:"asIsTitle"`
+	BusinessNeed                        *string                        `json:"businessNeed"`
+	BusinessOwner                       *string                        `json:"businessOwner"`
+	CmsBenefit                          *string                        `json:"cmsBenefit"`
+	ID                                  uuid.UUID                      `json:"id"`
+	LifecycleCostFiscalYear             *int                           `json:"lifecycleCostFiscalYear"`
+	LifecycleCostHorizon                *int                           `json:"lifecycleCostHorizon"`
+	LifecycleCostLines                  []*EstimatedLifecycleCostInput `json:"lifecycleCostLines"`
+	LifecycleCostPhases                 []string                       `json:"lifecycleCostPhases"`
+	PreferredAcquisitionApproach        *string                        `json:"preferredAcquisitionApproach"`
+	PreferredCons                       *string                        `json:"preferredCons"`
+	PreferredCostSavings                *string                        `json:"preferredCostSavings"`
+	PreferredHasUI                      *string                        `json:"preferredHasUI"`
+	PreferredHostingCloudServiceType    *string                        `json:"preferredHostingCloudServiceType"`
+	PreferredHostingLocation            *string                        `json:"preferredHostingLocation"`
+	PreferredHostingType                *string                        `json:"preferredHostingType"`
+	PreferredPros                       *string                        `json:"preferredPros"`
+	PreferredSecurityIsApproved         *bool                          `json:"preferredSecurityIsApproved"`
+	PreferredSecurityIsBeingReviewed    *string                        `json:"preferredSecurityIsBeingReviewed"`
+	PreferredSummary                    *string                        `json:"preferredSummary"`
+	PreferredTitle                      *string                        `json:"preferredTitle"`
+	PriorityAlignment                   *string                        `json:"priorityAlignment"`
+	ProjectName                         *string                        `json:"projectName"`
+	Requester                           *string                        `json:"requester"`
+	RequesterPhoneNumber                *string                        `json:"requesterPhoneNumber"`
+	SuccessIndicators                   *string                        `json:"successIndicators"`
+	Version                             *int                           `json:"version"`
+}
+
+// Result of updateBusinessCase
+type UpdateBusinessCasePayload struct {
+	BusinessCase *models.BusinessCase `json:"businessCase"`
+	UserErrors   []*UserError         `json:"userErrors"`
+}
+
+// Result of a mutation that changes a SystemIntake
+type UpdateSystemIntakePayload struct {
+	SystemIntake *models.SystemIntake `json:"systemIntake"`
+	UserErrors   []*UserError         `json:"userErrors"`
+}
+
 // Parameters for editing a test date
 type UpdateTestDateInput struct {
 	Date     time.Time               `json:"date"`
@@ -2,7 +2,16 @@ package graph
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/graph/model"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/upload"
@@ -23,7 +32,19 @@ type Resolver struct {
 
 // ResolverService holds service methods for use in resolvers
 type ResolverService struct {
-	CreateTestDate func(context.Context, *models.TestDate) (*models.TestDate, error)
+	CreateNote              func(context.Context, *models.Note) (*models.Note, error)
+	CreateTestDate          func(context.Context, *models.TestDate) (*models.TestDate, error)
+	DeleteTestDate          func(context.Context, uuid.UUID) (*models.TestDate, error)
+	FetchActionsByRequestID func(context.Context, uuid.UUID) ([]models.Action, error)
+	FetchBusinessCaseByID   func(context.Context, uuid.UUID) (*models.BusinessCase, error)
+	FetchNotes              func(context.Context, uuid.UUID) ([]*models.Note, error)
+	FetchSystemIntakeByID   func(context.Context, uuid.UUID) (*models.SystemIntake, error)
+	FetchSystemIntakes      func(context.Context, models.SystemIntakeStatusFilter) (models.SystemIntakes, error)
+	IssueLifecycleID        func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
+	RejectIntake            func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
+	TakeAction              func(context.Context, *models.Action) error
+	UpdateBusinessCase      func(context.Context, *models.BusinessCase) (*models.BusinessCase, error)
+	UpdateTestDate          func(context.Context, *models.TestDate) (*models.TestDate, error)
 }
 
 // NewResolver constructs a resolver
@@ -34,3 +55,74 @@ func NewResolver(
 ) *Resolver {
 	return &Resolver{store: store, service: service, s3Client: s3Client}
 }
+
+// authorize returns an error when the user may not take the action on the resource
+func authorize(ctx context.Context, action authz.Action, resource interface{}) error {
+	if !authz.Authorize(ctx, action, resource) {
+		return &apperrors.UnauthorizedError{Err: fmt.Errorf("cannot %s %T", action, resource)}
+	}
+	return nil
+}
+
+// userErrors turns failures the user can act on, like invalid input or a missing permission,
+// into errors for a mutation's payload. Any other error is returned to be reported at the top level.
+func userErrors(err error) ([]*model.UserError, error) {
+	var validationErr *apperrors.ValidationError
+	if errors.As(err, &validationErr) {
+		keys := []string{}
+		for key := range validationErr.Validations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		errs := []*model.UserError{}
+		for _, key := range keys {
+			errs = append(errs, &model.UserError{
+				Message: validationErr.Validations[key],
+				Path:    strings.Split(key, "."),
+			})
+		}
+		return errs, nil
+	}
+
+	var unauthorizedErr *apperrors.UnauthorizedError
+	if errors.As(err, &unauthorizedErr) {
+		return []*model.UserError{{Message: "User is unauthorized", Path: []string{}}}, nil
+	}
+	var notFoundErr *apperrors.ResourceNotFoundError
+	var conflictErr *apperrors.ResourceConflictError
+	var badRequestErr *apperrors.BadRequestError
+	if errors.As(err, &notFoundErr) || errors.As(err, &conflictErr) || errors.As(err, &badRequestErr) {
+		return []*model.UserError{{Message: err.Error(), Path: []string{}}}, nil
+	}
+	return nil, err
+}
+
+// updateSystemIntakeFailed reports a failed change to a system intake
+func updateSystemIntakeFailed(err error) (*model.UpdateSystemIntakePayload, error) {
+	errs, err := userErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UpdateSystemIntakePayload{UserErrors: errs}, nil
+}
+
+// takeAction takes an action on a system intake and returns the intake as it is afterwards
+func (r *Resolver) takeAction(ctx context.Context, action *models.Action) (*model.UpdateSystemIntakePayload, error) {
+	if err := r.service.TakeAction(ctx, action); err != nil {
+		return updateSystemIntakeFailed(err)
+	}
+	intake, err := r.service.FetchSystemIntakeByID(ctx, *action.IntakeID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UpdateSystemIntakePayload{SystemIntake: intake}, nil
+}
+
+// requireInput adds a validation for each of the named input fields that is blank
+func requireInput(valErr apperrors.ValidationError, fields map[string]string) {
+	for field, value := range fields {
+		if strings.TrimSpace(value) == "" {
+			valErr.WithValidation("input."+field, "is required")
+		}
+	}
+}
@@ -5,13 +5,18 @@ package graph
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"net/url"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/graph/generated"
 	"github.com/cmsgov/easi-app/pkg/graph/model"
 	"github.com/cmsgov/easi-app/pkg/models"
@@ -116,14 +121,61 @@ func (r *accessibilityRequestDocumentResolver) UploadedAt(ctx context.Context, o
 	return obj.CreatedAt, nil
 }
 
+func (r *businessCaseResolver) LifecycleCostLines(ctx context.Context, obj *models.BusinessCase) ([]*models.EstimatedLifecycleCost, error) {
+	lines := make([]*models.EstimatedLifecycleCost, len(obj.LifecycleCostLines))
+	for i := range obj.LifecycleCostLines {
+		lines[i] = &obj.LifecycleCostLines[i]
+	}
+	return lines, nil
+}
+
+func (r *businessCaseResolver) LifecycleCostPhases(ctx context.Context, obj *models.BusinessCase) ([]string, error) {
+	phases := []string{}
+	for _, phase := range obj.AllowedLifecycleCostPhases() {
+		phases = append(phases, string(phase))
+	}
+	return phases, nil
+}
+
+func (r *businessCaseResolver) SystemIntake(ctx context.Context, obj *models.BusinessCase) (*models.SystemIntake, error) {
+	return r.service.FetchSystemIntakeByID(ctx, obj.SystemIntakeID)
+}
+
+func (r *estimatedLifecycleCostResolver) Phase(ctx context.Context, obj *models.EstimatedLifecycleCost) (*string, error) {
+	if obj.Phase == nil {
+		return nil, nil
+	}
+	phase := string(*obj.Phase)
+	return &phase, nil
+}
+
+func (r *estimatedLifecycleCostResolver) Solution(ctx context.Context, obj *models.EstimatedLifecycleCost) (*string, error) {
+	// lines for the alternatives added to a business case have an alternative ID instead
+	if obj.Solution == "" {
+		return nil, nil
+	}
+	solution := string(obj.Solution)
+	return &solution, nil
+}
+
+func (r *estimatedLifecycleCostResolver) Year(ctx context.Context, obj *models.EstimatedLifecycleCost) (string, error) {
+	return string(obj.Year), nil
+}
+
 func (r *mutationResolver) CreateAccessibilityRequest(ctx context.Context, input model.CreateAccessibilityRequestInput) (*model.CreateAccessibilityRequestPayload, error) {
-	request, err := r.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
+	request := &models.AccessibilityRequest{
 		Name:     input.Name,
 		IntakeID: input.IntakeID,
-	})
+	}
+	err := authorize(ctx, authz.ActionCreate, request)
+	if err == nil {
+		request, err = r.store.CreateAccessibilityRequest(ctx, request)
+	}
 	if err != nil {
+		audit.Record(ctx, authz.ActionCreate, "AccessibilityRequest", "", err)
 		return nil, err
 	}
+	audit.Record(ctx, authz.ActionCreate, "AccessibilityRequest", request.ID.String(), nil)
 
 	return &model.CreateAccessibilityRequestPayload{
 		AccessibilityRequest: request,
@@ -132,13 +184,20 @@ func (r *mutationResolver) CreateAccessibilityRequest(ctx context.Context, input
 }
 
 func (r *mutationResolver) CreateAccessibilityRequestDocument(ctx context.Context, input model.CreateAccessibilityRequestDocumentInput) (*model.CreateAccessibilityRequestDocumentPayload, error) {
+	if err := authorize(ctx, authz.ActionCreate, (*models.AccessibilityRequestDocument)(nil)); err != nil {
+		audit.Record(ctx, authz.ActionCreate, "AccessibilityRequestDocument", "", err)
+		return nil, err
+	}
+
 	url, urlErr := url.Parse(input.URL)
 	if urlErr != nil {
+		audit.Record(ctx, authz.ActionCreate, "AccessibilityRequestDocument", "", urlErr)
 		return nil, urlErr
 	}
 
 	key, keyErr := r.s3Client.KeyFromURL(url)
 	if keyErr != nil {
+		audit.Record(ctx, authz.ActionCreate, "AccessibilityRequestDocument", "", keyErr)
 		return nil, keyErr
 	}
 
@@ -153,8 +212,10 @@ func (r *mutationResolver) CreateAccessibilityRequestDocument(ctx context.Contex
 	})
 
 	if docErr != nil {
+		audit.Record(ctx, authz.ActionCreate, "AccessibilityRequestDocument", "", docErr)
 		return nil, docErr
 	}
+	audit.Record(ctx, authz.ActionCreate, "AccessibilityRequestDocument", doc.ID.String(), nil)
 	if url, urlErr := r.s3Client.NewGetPresignedURL(key); urlErr == nil {
 		doc.URL = url.URL
 	}
@@ -164,6 +225,38 @@ func (r *mutationResolver) CreateAccessibilityRequestDocument(ctx context.Contex
 	}, nil
 }
 
+func (r *mutationResolver) CreateNote(ctx context.Context, input model.CreateNoteInput) (*model.CreateNotePayload, error) {
+	valErr := apperrors.NewValidationError(errors.New("note failed validation"), models.Note{}, "")
+	requireInput(valErr, map[string]string{"authorName": input.AuthorName, "content": input.Content})
+	if len(valErr.Validations) > 0 {
+		errs, _ := userErrors(&valErr)
+		return &model.CreateNotePayload{UserErrors: errs}, nil
+	}
+
+	note, err := r.service.CreateNote(ctx, &models.Note{
+		SystemIntakeID: input.IntakeID,
+		AuthorName:     null.StringFrom(input.AuthorName),
+		Content:        null.StringFrom(input.Content),
+	})
+	if err != nil {
+		errs, err := userErrors(err)
+		if err != nil {
+			return nil, err
+		}
+		return &model.CreateNotePayload{UserErrors: errs}, nil
+	}
+	return &model.CreateNotePayload{Note: note}, nil
+}
+
+func (r *mutationResolver) CreateSystemIntakeAction(ctx context.Context, input model.BasicActionInput) (*model.UpdateSystemIntakePayload, error) {
+	return r.takeAction(ctx, &models.Action{
+		IntakeID:            &input.IntakeID,
+		ActionType:          input.ActionType,
+		Feedback:            null.StringFromPtr(input.Feedback),
+		NotifyCollaborators: input.NotifyCollaborators != nil && *input.NotifyCollaborators,
+	})
+}
+
 func (r *mutationResolver) CreateTestDate(ctx context.Context, input model.CreateTestDateInput) (*model.CreateTestDatePayload, error) {
 	testDate, err := r.service.CreateTestDate(ctx, &models.TestDate{
 		TestType:  input.TestType,
@@ -172,13 +265,58 @@ func (r *mutationResolver) CreateTestDate(ctx context.Context, input model.Creat
 		RequestID: input.RequestID,
 	})
 	if err != nil {
-		return nil, err
+		errs, err := userErrors(err)
+		if err != nil {
+			return nil, err
+		}
+		return &model.CreateTestDatePayload{UserErrors: errs}, nil
 	}
 	return &model.CreateTestDatePayload{TestDate: testDate, UserErrors: nil}, nil
 }
 
+func (r *mutationResolver) DeleteAccessibilityRequest(ctx context.Context, input model.AccessibilityRequestIDInput) (*model.DeleteAccessibilityRequestPayload, error) {
+	err := authorize(ctx, authz.ActionRemove, (*models.AccessibilityRequest)(nil))
+	if err == nil {
+		err = r.store.SoftDeleteAccessibilityRequest(ctx, input.ID, appcontext.Principal(ctx).ID())
+	}
+	audit.Record(ctx, authz.ActionRemove, "AccessibilityRequest", input.ID.String(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DeleteAccessibilityRequestPayload{ID: &input.ID}, nil
+}
+
+func (r *mutationResolver) DeleteAccessibilityRequestDocument(ctx context.Context, input model.AccessibilityRequestDocumentIDInput) (*model.DeleteAccessibilityRequestDocumentPayload, error) {
+	err := authorize(ctx, authz.ActionRemove, (*models.AccessibilityRequestDocument)(nil))
+	if err == nil {
+		err = r.store.SoftDeleteAccessibilityRequestDocument(ctx, input.ID, appcontext.Principal(ctx).ID())
+	}
+	audit.Record(ctx, authz.ActionRemove, "AccessibilityRequestDocument", input.ID.String(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DeleteAccessibilityRequestDocumentPayload{ID: &input.ID}, nil
+}
+
+func (r *mutationResolver) DeleteTestDate(ctx context.Context, input model.DeleteTestDateInput) (*model.DeleteTestDatePayload, error) {
+	testDate, err := r.service.DeleteTestDate(ctx, input.ID)
+	if err != nil {
+		errs, err := userErrors(err)
+		if err != nil {
+			return nil, err
+		}
+		return &model.DeleteTestDatePayload{UserErrors: errs}, nil
+	}
+	return &model.DeleteTestDatePayload{TestDate: testDate}, nil
+}
+
 func (r *mutationResolver) GeneratePresignedUploadURL(ctx context.Context, input model.GeneratePresignedUploadURLInput) (*model.GeneratePresignedUploadURLPayload, error) {
-	url, err := r.s3Client.NewPutPresignedURL(input.MimeType)
+	err := authorize(ctx, authz.ActionCreate, (*models.AccessibilityRequestDocument)(nil))
+	var url *models.PreSignedURL
+	if err == nil {
+		url, err = r.s3Client.NewPutPresignedURL(input.MimeType)
+	}
+	audit.Record(ctx, authz.ActionCreate, "PreSignedURL", "", err)
 	if err != nil {
 		return nil, err
 	}
@@ -187,16 +325,169 @@ func (r *mutationResolver) GeneratePresignedUploadURL(ctx context.Context, input
 	}, nil
 }
 
+func (r *mutationResolver) IssueLifecycleID(ctx context.Context, input model.IssueLifecycleIDInput) (*model.UpdateSystemIntakePayload, error) {
+	valErr := apperrors.NewValidationError(
+		errors.New("system intake lifecycle fields failed validation"),
+		models.SystemIntake{},
+		input.IntakeID.String(),
+	)
+	requireInput(valErr, map[string]string{
+		"feedback":  input.Feedback,
+		"nextSteps": input.NextSteps,
+		"scope":     input.Scope,
+	})
+	if len(valErr.Validations) > 0 {
+		return updateSystemIntakeFailed(&valErr)
+	}
+
+	intake, err := r.service.IssueLifecycleID(
+		ctx,
+		&models.SystemIntake{
+			ID:                 input.IntakeID,
+			LifecycleID:        null.StringFromPtr(input.Lcid),
+			LifecycleExpiresAt: &input.ExpiresAt,
+			LifecycleScope:     null.StringFrom(input.Scope),
+			DecisionNextSteps:  null.StringFrom(input.NextSteps),
+		},
+		&models.Action{
+			Feedback:            null.StringFrom(input.Feedback),
+			NotifyCollaborators: input.NotifyCollaborators != nil && *input.NotifyCollaborators,
+		},
+	)
+	if err != nil {
+		return updateSystemIntakeFailed(err)
+	}
+	return &model.UpdateSystemIntakePayload{SystemIntake: intake}, nil
+}
+
+func (r *mutationResolver) RejectIntake(ctx context.Context, input model.RejectIntakeInput) (*model.UpdateSystemIntakePayload, error) {
+	valErr := apperrors.NewValidationError(
+		errors.New("system intake rejection fields failed validation"),
+		models.SystemIntake{},
+		input.IntakeID.String(),
+	)
+	requireInput(valErr, map[string]string{
+		"feedback":  input.Feedback,
+		"nextSteps": input.NextSteps,
+		"reason":    input.Reason,
+	})
+	if len(valErr.Validations) > 0 {
+		return updateSystemIntakeFailed(&valErr)
+	}
+
+	intake, err := r.service.RejectIntake(
+		ctx,
+		&models.SystemIntake{
+			ID:                input.IntakeID,
+			RejectionReason:   null.StringFrom(input.Reason),
+			DecisionNextSteps: null.StringFrom(input.NextSteps),
+		},
+		&models.Action{
+			Feedback:            null.StringFrom(input.Feedback),
+			NotifyCollaborators: input.NotifyCollaborators != nil && *input.NotifyCollaborators,
+		},
+	)
+	if err != nil {
+		return updateSystemIntakeFailed(err)
+	}
+	return &model.UpdateSystemIntakePayload{SystemIntake: intake}, nil
+}
+
+func (r *mutationResolver) RestoreAccessibilityRequest(ctx context.Context, input model.AccessibilityRequestIDInput) (*model.RestoreAccessibilityRequestPayload, error) {
+	err := authorize(ctx, authz.ActionRemove, (*models.AccessibilityRequest)(nil))
+	if err == nil {
+		err = r.store.RestoreAccessibilityRequest(ctx, input.ID)
+	}
+	audit.Record(ctx, "restore", "AccessibilityRequest", input.ID.String(), err)
+	if err != nil {
+		return nil, err
+	}
+	request, err := r.store.FetchAccessibilityRequestByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.RestoreAccessibilityRequestPayload{AccessibilityRequest: request}, nil
+}
+
+func (r *mutationResolver) RestoreAccessibilityRequestDocument(ctx context.Context, input model.AccessibilityRequestDocumentIDInput) (*model.RestoreAccessibilityRequestDocumentPayload, error) {
+	err := authorize(ctx, authz.ActionRemove, (*models.AccessibilityRequestDocument)(nil))
+	if err == nil {
+		err = r.store.RestoreAccessibilityRequestDocument(ctx, input.ID)
+	}
+	audit.Record(ctx, "restore", "AccessibilityRequestDocument", input.ID.String(), err)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := r.store.FetchAccessibilityRequestDocumentByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if url, urlErr := r.s3Client.NewGetPresignedURL(doc.Key); urlErr == nil {
+		doc.URL = url.URL
+	}
+	return &model.RestoreAccessibilityRequestDocumentPayload{AccessibilityRequestDocument: doc}, nil
+}
+
+func (r *mutationResolver) SubmitIntake(ctx context.Context, input model.SubmitIntakeInput) (*model.UpdateSystemIntakePayload, error) {
+	return r.takeAction(ctx, &models.Action{
+		IntakeID:   &input.ID,
+		ActionType: models.ActionTypeSUBMITINTAKE,
+	})
+}
+
+func (r *mutationResolver) UpdateBusinessCase(ctx context.Context, input model.UpdateBusinessCaseInput) (*model.UpdateBusinessCasePayload, error) {
+	businessCase, err := r.service.FetchBusinessCaseByID(ctx, input.ID)
+	if err == nil {
+		applyBusinessCaseInput(businessCase, input)
+		businessCase, err = r.service.UpdateBusinessCase(ctx, businessCase)
+	}
+	if err != nil {
+		errs, err := userErrors(err)
+		if err != nil {
+			return nil, err
+		}
+		return &model.UpdateBusinessCasePayload{UserErrors: errs}, nil
+	}
+	return &model.UpdateBusinessCasePayload{BusinessCase: businessCase}, nil
+}
+
 func (r *mutationResolver) UpdateTestDate(ctx context.Context, input model.UpdateTestDateInput) (*model.UpdateTestDatePayload, error) {
-	panic(fmt.Errorf("not implemented"))
+	testDate, err := r.service.UpdateTestDate(ctx, &models.TestDate{
+		ID:       input.ID,
+		TestType: input.TestType,
+		Date:     input.Date,
+		Score:    input.Score,
+	})
+	if err != nil {
+		errs, err := userErrors(err)
+		if err != nil {
+			return nil, err
+		}
+		return &model.UpdateTestDatePayload{UserErrors: errs}, nil
+	}
+	return &model.UpdateTestDatePayload{TestDate: testDate}, nil
 }
 
 func (r *queryResolver) AccessibilityRequest(ctx context.Context, id uuid.UUID) (*models.AccessibilityRequest, error) {
-	return r.store.FetchAccessibilityRequestByID(ctx, id)
+	request, err := r.store.FetchAccessibilityRequestByID(ctx, id)
+	if err == nil {
+		err = authorize(ctx, authz.ActionRead, request)
+	}
+	audit.Record(ctx, authz.ActionRead, "AccessibilityRequest", id.String(), err)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
 }
 
 func (r *queryResolver) AccessibilityRequests(ctx context.Context, after *string, first int) (*model.AccessibilityRequestsConnection, error) {
+	if err := authorize(ctx, authz.ActionRead, (*models.AccessibilityRequest)(nil)); err != nil {
+		audit.Record(ctx, authz.ActionRead, "AccessibilityRequests", "", err)
+		return nil, err
+	}
+
 	requests, queryErr := r.store.FetchAccessibilityRequests(ctx)
+	audit.Record(ctx, authz.ActionRead, "AccessibilityRequests", "", queryErr)
 	if queryErr != nil {
 		return nil, gqlerror.Errorf("query error: %s", queryErr)
 	}
@@ -213,8 +504,33 @@ func (r *queryResolver) AccessibilityRequests(ctx context.Context, after *string
 	return &model.AccessibilityRequestsConnection{Edges: edges}, nil
 }
 
+func (r *queryResolver) BusinessCase(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+	return r.service.FetchBusinessCaseByID(ctx, id)
+}
+
+func (r *queryResolver) SystemIntake(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+	return r.service.FetchSystemIntakeByID(ctx, id)
+}
+
+func (r *queryResolver) SystemIntakes(ctx context.Context, statusFilter *models.SystemIntakeStatusFilter) ([]*models.SystemIntake, error) {
+	var filter models.SystemIntakeStatusFilter
+	if statusFilter != nil {
+		filter = *statusFilter
+	}
+	intakes, err := r.service.FetchSystemIntakes(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*models.SystemIntake, len(intakes))
+	for i := range intakes {
+		results[i] = &intakes[i]
+	}
+	return results, nil
+}
+
 func (r *queryResolver) Systems(ctx context.Context, after *string, first int) (*model.SystemConnection, error) {
 	systems, err := r.store.ListSystems(ctx)
+	audit.Record(ctx, authz.ActionRead, "Systems", "", err)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +548,29 @@ func (r *queryResolver) Systems(ctx context.Context, after *string, first int) (
 	return conn, nil
 }
 
+func (r *systemIntakeResolver) Actions(ctx context.Context, obj *models.SystemIntake) ([]*models.Action, error) {
+	actions, err := r.service.FetchActionsByRequestID(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*models.Action, len(actions))
+	for i := range actions {
+		results[i] = &actions[i]
+	}
+	return results, nil
+}
+
+func (r *systemIntakeResolver) BusinessCase(ctx context.Context, obj *models.SystemIntake) (*models.BusinessCase, error) {
+	if obj.BusinessCaseID == nil {
+		return nil, nil
+	}
+	return r.service.FetchBusinessCaseByID(ctx, *obj.BusinessCaseID)
+}
+
+func (r *systemIntakeResolver) Notes(ctx context.Context, obj *models.SystemIntake) ([]*models.Note, error) {
+	return r.service.FetchNotes(ctx, obj.ID)
+}
+
 // AccessibilityRequest returns generated.AccessibilityRequestResolver implementation.
 func (r *Resolver) AccessibilityRequest() generated.AccessibilityRequestResolver {
 	return &accessibilityRequestResolver{r}
@@ -242,13 +581,27 @@ func (r *Resolver) AccessibilityRequestDocument() generated.AccessibilityRequest
 	return &accessibilityRequestDocumentResolver{r}
 }
 
+// BusinessCase returns generated.BusinessCaseResolver implementation.
+func (r *Resolver) BusinessCase() generated.BusinessCaseResolver { return &businessCaseResolver{r} }
+
+// EstimatedLifecycleCost returns generated.EstimatedLifecycleCostResolver implementation.
+func (r *Resolver) EstimatedLifecycleCost() generated.EstimatedLifecycleCostResolver {
+	return &estimatedLifecycleCostResolver{r}
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// SystemIntake returns generated.SystemIntakeResolver implementation.
+func (r *Resolver) SystemIntake() generated.SystemIntakeResolver { return &systemIntakeResolver{r} }
+
 type accessibilityRequestResolver struct{ *Resolver }
 type accessibilityRequestDocumentResolver struct{ *Resolver }
+type businessCaseResolver struct{ *Resolver }
+type estimatedLifecycleCostResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type systemIntakeResolver struct{ *Resolver }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -21,8 +22,12 @@ import (
 	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/graph/generated"
 	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/services"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 	"github.com/cmsgov/easi-app/pkg/upload"
@@ -93,6 +98,13 @@ func (m mockS3Client) GetObjectTagging(input *s3.GetObjectTaggingInput) (*s3.Get
 	}, nil
 }
 
+// asPrincipal makes GraphQL requests as the given user
+func asPrincipal(principal authn.Principal) client.Option {
+	return func(request *client.Request) {
+		request.HTTP = request.HTTP.WithContext(appcontext.WithPrincipal(request.HTTP.Context(), principal))
+	}
+}
+
 func TestGraphQLTestSuite(t *testing.T) {
 	config := testhelpers.NewConfig()
 
@@ -123,8 +135,113 @@ func TestGraphQLTestSuite(t *testing.T) {
 	mockClient := mockS3Client{}
 	s3Client := upload.NewS3ClientUsingClient(&mockClient, s3Config)
 
-	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(store, ResolverService{}, &s3Client)})
-	graphQLClient := client.New(handler.NewDefaultServer(schema))
+	serviceConfig := services.NewConfig(logger, ldClient)
+	// user lookups and emails would go to CEDAR and SES
+	fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+		return &models.UserInfo{EuaUserID: euaID, CommonName: "Test User", Email: "test.user@example.com"}, nil
+	}
+	saveAction := services.NewSaveAction(store.CreateAction, fetchUserInfo)
+	recordFieldChanges := func(context.Context, interface{}, interface{}) {}
+	resolverService := ResolverService{
+		CreateNote: services.NewCreateNote(
+			serviceConfig,
+			store.CreateNote,
+			services.NewAuthorize(authz.ActionCreate, (*models.Note)(nil)),
+		),
+		CreateTestDate: services.NewCreateTestDate(
+			serviceConfig,
+			services.NewAuthorizeHasEASiRole(),
+			store.CreateTestDate,
+		),
+		DeleteTestDate: services.NewDeleteTestDate(
+			serviceConfig,
+			services.NewAuthorizeHasEASiRole(),
+			store.FetchTestDateByID,
+			store.SoftDeleteTestDate,
+			recordFieldChanges,
+		),
+		FetchActionsByRequestID: services.NewFetchActionsByRequestID(
+			services.NewAuthorize(authz.ActionRead, (*models.Action)(nil)),
+			store.GetActionsByRequestID,
+		),
+		FetchBusinessCaseByID: services.NewFetchBusinessCaseByID(
+			serviceConfig,
+			store.FetchBusinessCaseByID,
+			services.NewAuthorizeBusinessCase(authz.ActionRead),
+		),
+		FetchNotes: services.NewFetchNotes(
+			serviceConfig,
+			store.FetchNotesBySystemIntakeID,
+			services.NewAuthorize(authz.ActionRead, (*models.Note)(nil)),
+		),
+		FetchSystemIntakeByID: services.NewFetchSystemIntakeByID(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+		),
+		FetchSystemIntakes: services.NewFetchSystemIntakes(
+			serviceConfig,
+			store.FetchSystemIntakesByEuaID,
+			store.FetchSystemIntakes,
+			store.FetchSystemIntakesByStatuses,
+			services.NewAuthorize(authz.ActionRead, models.SystemIntakes(nil)),
+		),
+		IssueLifecycleID: services.NewUpdateLifecycleFields(
+			serviceConfig,
+			services.NewAuthorizeRequireGRTJobCode(),
+			store.FetchSystemIntakeByID,
+			store.UpdateSystemIntake,
+			saveAction,
+			fetchUserInfo,
+			func(context.Context, string, string, *time.Time, string, string, string) error { return nil },
+			store.GenerateLifecycleID,
+			recordFieldChanges,
+		),
+		RejectIntake: services.NewUpdateRejectionFields(
+			serviceConfig,
+			services.NewAuthorizeRequireGRTJobCode(),
+			store.FetchSystemIntakeByID,
+			store.UpdateSystemIntake,
+			saveAction,
+			fetchUserInfo,
+			func(context.Context, string, string, string, string) error { return nil },
+			recordFieldChanges,
+		),
+		TakeAction: services.NewTakeAction(
+			store.FetchSystemIntakeByID,
+			map[models.ActionType]services.ActionExecuter{
+				models.ActionTypeNOTITREQUEST: services.NewTakeActionUpdateStatus(
+					serviceConfig,
+					models.SystemIntakeStatusNOTITREQUEST,
+					store.UpdateSystemIntake,
+					services.NewAuthorizeRequireGRTJobCode(),
+					saveAction,
+					fetchUserInfo,
+					func(context.Context, string, string) error { return nil },
+					true,
+					services.NewCloseBusinessCase(serviceConfig, store.FetchBusinessCaseByID, store.UpdateBusinessCase),
+				),
+			},
+		),
+		UpdateBusinessCase: services.NewUpdateBusinessCase(
+			serviceConfig,
+			store.FetchBusinessCaseByID,
+			services.NewAuthorizeUserIsBusinessCaseRequester(),
+			store.UpdateBusinessCase,
+			recordFieldChanges,
+		),
+		UpdateTestDate: services.NewUpdateTestDate(
+			serviceConfig,
+			services.NewAuthorizeHasEASiRole(),
+			store.FetchTestDateByID,
+			store.UpdateTestDate,
+			recordFieldChanges,
+		),
+	}
+
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(store, resolverService, &s3Client)})
+	tester := &authn.EUAPrincipal{EUAID: "TEST", JobCodeEASi: true, JobCode508Tester: true}
+	graphQLClient := client.New(handler.NewDefaultServer(schema), asPrincipal(tester))
 
 	storeTestSuite := &GraphQLTestSuite{
 		Suite:    suite.Suite{},
@@ -321,6 +438,38 @@ func (s GraphQLTestSuite) TestAccessibilityRequestVirusStatusQuery() {
 	s.Equal("UNAVAILABLE", responseDocument.Status)
 }
 
+func (s GraphQLTestSuite) TestAccessibilityRequestQueryIsAuthorized() {
+	ctx := context.Background()
+
+	intake, intakeErr := s.store.CreateSystemIntake(ctx, &models.SystemIntake{
+		Status:      models.SystemIntakeStatusLCIDISSUED,
+		RequestType: models.SystemIntakeRequestTypeNEW,
+	})
+	s.NoError(intakeErr)
+
+	accessibilityRequest, requestErr := s.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
+		IntakeID: intake.ID,
+	})
+	s.NoError(requestErr)
+
+	var resp struct {
+		AccessibilityRequest *struct {
+			ID string
+		}
+	}
+
+	err := s.client.Post(fmt.Sprintf(
+		`query {
+			accessibilityRequest(id: "%s") {
+				id
+			}
+		}`, accessibilityRequest.ID), &resp, asPrincipal(testhelpers.NewRequesterPrincipal()))
+
+	s.Error(err)
+	s.Contains(err.Error(), "User is unauthorized")
+	s.Nil(resp.AccessibilityRequest)
+}
+
 func (s GraphQLTestSuite) TestGeneratePresignedUploadURLMutation() {
 	var resp struct {
 		GeneratePresignedUploadURL struct {
@@ -428,3 +577,564 @@ func (s GraphQLTestSuite) TestCreateAccessibilityRequestDocumentMutation() {
 	s.Equal(accessibilityRequest.ID.String(), document.RequestID)
 	s.Equal("https://signed.example.com/signed/get/123", document.URL)
 }
+
+func (s GraphQLTestSuite) TestDeleteAndRestoreAccessibilityRequestMutations() {
+	ctx := context.Background()
+
+	intake, intakeErr := s.store.CreateSystemIntake(ctx, &models.SystemIntake{
+		Status:      models.SystemIntakeStatusLCIDISSUED,
+		RequestType: models.SystemIntakeRequestTypeNEW,
+	})
+	s.NoError(intakeErr)
+
+	accessibilityRequest, requestErr := s.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
+		Name:     "Duplicate request",
+		IntakeID: intake.ID,
+	})
+	s.NoError(requestErr)
+
+	var deleteResp struct {
+		DeleteAccessibilityRequest struct {
+			ID string
+		}
+	}
+	deleteMutation := fmt.Sprintf(
+		`mutation {
+			deleteAccessibilityRequest(input: {id: "%s"}) {
+				id
+			}
+		}`, accessibilityRequest.ID)
+
+	err := s.client.Post(deleteMutation, &deleteResp)
+	s.Error(err)
+	s.Contains(err.Error(), "User is unauthorized")
+
+	s.client.MustPost(deleteMutation, &deleteResp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+	s.Equal(accessibilityRequest.ID.String(), deleteResp.DeleteAccessibilityRequest.ID)
+
+	_, fetchErr := s.store.FetchAccessibilityRequestByID(ctx, accessibilityRequest.ID)
+	s.Error(fetchErr)
+
+	var restoreResp struct {
+		RestoreAccessibilityRequest struct {
+			AccessibilityRequest struct {
+				ID   string
+				Name string
+			}
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`mutation {
+			restoreAccessibilityRequest(input: {id: "%s"}) {
+				accessibilityRequest {
+					id
+					name
+				}
+			}
+		}`, accessibilityRequest.ID), &restoreResp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+
+	s.Equal(accessibilityRequest.ID.String(), restoreResp.RestoreAccessibilityRequest.AccessibilityRequest.ID)
+	s.Equal("Duplicate request", restoreResp.RestoreAccessibilityRequest.AccessibilityRequest.Name)
+}
+
+func (s GraphQLTestSuite) TestSystemIntakeQuery() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.EUAUserID = intake.EUAUserID.ValueOrZero()
+	businessCase.SystemIntakeID = intake.ID
+	_, err = s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+	action := testhelpers.NewAction()
+	action.IntakeID = &intake.ID
+	_, err = s.store.CreateAction(ctx, &action)
+	s.NoError(err)
+	_, err = s.store.CreateNote(ctx, &models.Note{
+		SystemIntakeID: intake.ID,
+		AuthorEUAID:    "REV",
+		AuthorName:     null.StringFrom("Reviewer"),
+		Content:        null.StringFrom("A note"),
+	})
+	s.NoError(err)
+
+	var resp struct {
+		SystemIntake struct {
+			ID           string
+			ProjectName  string
+			Status       string
+			BusinessCase struct {
+				ID                 string
+				LifecycleCostLines []struct {
+					Solution *string
+					Year     string
+				}
+				SystemIntake struct {
+					ID string
+				}
+			}
+			Actions []struct {
+				ActionType string
+				ActorName  string
+			}
+			Notes []struct {
+				AuthorEUAID string
+				Content     string
+			}
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`query {
+			systemIntake(id: "%s") {
+				id
+				projectName
+				status
+				businessCase {
+					id
+					lifecycleCostLines {
+						solution
+						year
+					}
+					systemIntake {
+						id
+					}
+				}
+				actions {
+					actionType
+					actorName
+				}
+				notes {
+					authorEuaId
+					content
+				}
+			}
+		}`, intake.ID), &resp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+
+	s.Equal(intake.ID.String(), resp.SystemIntake.ID)
+	s.Equal("Test Project Name", resp.SystemIntake.ProjectName)
+	s.Equal("INTAKE_DRAFT", resp.SystemIntake.Status)
+	s.Equal(businessCase.ID.String(), resp.SystemIntake.BusinessCase.ID)
+	s.Equal(intake.ID.String(), resp.SystemIntake.BusinessCase.SystemIntake.ID)
+	s.Len(resp.SystemIntake.BusinessCase.LifecycleCostLines, len(businessCase.LifecycleCostLines))
+	s.Len(resp.SystemIntake.Actions, 1)
+	s.Equal("SUBMIT_INTAKE", resp.SystemIntake.Actions[0].ActionType)
+	s.Len(resp.SystemIntake.Notes, 1)
+	s.Equal("REV", resp.SystemIntake.Notes[0].AuthorEUAID)
+	s.Equal("A note", resp.SystemIntake.Notes[0].Content)
+}
+
+func (s GraphQLTestSuite) TestSystemIntakeNotesAreOnlyForTheGRT() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	intake.EUAUserID = null.StringFrom("REQ")
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	var resp struct {
+		SystemIntake *struct {
+			ID    string
+			Notes []struct {
+				Content string
+			}
+		}
+	}
+	err = s.client.Post(fmt.Sprintf(
+		`query {
+			systemIntake(id: "%s") {
+				id
+				notes {
+					content
+				}
+			}
+		}`, intake.ID), &resp, asPrincipal(testhelpers.NewRequesterPrincipal()))
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to authorize fetch notes")
+	s.Equal(intake.ID.String(), resp.SystemIntake.ID)
+	s.Nil(resp.SystemIntake.Notes)
+}
+
+// userErrorsResponse is the userErrors of a mutation's payload
+type userErrorsResponse []struct {
+	Message string
+	Path    []string
+}
+
+func (s GraphQLTestSuite) TestRejectIntakeMutation() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	intake.Status = models.SystemIntakeStatusINTAKESUBMITTED
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	type response struct {
+		RejectIntake struct {
+			SystemIntake *struct {
+				Status            string
+				RejectionReason   string
+				DecisionNextSteps string
+			}
+			UserErrors userErrorsResponse
+		}
+	}
+	var resp response
+	mutation := func(reason string) string {
+		return fmt.Sprintf(
+			`mutation {
+				rejectIntake(input: {intakeId: "%s", reason: "%s", nextSteps: "Try again", feedback: "Not yet"}) {
+					systemIntake {
+						status
+						rejectionReason
+						decisionNextSteps
+					}
+					userErrors {
+						message
+						path
+					}
+				}
+			}`, intake.ID, reason)
+	}
+
+	s.client.MustPost(mutation("Too vague"), &resp, asPrincipal(testhelpers.NewRequesterPrincipal()))
+	s.Nil(resp.RejectIntake.SystemIntake)
+	s.Len(resp.RejectIntake.UserErrors, 1)
+	s.Equal("User is unauthorized", resp.RejectIntake.UserErrors[0].Message)
+
+	resp = response{}
+	s.client.MustPost(mutation(" "), &resp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+	s.Nil(resp.RejectIntake.SystemIntake)
+	s.Len(resp.RejectIntake.UserErrors, 1)
+	s.Equal("is required", resp.RejectIntake.UserErrors[0].Message)
+	s.Equal([]string{"input", "reason"}, resp.RejectIntake.UserErrors[0].Path)
+
+	resp = response{}
+	s.client.MustPost(mutation("Too vague"), &resp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+	s.Empty(resp.RejectIntake.UserErrors)
+	s.Equal("NOT_APPROVED", resp.RejectIntake.SystemIntake.Status)
+	s.Equal("Too vague", resp.RejectIntake.SystemIntake.RejectionReason)
+	s.Equal("Try again", resp.RejectIntake.SystemIntake.DecisionNextSteps)
+
+	actions, err := s.store.GetActionsByRequestID(ctx, intake.ID)
+	s.NoError(err)
+	s.Len(actions, 1)
+	s.Equal(models.ActionTypeREJECT, actions[0].ActionType)
+}
+
+func (s GraphQLTestSuite) TestIssueLifecycleIdMutation() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	intake.Status = models.SystemIntakeStatusREADYFORGRB
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	type response struct {
+		IssueLifecycleID struct {
+			SystemIntake struct {
+				Status    string
+				Lcid      string
+				LcidScope string
+			}
+			UserErrors userErrorsResponse
+		}
+	}
+	var resp response
+	s.client.MustPost(fmt.Sprintf(
+		`mutation {
+			issueLifecycleId(input: {
+				intakeId: "%s",
+				expiresAt: "2030-01-01T00:00:00Z",
+				scope: "All of it",
+				nextSteps: "Build it",
+				feedback: "Looks good"
+			}) {
+				systemIntake {
+					status
+					lcid
+					lcidScope
+				}
+				userErrors {
+					message
+					path
+				}
+			}
+		}`, intake.ID), &resp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+
+	s.Empty(resp.IssueLifecycleID.UserErrors)
+	s.Equal("LCID_ISSUED", resp.IssueLifecycleID.SystemIntake.Status)
+	s.NotEmpty(resp.IssueLifecycleID.SystemIntake.Lcid)
+	s.Equal("All of it", resp.IssueLifecycleID.SystemIntake.LcidScope)
+}
+
+func (s GraphQLTestSuite) TestCreateSystemIntakeActionMutation() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	intake.Status = models.SystemIntakeStatusINTAKESUBMITTED
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	type response struct {
+		CreateSystemIntakeAction struct {
+			SystemIntake *struct {
+				Status string
+			}
+			UserErrors userErrorsResponse
+		}
+	}
+	var resp response
+	mutation := func(actionType string) string {
+		return fmt.Sprintf(
+			`mutation {
+				createSystemIntakeAction(input: {intakeId: "%s", actionType: %s, feedback: "Thanks"}) {
+					systemIntake {
+						status
+					}
+					userErrors {
+						message
+					}
+				}
+			}`, intake.ID, actionType)
+	}
+
+	s.client.MustPost(mutation("TRANSFER_OWNERSHIP"), &resp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+	s.Nil(resp.CreateSystemIntakeAction.SystemIntake)
+	s.Len(resp.CreateSystemIntakeAction.UserErrors, 1)
+	s.Contains(resp.CreateSystemIntakeAction.UserErrors[0].Message, "invalid action type")
+
+	resp = response{}
+	s.client.MustPost(mutation("NOT_IT_REQUEST"), &resp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+	s.Empty(resp.CreateSystemIntakeAction.UserErrors)
+	s.Equal("NOT_IT_REQUEST", resp.CreateSystemIntakeAction.SystemIntake.Status)
+}
+
+func (s GraphQLTestSuite) TestCreateNoteMutation() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	type response struct {
+		CreateNote struct {
+			Note *struct {
+				AuthorEUAID string
+				Content     string
+			}
+			UserErrors userErrorsResponse
+		}
+	}
+	var resp response
+	mutation := fmt.Sprintf(
+		`mutation {
+			createNote(input: {intakeId: "%s", authorName: "Reviewer", content: "Waiting on the ISSO"}) {
+				note {
+					authorEuaId
+					content
+				}
+				userErrors {
+					message
+				}
+			}
+		}`, intake.ID)
+
+	s.client.MustPost(mutation, &resp, asPrincipal(testhelpers.NewRequesterPrincipal()))
+	s.Nil(resp.CreateNote.Note)
+	s.Len(resp.CreateNote.UserErrors, 1)
+
+	resp = response{}
+	s.client.MustPost(mutation, &resp, asPrincipal(testhelpers.NewReviewerPrincipal()))
+	s.Empty(resp.CreateNote.UserErrors)
+	s.Equal("REV", resp.CreateNote.Note.AuthorEUAID)
+	s.Equal("Waiting on the ISSO", resp.CreateNote.Note.Content)
+}
+
+func (s GraphQLTestSuite) TestUpdateBusinessCaseMutation() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	intake.EUAUserID = null.StringFrom("REQ")
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.EUAUserID = "REQ"
+	businessCase.SystemIntakeID = intake.ID
+	_, err = s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+
+	type response struct {
+		UpdateBusinessCase struct {
+			BusinessCase *struct {
+				BusinessNeed       string
+				ProjectName        *string
+				PreferredTitle     *string
+				Status             string
+				LifecycleCostLines []struct {
+					Year string
+				}
+				Version int
+			}
+			UserErrors userErrorsResponse
+		}
+	}
+	var resp response
+	mutation := func(version int) string {
+		return fmt.Sprintf(
+			`mutation {
+				updateBusinessCase(input: {id: "%s", businessNeed: "A newer need", preferredTitle: "A newer title", version: %d}) {
+					businessCase {
+						businessNeed
+						projectName
+						preferredTitle
+						status
+						lifecycleCostLines {
+							year
+						}
+						version
+					}
+					userErrors {
+						message
+					}
+				}
+			}`, businessCase.ID, version)
+	}
+
+	s.client.MustPost(mutation(1), &resp, asPrincipal(testhelpers.NewRequesterPrincipal()))
+	s.Empty(resp.UpdateBusinessCase.UserErrors)
+	s.Equal("A newer need", resp.UpdateBusinessCase.BusinessCase.BusinessNeed)
+	s.Equal(businessCase.ProjectName.Ptr(), resp.UpdateBusinessCase.BusinessCase.ProjectName)
+	s.Equal("A newer title", *resp.UpdateBusinessCase.BusinessCase.PreferredTitle)
+	s.Equal("OPEN", resp.UpdateBusinessCase.BusinessCase.Status)
+	s.Len(resp.UpdateBusinessCase.BusinessCase.LifecycleCostLines, len(businessCase.LifecycleCostLines))
+	s.Equal(2, resp.UpdateBusinessCase.BusinessCase.Version)
+
+	saved, err := s.store.FetchBusinessCaseByID(ctx, businessCase.ID)
+	s.NoError(err)
+	for _, alternative := range saved.Alternatives {
+		if alternative.Role == models.BusinessCaseAlternativeRolePREFERRED {
+			s.Equal("A newer title", alternative.Title.String)
+		}
+	}
+
+	resp = response{}
+	s.client.MustPost(mutation(1), &resp, asPrincipal(testhelpers.NewRequesterPrincipal()))
+	s.Nil(resp.UpdateBusinessCase.BusinessCase)
+	s.Len(resp.UpdateBusinessCase.UserErrors, 1)
+}
+
+func (s GraphQLTestSuite) TestUpdateAndDeleteTestDateMutations() {
+	ctx := context.Background()
+
+	intake, intakeErr := s.store.CreateSystemIntake(ctx, &models.SystemIntake{
+		Status:      models.SystemIntakeStatusLCIDISSUED,
+		RequestType: models.SystemIntakeRequestTypeNEW,
+	})
+	s.NoError(intakeErr)
+	accessibilityRequest, requestErr := s.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
+		Name:     "Test dates",
+		IntakeID: intake.ID,
+	})
+	s.NoError(requestErr)
+	score := 500
+	testDate, testDateErr := s.store.CreateTestDate(ctx, &models.TestDate{
+		RequestID: accessibilityRequest.ID,
+		TestType:  models.TestDateTestTypeInitial,
+		Date:      time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		Score:     &score,
+	})
+	s.NoError(testDateErr)
+
+	type updateResponse struct {
+		UpdateTestDate struct {
+			TestDate *struct {
+				ID       string
+				Score    *int
+				TestType string
+			}
+			UserErrors userErrorsResponse
+		}
+	}
+	var updateResp updateResponse
+	updateMutation := func(score int) string {
+		return fmt.Sprintf(
+			`mutation {
+				updateTestDate(input: {id: "%s", date: "2021-07-01T00:00:00Z", score: %d, testType: REMEDIATION}) {
+					testDate {
+						id
+						score
+						testType
+					}
+					userErrors {
+						message
+						path
+					}
+				}
+			}`, testDate.ID, score)
+	}
+
+	s.client.MustPost(updateMutation(1000), &updateResp)
+	s.Empty(updateResp.UpdateTestDate.UserErrors)
+	s.Equal(testDate.ID.String(), updateResp.UpdateTestDate.TestDate.ID)
+	s.Equal(1000, *updateResp.UpdateTestDate.TestDate.Score)
+	s.Equal("REMEDIATION", updateResp.UpdateTestDate.TestDate.TestType)
+
+	updateResp = updateResponse{}
+	s.client.MustPost(updateMutation(1001), &updateResp)
+	s.Nil(updateResp.UpdateTestDate.TestDate)
+	s.Len(updateResp.UpdateTestDate.UserErrors, 1)
+	s.Equal("must be between 0 and 1000", updateResp.UpdateTestDate.UserErrors[0].Message)
+	s.Equal([]string{"input", "score"}, updateResp.UpdateTestDate.UserErrors[0].Path)
+
+	err := s.client.Post(updateMutation(900), &updateResp, asPrincipal(testhelpers.NewRequesterPrincipal()))
+	s.Error(err)
+
+	var deleteResp struct {
+		DeleteTestDate struct {
+			TestDate struct {
+				ID string
+			}
+			UserErrors userErrorsResponse
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`mutation {
+			deleteTestDate(input: {id: "%s"}) {
+				testDate {
+					id
+				}
+				userErrors {
+					message
+				}
+			}
+		}`, testDate.ID), &deleteResp)
+	s.Empty(deleteResp.DeleteTestDate.UserErrors)
+	s.Equal(testDate.ID.String(), deleteResp.DeleteTestDate.TestDate.ID)
+
+	var queryResp struct {
+		AccessibilityRequest struct {
+			TestDates []struct {
+				ID string
+			}
+			RelevantTestDate *struct {
+				ID string
+			}
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`query {
+			accessibilityRequest(id: "%s") {
+				testDates {
+					id
+				}
+				relevantTestDate {
+					id
+				}
+			}
+		}`, accessibilityRequest.ID), &queryResp)
+	s.Empty(queryResp.AccessibilityRequest.TestDates)
+	s.Nil(queryResp.AccessibilityRequest.RelevantTestDate)
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchAuditEvents func(context.Context, models.AuditEventFilter) (models.AuditEvents, error)
+
+// NewAuditEventsHandler is a constructor for AuditEventsHandler
+func NewAuditEventsHandler(base HandlerBase, fetch fetchAuditEvents) AuditEventsHandler {
+	return AuditEventsHandler{
+		HandlerBase:      base,
+		FetchAuditEvents: fetch,
+	}
+}
+
+// AuditEventsHandler is the handler for querying audit events
+type AuditEventsHandler struct {
+	HandlerBase
+	FetchAuditEvents fetchAuditEvents
+}
+
+// Handle handles a web request and returns a list of audit events
+func (h AuditEventsHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			query := r.URL.Query()
+			valErr := apperrors.NewValidationError(
+				errors.New("audit event filter failed validation"),
+				models.AuditEvents{},
+				"",
+			)
+			filter := models.AuditEventFilter{
+				EUAUserID:    strings.ToUpper(query.Get("euaUserId")),
+				Action:       query.Get("action"),
+				ResourceType: query.Get("resourceType"),
+				ResourceID:   query.Get("resourceId"),
+				Outcome:      models.AuditEventOutcome(strings.ToUpper(query.Get("outcome"))),
+			}
+			switch filter.Outcome {
+			case "", models.AuditEventOutcomeSUCCEEDED, models.AuditEventOutcomeFAILED, models.AuditEventOutcomeDENIED:
+			default:
+				valErr.WithValidation("outcome", "must be SUCCEEDED, FAILED or DENIED")
+			}
+			for key, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+				if value := query.Get(key); value != "" {
+					parsed, err := time.Parse(time.RFC3339, value)
+					if err != nil {
+						valErr.WithValidation(key, "must be RFC3339")
+						continue
+					}
+					*target = &parsed
+				}
+			}
+			if value := query.Get("limit"); value != "" {
+				limit, err := strconv.Atoi(value)
+				if err != nil || limit <= 0 {
+					valErr.WithValidation("limit", "must be a positive integer")
+				}
+				filter.Limit = limit
+			}
+			if len(valErr.Validations) > 0 {
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+
+			events, err := h.FetchAuditEvents(r.Context(), filter)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(events)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestAuditEventsHandler() {
+	var filter models.AuditEventFilter
+	fetch := func(_ context.Context, f models.AuditEventFilter) (models.AuditEvents, error) {
+		filter = f
+		return models.AuditEvents{{EUAUserID: f.EUAUserID, Action: "read"}}, nil
+	}
+	handler := NewAuditEventsHandler(s.base, fetch).Handle()
+
+	newRequest := func(method string, params url.Values) *http.Request {
+		req, err := http.NewRequest(method, "/audit_events?"+params.Encode(), nil)
+		s.NoError(err)
+		return req
+	}
+
+	s.Run("golden path GET passes the filters on", func() {
+		rr := httptest.NewRecorder()
+		handler(rr, newRequest("GET", url.Values{
+			"euaUserId":    {"abcd"},
+			"resourceType": {"SystemIntake"},
+			"outcome":      {"denied"},
+			"from":         {"2021-01-01T00:00:00Z"},
+			"limit":        {"10"},
+		}))
+
+		s.Equal(http.StatusOK, rr.Code)
+		var events models.AuditEvents
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &events))
+		s.Len(events, 1)
+		s.Equal("ABCD", filter.EUAUserID)
+		s.Equal("SystemIntake", filter.ResourceType)
+		s.Equal(models.AuditEventOutcomeDENIED, filter.Outcome)
+		s.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), *filter.From)
+		s.Nil(filter.To)
+		s.Equal(10, filter.Limit)
+	})
+
+	s.Run("invalid filters fail validation", func() {
+		for name, params := range map[string]url.Values{
+			"outcome": {"outcome": {"MAYBE"}},
+			"from":    {"from": {"yesterday"}},
+			"limit":   {"limit": {"-1"}},
+		} {
+			s.Run(name, func() {
+				rr := httptest.NewRecorder()
+				handler(rr, newRequest("GET", params))
+
+				s.Equal(http.StatusUnprocessableEntity, rr.Code)
+			})
+		}
+	})
+
+	s.Run("POST is not allowed", func() {
+		rr := httptest.NewRecorder()
+		handler(rr, newRequest("POST", url.Values{}))
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+}
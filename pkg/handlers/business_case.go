@@ -18,6 +18,7 @@ import (
 type fetchBusinessCaseByID func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error)
 type createBusinessCase func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error)
 type updateBusinessCase func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error)
+type patchBusinessCase func(ctx context.Context, id uuid.UUID, patch map[string]json.RawMessage, version int) (*models.BusinessCase, error)
 
 // NewBusinessCaseHandler is a constructor for BusinessCaseHandler
 func NewBusinessCaseHandler(
@@ -25,12 +26,14 @@ func NewBusinessCaseHandler(
 	fetch fetchBusinessCaseByID,
 	create createBusinessCase,
 	update updateBusinessCase,
+	patch patchBusinessCase,
 ) BusinessCaseHandler {
 	return BusinessCaseHandler{
 		HandlerBase:           base,
 		FetchBusinessCaseByID: fetch,
 		CreateBusinessCase:    create,
 		UpdateBusinessCase:    update,
+		PatchBusinessCase:     patch,
 	}
 }
 
@@ -40,6 +43,7 @@ type BusinessCaseHandler struct {
 	FetchBusinessCaseByID fetchBusinessCaseByID
 	CreateBusinessCase    createBusinessCase
 	UpdateBusinessCase    updateBusinessCase
+	PatchBusinessCase     patchBusinessCase
 }
 
 func requireBusinessCaseID(reqVars map[string]string) (uuid.UUID, error) {
@@ -84,6 +88,8 @@ func (h BusinessCaseHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			w.Header().Set("ETag", etag(businessCase.Version))
+
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -171,12 +177,51 @@ func (h BusinessCaseHandler) Handle() http.HandlerFunc {
 				return
 			}
 			businessCaseToUpdate.EUAUserID = principal.ID()
+			businessCaseToUpdate.Version, err = requestedVersion(r, businessCaseToUpdate.Version)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
 
 			updatedBusinessCase, err := h.UpdateBusinessCase(r.Context(), &businessCaseToUpdate)
 			if err != nil {
 				h.Logger.Error(fmt.Sprintf("Failed to update business case to response: %v", err))
 
 				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(updatedBusinessCase)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("ETag", etag(updatedBusinessCase.Version))
+
+			_, err = w.Write(responseBody)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			return
+		case "PATCH":
+			businessCaseID, err := requireBusinessCaseID(mux.Vars(r))
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			patch, version, err := decodeMergePatch(r)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			updatedBusinessCase, err := h.PatchBusinessCase(r.Context(), businessCaseID, patch, version)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
 			}
 
 			responseBody, err := json.Marshal(updatedBusinessCase)
@@ -185,6 +230,8 @@ func (h BusinessCaseHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			w.Header().Set("ETag", etag(updatedBusinessCase.Version))
+
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -272,6 +272,92 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 		s.Equal(http.StatusConflict, rr.Code)
 	})
 
+	s.Run("PUT sends the If-Match version to the update and returns the new ETag", func() {
+		rr := httptest.NewRecorder()
+		body, err := json.Marshal(map[string]interface{}{
+			"requesterPhoneNumber": "1234567890",
+			"version":              1,
+		})
+		s.NoError(err)
+		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBuffer(body))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		req.Header.Set("If-Match", `"3"`)
+		BusinessCaseHandler{
+			HandlerBase: s.base,
+			UpdateBusinessCase: func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+				s.Equal(3, businessCase.Version)
+				businessCase.Version = 4
+				return businessCase, nil
+			},
+		}.Handle()(rr, req)
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(`"4"`, rr.Header().Get("ETag"))
+	})
+
+	s.Run("PUT fails with an invalid If-Match header", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBufferString("{}"))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		req.Header.Set("If-Match", "latest")
+		BusinessCaseHandler{
+			HandlerBase:        s.base,
+			UpdateBusinessCase: newMockUpdateBusinessCase(nil),
+		}.Handle()(rr, req)
+		s.Equal(http.StatusBadRequest, rr.Code)
+	})
+
+	s.Run("PUT of a stale version returns the current version", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBufferString(`{"version": 2}`))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		BusinessCaseHandler{
+			HandlerBase: s.base,
+			UpdateBusinessCase: newMockUpdateBusinessCase(&apperrors.ResourceConflictError{
+				Err:            fmt.Errorf("stale"),
+				ResourceID:     id.String(),
+				CurrentVersion: 5,
+			}),
+		}.Handle()(rr, req)
+		s.Equal(http.StatusConflict, rr.Code)
+		s.Equal(`"5"`, rr.Header().Get("ETag"))
+		responseErr := errorResponse{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &responseErr))
+		s.Equal([]errorItem{{Field: "version", Message: "5"}}, responseErr.Errors)
+	})
+
+	s.Run("golden path PATCH passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PATCH", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBufferString(`{"businessNeed": null}`))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		req.Header.Set("If-Match", `"7"`)
+		BusinessCaseHandler{
+			HandlerBase: s.base,
+			PatchBusinessCase: func(ctx context.Context, businessCaseID uuid.UUID, patch map[string]json.RawMessage, version int) (*models.BusinessCase, error) {
+				s.Equal(id, businessCaseID)
+				s.Equal(map[string]json.RawMessage{"businessNeed": json.RawMessage("null")}, patch)
+				s.Equal(7, version)
+				return &models.BusinessCase{ID: businessCaseID, Version: 8}, nil
+			},
+		}.Handle()(rr, req)
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(`"8"`, rr.Header().Get("ETag"))
+	})
+
+	s.Run("PATCH fails with an invalid version", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PATCH", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBufferString(`{"version": "latest"}`))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		BusinessCaseHandler{
+			HandlerBase: s.base,
+		}.Handle()(rr, req)
+		s.Equal(http.StatusBadRequest, rr.Code)
+	})
+
 	s.Run("returns an error if there updating fails in another way", func() {
 		rr := httptest.NewRecorder()
 		body, err := json.Marshal(map[string]string{
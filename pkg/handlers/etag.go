@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+// etag is the ETag for a record at the given version
+func etag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// requestedVersion is the version an update expects to overwrite.
+// An If-Match header takes precedence over the version in the body,
+// and 0 (from "If-Match: *" or no version at all) updates unconditionally,
+// which is what the UI's saves rely on until it sends the version it fetched.
+func requestedVersion(r *http.Request, bodyVersion int) (int, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		return bodyVersion, nil
+	}
+	if header == "*" {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(header, "W/"), `"`))
+	if err != nil || version < 1 {
+		return 0, &apperrors.BadRequestError{Err: fmt.Errorf("invalid If-Match header %q", header)}
+	}
+	return version, nil
+}
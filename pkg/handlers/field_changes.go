@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchFieldChanges func(context.Context, uuid.UUID) (models.FieldChanges, error)
+
+// FieldChangesHandler returns the change history of a stored record
+type FieldChangesHandler struct {
+	HandlerBase
+	idKey             string
+	FetchFieldChanges fetchFieldChanges
+}
+
+// NewFieldChangesHandler returns a new FieldChangesHandler for the record identified by the idKey path variable
+func NewFieldChangesHandler(base HandlerBase, idKey string, fetch fetchFieldChanges) FieldChangesHandler {
+	return FieldChangesHandler{
+		HandlerBase:       base,
+		idKey:             idKey,
+		FetchFieldChanges: fetch,
+	}
+}
+
+// Handle returns an http.HandlerFunc
+func (h FieldChangesHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+
+		id, err := uuid.Parse(mux.Vars(r)[h.idKey])
+		if err != nil {
+			valErr := apperrors.NewValidationError(errors.New("change history failed validation"), models.FieldChanges{}, "")
+			valErr.WithValidation("path."+h.idKey, "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+
+		changes, err := h.FetchFieldChanges(r.Context(), id)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+
+		js, err := json.Marshal(changes)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_, err = w.Write(js)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestFieldChangesHandler() {
+	id := uuid.New()
+	fetch := func(_ context.Context, resourceID uuid.UUID) (models.FieldChanges, error) {
+		return models.FieldChanges{{ResourceID: resourceID, Field: "projectName"}}, nil
+	}
+
+	s.Run("golden path GET returns the history", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intake/"+id.String()+"/history", nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": id.String()})
+
+		NewFieldChangesHandler(s.base, "intake_id", fetch).Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		var changes models.FieldChanges
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &changes))
+		s.Len(changes, 1)
+		s.Equal(id, changes[0].ResourceID)
+	})
+
+	s.Run("the id must be a UUID", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/business_case/nope/history", nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": "nope"})
+
+		NewFieldChangesHandler(s.base, "business_case_id", fetch).Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("unauthorized fetches return a 401", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intake/"+id.String()+"/history", nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": id.String()})
+		unauthorized := func(context.Context, uuid.UUID) (models.FieldChanges, error) {
+			return nil, &apperrors.UnauthorizedError{}
+		}
+
+		NewFieldChangesHandler(s.base, "intake_id", unauthorized).Handle()(rr, req)
+
+		s.Equal(http.StatusUnauthorized, rr.Code)
+	})
+
+	s.Run("only GET is allowed", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "/system_intake/"+id.String()+"/history", nil)
+		s.NoError(err)
+
+		NewFieldChangesHandler(s.base, "intake_id", fetch).Handle()(rr, req)
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+}
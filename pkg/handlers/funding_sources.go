@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchFundingSources func(context.Context, uuid.UUID) (models.SystemIntakeFundingSources, error)
+type createFundingSource func(context.Context, *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error)
+type updateFundingSource func(context.Context, *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error)
+type deleteFundingSource func(context.Context, uuid.UUID, uuid.UUID) error
+
+// NewFundingSourcesHandler is a constructor for FundingSourcesHandler
+func NewFundingSourcesHandler(
+	base HandlerBase,
+	fetch fetchFundingSources,
+	create createFundingSource,
+	update updateFundingSource,
+	delete deleteFundingSource,
+) FundingSourcesHandler {
+	return FundingSourcesHandler{
+		HandlerBase:         base,
+		FetchFundingSources: fetch,
+		CreateFundingSource: create,
+		UpdateFundingSource: update,
+		DeleteFundingSource: delete,
+	}
+}
+
+// FundingSourcesHandler is the handler for interacting with the funding sources
+// associated with a SystemIntake
+type FundingSourcesHandler struct {
+	HandlerBase
+	FetchFundingSources fetchFundingSources
+	CreateFundingSource createFundingSource
+	UpdateFundingSource updateFundingSource
+	DeleteFundingSource deleteFundingSource
+}
+
+// Handle handles a web request for the funding sources of a system intake
+func (h FundingSourcesHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		valErr := apperrors.NewValidationError(
+			errors.New("funding source failed validation"),
+			models.SystemIntakeFundingSource{},
+			"",
+		)
+		intakeID, err := uuid.Parse(mux.Vars(r)["intake_id"])
+		if err != nil {
+			valErr.WithValidation("path.intakeID", "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+		var id uuid.UUID
+		if rawID, ok := mux.Vars(r)["funding_source_id"]; ok {
+			id, err = uuid.Parse(rawID)
+			if err != nil {
+				valErr.WithValidation("path.fundingSourceID", "must be UUID")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+		}
+
+		switch r.Method {
+		case "GET":
+			if id != uuid.Nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			fundingSources, err := h.FetchFundingSources(r.Context(), intakeID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(fundingSources)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		case "POST", "PUT":
+			if (r.Method == "POST") != (id == uuid.Nil) {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			if r.Body == nil {
+				h.WriteErrorResponse(
+					r.Context(),
+					w,
+					&apperrors.BadRequestError{Err: errors.New("empty request not allowed")},
+				)
+				return
+			}
+			defer r.Body.Close()
+
+			fundingSource := models.SystemIntakeFundingSource{}
+			err := json.NewDecoder(r.Body).Decode(&fundingSource)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.BadRequestError{Err: err})
+				return
+			}
+			fundingSource.SystemIntakeID = intakeID
+
+			var saved *models.SystemIntakeFundingSource
+			status := http.StatusOK
+			if r.Method == "POST" {
+				saved, err = h.CreateFundingSource(r.Context(), &fundingSource)
+				status = http.StatusCreated
+			} else {
+				fundingSource.ID = id
+				saved, err = h.UpdateFundingSource(r.Context(), &fundingSource)
+			}
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(saved)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, err = w.Write(responseBody)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		case "DELETE":
+			if id == uuid.Nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			err := h.DeleteFundingSource(r.Context(), intakeID, id)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestFundingSourcesHandler() {
+	requestContext := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: true})
+	intakeID := uuid.New()
+	fundingSourceID := uuid.New()
+
+	fetch := func(_ context.Context, id uuid.UUID) (models.SystemIntakeFundingSources, error) {
+		return models.SystemIntakeFundingSources{{ID: uuid.New(), SystemIntakeID: id}}, nil
+	}
+	save := func(_ context.Context, fundingSource *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+		return fundingSource, nil
+	}
+	remove := func(context.Context, uuid.UUID, uuid.UUID) error {
+		return nil
+	}
+	handler := NewFundingSourcesHandler(s.base, fetch, save, save, remove)
+
+	newRequest := func(method string, body []byte, vars map[string]string) *http.Request {
+		req, err := http.NewRequestWithContext(
+			requestContext,
+			method,
+			fmt.Sprintf("/system_intake/%s/funding_sources", intakeID),
+			bytes.NewBuffer(body),
+		)
+		s.NoError(err)
+		return mux.SetURLVars(req, vars)
+	}
+	body, err := json.Marshal(map[string]string{
+		"source":        "CLIA",
+		"fundingNumber": "123456",
+	})
+	s.NoError(err)
+
+	s.Run("golden path GET passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("GET", nil, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusOK, rr.Code)
+		var fundingSources models.SystemIntakeFundingSources
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &fundingSources))
+		s.Len(fundingSources, 1)
+	})
+
+	s.Run("golden path POST passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("POST", body, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusCreated, rr.Code)
+		fundingSource := models.SystemIntakeFundingSource{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &fundingSource))
+		s.Equal(intakeID, fundingSource.SystemIntakeID)
+	})
+
+	s.Run("golden path PUT passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("PUT", body, map[string]string{
+			"intake_id":         intakeID.String(),
+			"funding_source_id": fundingSourceID.String(),
+		}))
+
+		s.Equal(http.StatusOK, rr.Code)
+		fundingSource := models.SystemIntakeFundingSource{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &fundingSource))
+		s.Equal(fundingSourceID, fundingSource.ID)
+	})
+
+	s.Run("golden path DELETE passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", nil, map[string]string{
+			"intake_id":         intakeID.String(),
+			"funding_source_id": fundingSourceID.String(),
+		}))
+
+		s.Equal(http.StatusNoContent, rr.Code)
+	})
+
+	s.Run("DELETE without a funding source id is not allowed", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", nil, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+
+	s.Run("fails with an invalid intake id", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("GET", nil, map[string]string{"intake_id": "not-a-uuid"}))
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("POST fails with a validation error from the service", func() {
+		failingCreate := func(_ context.Context, fundingSource *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+			valErr := apperrors.NewValidationError(errors.New("failed"), fundingSource, "")
+			valErr.WithValidation("FundingNumber", "must be a 6 digit string")
+			return nil, &valErr
+		}
+		rr := httptest.NewRecorder()
+		NewFundingSourcesHandler(s.base, fetch, failingCreate, save, remove).Handle()(
+			rr,
+			newRequest("POST", body, map[string]string{"intake_id": intakeID.String()}),
+		)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+}
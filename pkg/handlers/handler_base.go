@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/facebookgo/clock"
 	"github.com/google/uuid"
@@ -151,6 +152,13 @@ func (b HandlerBase) WriteErrorResponse(ctx context.Context, w http.ResponseWrit
 			"Resource conflict",
 			traceID,
 		)
+		if appErr.CurrentVersion > 0 {
+			w.Header().Set("ETag", etag(appErr.CurrentVersion))
+			response.Errors = append(response.Errors, errorItem{
+				Field:   "version",
+				Message: strconv.Itoa(appErr.CurrentVersion),
+			})
+		}
 	case *apperrors.BadRequestError:
 		logger.Info("Returning bad request error from handler", zap.Error(appErr))
 		code = http.StatusBadRequest
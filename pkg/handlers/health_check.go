@@ -5,33 +5,44 @@ import (
 	"net/http"
 
 	"github.com/spf13/viper"
+
+	"github.com/cmsgov/easi-app/pkg/cedar/resilience"
 )
 
 // NewHealthCheckHandler is a constructor for HealthCheckHandler
-func NewHealthCheckHandler(base HandlerBase, config *viper.Viper) HealthCheckHandler {
+func NewHealthCheckHandler(
+	base HandlerBase,
+	config *viper.Viper,
+	circuitBreakers map[string]func() resilience.BreakerState,
+) HealthCheckHandler {
 	return HealthCheckHandler{
-		HandlerBase: base,
-		Config:      config,
+		HandlerBase:     base,
+		Config:          config,
+		CircuitBreakers: circuitBreakers,
 	}
 }
 
 // HealthCheckHandler returns the API status
 type HealthCheckHandler struct {
 	HandlerBase
-	Config *viper.Viper
+	Config          *viper.Viper
+	CircuitBreakers map[string]func() resilience.BreakerState
 }
 
 type status string
 
 const (
 	statusPass status = "pass"
+	// statusWarn means the API is up but a dependency is failing
+	statusWarn status = "warn"
 )
 
 type healthCheck struct {
-	Status    status `json:"status"`
-	Datetime  string `json:"datetime"`
-	Version   string `json:"version"`
-	Timestamp string `json:"timestamp"`
+	Status       status                             `json:"status"`
+	Datetime     string                             `json:"datetime"`
+	Version      string                             `json:"version"`
+	Timestamp    string                             `json:"timestamp"`
+	Dependencies map[string]resilience.BreakerState `json:"dependencies,omitempty"`
 }
 
 // Handle handles a web request and returns a healthcheck JSON payload
@@ -43,6 +54,16 @@ func (h HealthCheckHandler) Handle() http.HandlerFunc {
 			Datetime:  h.Config.GetString("APPLICATION_DATETIME"),
 			Timestamp: h.Config.GetString("APPLICATION_TS"),
 		}
+		if len(h.CircuitBreakers) > 0 {
+			statusReport.Dependencies = map[string]resilience.BreakerState{}
+		}
+		for name, breakerState := range h.CircuitBreakers {
+			state := breakerState()
+			statusReport.Dependencies[name] = state
+			if state == resilience.BreakerStateOpen {
+				statusReport.Status = statusWarn
+			}
+		}
 		js, err := json.Marshal(statusReport)
 		if err != nil {
 			h.WriteErrorResponse(r.Context(), w, err)
@@ -6,6 +6,8 @@ import (
 	"net/http/httptest"
 
 	"github.com/spf13/viper"
+
+	"github.com/cmsgov/easi-app/pkg/cedar/resilience"
 )
 
 func (s HandlerTestSuite) TestHealthcheckHandler() {
@@ -33,3 +35,34 @@ func (s HandlerTestSuite) TestHealthcheckHandler() {
 	s.Equal("mockversion", healthCheckActual.Version)
 	s.Equal("mocktimestamp", healthCheckActual.Timestamp)
 }
+
+func (s HandlerTestSuite) TestHealthcheckHandlerCircuitBreakers() {
+	mockViper := viper.New()
+	breakerState := resilience.BreakerStateClosed
+	handler := NewHealthCheckHandler(s.base, mockViper, map[string]func() resilience.BreakerState{
+		"cedarEasi": func() resilience.BreakerState { return breakerState },
+	})
+
+	s.Run("passes when the breakers are closed", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, nil)
+
+		s.Equal(http.StatusOK, rr.Code)
+		var healthCheckActual healthCheck
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &healthCheckActual))
+		s.Equal(statusPass, healthCheckActual.Status)
+		s.Equal(resilience.BreakerStateClosed, healthCheckActual.Dependencies["cedarEasi"])
+	})
+
+	s.Run("warns when a breaker is open", func() {
+		breakerState = resilience.BreakerStateOpen
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, nil)
+
+		s.Equal(http.StatusOK, rr.Code)
+		var healthCheckActual healthCheck
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &healthCheckActual))
+		s.Equal(statusWarn, healthCheckActual.Status)
+		s.Equal(resilience.BreakerStateOpen, healthCheckActual.Dependencies["cedarEasi"])
+	})
+}
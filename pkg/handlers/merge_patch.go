@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+// decodeMergePatch reads a JSON Merge Patch request body, along with the version it expects
+// to patch from If-Match or the patch's own "version"
+func decodeMergePatch(r *http.Request) (map[string]json.RawMessage, int, error) {
+	if r.Body == nil {
+		return nil, 0, &apperrors.BadRequestError{Err: errors.New("empty request not allowed")}
+	}
+	defer r.Body.Close()
+	patch := map[string]json.RawMessage{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		return nil, 0, &apperrors.BadRequestError{Err: err}
+	}
+
+	var bodyVersion int
+	if rawVersion, ok := patch["version"]; ok {
+		if err := json.Unmarshal(rawVersion, &bodyVersion); err != nil {
+			return nil, 0, &apperrors.BadRequestError{Err: err}
+		}
+		delete(patch, "version")
+	}
+	version, err := requestedVersion(r, bodyVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+	return patch, version, nil
+}
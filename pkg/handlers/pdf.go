@@ -3,53 +3,63 @@ package handlers
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
-type invokeLambdaFunc func(cxt context.Context, html string) ([]byte, error)
+type generateRecordPDF func(context.Context, uuid.UUID) (*models.PDF, error)
 
-// PDFHandler handles PDFs
-type PDFHandler struct {
+// RecordPDFHandler renders a stored record as a PDF
+type RecordPDFHandler struct {
 	HandlerBase
-	invokeLambda invokeLambdaFunc
-}
-
-// NewPDFHandler returns a new PDFHandler
-func NewPDFHandler(invoker invokeLambdaFunc) *PDFHandler {
-	return &PDFHandler{invokeLambda: invoker}
+	idKey       string
+	generatePDF generateRecordPDF
 }
 
-type requestPayload struct {
-	HTML []byte `json:"html"` // automatically decode base64
+// NewRecordPDFHandler returns a new RecordPDFHandler for the record identified by the idKey path variable
+func NewRecordPDFHandler(base HandlerBase, idKey string, generate generateRecordPDF) RecordPDFHandler {
+	return RecordPDFHandler{
+		HandlerBase: base,
+		idKey:       idKey,
+		generatePDF: generate,
+	}
 }
 
 // Handle returns an http.HandlerFunc
-func (h PDFHandler) Handle() http.HandlerFunc {
+func (h RecordPDFHandler) Handle() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-
-		body, readErr := ioutil.ReadAll(r.Body)
-		if readErr != nil {
-			h.WriteErrorResponse(r.Context(), w, readErr)
+		if r.Method != "GET" {
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
 			return
 		}
 
-		var generateRequest requestPayload
-		parseErr := json.Unmarshal(body, &generateRequest)
-		if parseErr != nil {
-			h.WriteErrorResponse(r.Context(), w, parseErr)
+		id, err := uuid.Parse(mux.Vars(r)[h.idKey])
+		if err != nil {
+			valErr := apperrors.NewValidationError(errors.New("pdf generation failed validation"), models.PDF{}, "")
+			valErr.WithValidation("path."+h.idKey, "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
 			return
 		}
 
-		result, generateErr := h.invokeLambda(r.Context(), string(generateRequest.HTML))
-		if generateErr != nil {
-			h.WriteErrorResponse(r.Context(), w, generateErr)
+		pdf, err := h.generatePDF(r.Context(), id)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
 			return
 		}
 
-		if _, copyErr := io.Copy(w, bytes.NewReader(result)); copyErr != nil {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", pdf.Filename))
+		w.Header().Set("Content-Length", strconv.Itoa(len(pdf.Content)))
+		if _, copyErr := io.Copy(w, bytes.NewReader(pdf.Content)); copyErr != nil {
 			h.WriteErrorResponse(r.Context(), w, copyErr)
 			return
 		}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestRecordPDFHandler() {
+	intakeID := uuid.New()
+	generate := func(_ context.Context, id uuid.UUID) (*models.PDF, error) {
+		return &models.PDF{Filename: "system_intake_" + id.String() + ".pdf", Content: []byte("%PDF-1.4")}, nil
+	}
+	newRequest := func(method string, id string) *http.Request {
+		req, err := http.NewRequestWithContext(context.Background(), method, "/system_intake/"+id+"/pdf", nil)
+		s.NoError(err)
+		return mux.SetURLVars(req, map[string]string{"intake_id": id})
+	}
+
+	s.Run("golden path returns the PDF", func() {
+		rr := httptest.NewRecorder()
+		NewRecordPDFHandler(s.base, "intake_id", generate).Handle()(rr, newRequest("GET", intakeID.String()))
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal("application/pdf", rr.Header().Get("Content-Type"))
+		s.Equal(`attachment; filename="system_intake_`+intakeID.String()+`.pdf"`, rr.Header().Get("Content-Disposition"))
+		s.Equal("%PDF-1.4", rr.Body.String())
+	})
+
+	s.Run("fails with an invalid id", func() {
+		rr := httptest.NewRecorder()
+		NewRecordPDFHandler(s.base, "intake_id", generate).Handle()(rr, newRequest("GET", "not-a-uuid"))
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("POST is not allowed", func() {
+		rr := httptest.NewRecorder()
+		NewRecordPDFHandler(s.base, "intake_id", generate).Handle()(rr, newRequest("POST", intakeID.String()))
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+
+	s.Run("fails when unauthorized", func() {
+		unauthorized := func(context.Context, uuid.UUID) (*models.PDF, error) {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed")}
+		}
+		rr := httptest.NewRecorder()
+		NewRecordPDFHandler(s.base, "intake_id", unauthorized).Handle()(rr, newRequest("GET", intakeID.String()))
+
+		s.Equal(http.StatusUnauthorized, rr.Code)
+	})
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// minimumSearchLength keeps typeahead searches from matching most of the directory
+const minimumSearchLength = 2
+
+type searchPeople func(context.Context, string) ([]*models.UserInfo, error)
+
+// NewPeopleHandler is a constructor for PeopleHandler
+func NewPeopleHandler(base HandlerBase, search searchPeople) PeopleHandler {
+	return PeopleHandler{
+		HandlerBase:  base,
+		SearchPeople: search,
+	}
+}
+
+// PeopleHandler is the handler for searching people in the CEDAR directory
+type PeopleHandler struct {
+	HandlerBase
+	SearchPeople searchPeople
+}
+
+// Handle handles a typeahead search for people by name or email
+func (h PeopleHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			query := strings.TrimSpace(r.URL.Query().Get("search"))
+			if len(query) < minimumSearchLength {
+				h.WriteErrorResponse(
+					r.Context(),
+					w,
+					&apperrors.BadRequestError{Err: errors.New("search must be at least 2 characters")},
+				)
+				return
+			}
+
+			people, err := h.SearchPeople(r.Context(), query)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(people)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestPeopleHandler() {
+	search := func(_ context.Context, query string) ([]*models.UserInfo, error) {
+		return []*models.UserInfo{{CommonName: "Adeline Aarons", Email: "adeline.aarons@local.fake", EuaUserID: "ABCD"}}, nil
+	}
+
+	s.Run("golden path GET passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/people?search=Ade", nil)
+		s.NoError(err)
+
+		NewPeopleHandler(s.base, search).Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		var people []map[string]string
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &people))
+		s.Equal([]map[string]string{{
+			"commonName": "Adeline Aarons",
+			"email":      "adeline.aarons@local.fake",
+			"euaUserId":  "ABCD",
+		}}, people)
+	})
+
+	s.Run("GET fails with a short search", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/people?search=%20A%20", nil)
+		s.NoError(err)
+
+		NewPeopleHandler(s.base, search).Handle()(rr, req)
+
+		s.Equal(http.StatusBadRequest, rr.Code)
+	})
+
+	s.Run("GET fails when CEDAR does", func() {
+		failingSearch := func(context.Context, string) ([]*models.UserInfo, error) {
+			return nil, &apperrors.ExternalAPIError{Err: errors.New("failed"), Source: "CEDAR LDAP"}
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/people?search=Ade", nil)
+		s.NoError(err)
+
+		NewPeopleHandler(s.base, failingSearch).Handle()(rr, req)
+
+		s.Equal(http.StatusServiceUnavailable, rr.Code)
+	})
+
+	s.Run("POST is not allowed", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "/people", nil)
+		s.NoError(err)
+
+		NewPeopleHandler(s.base, search).Handle()(rr, req)
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+type softDelete func(context.Context, uuid.UUID) error
+type restore func(context.Context, uuid.UUID) error
+
+// SoftDeleteHandler soft deletes and restores the record identified by a path variable
+type SoftDeleteHandler struct {
+	HandlerBase
+	idKey      string
+	SoftDelete softDelete
+	Restore    restore
+}
+
+// NewSoftDeleteHandler returns a new SoftDeleteHandler for the record identified by the idKey path variable
+func NewSoftDeleteHandler(base HandlerBase, idKey string, softDelete softDelete, restore restore) SoftDeleteHandler {
+	return SoftDeleteHandler{
+		HandlerBase: base,
+		idKey:       idKey,
+		SoftDelete:  softDelete,
+		Restore:     restore,
+	}
+}
+
+// HandleDelete handles a web request to soft delete the record
+func (h SoftDeleteHandler) HandleDelete() http.HandlerFunc {
+	return h.handle(h.SoftDelete)
+}
+
+// HandleRestore handles a web request to restore the soft deleted record
+func (h SoftDeleteHandler) HandleRestore() http.HandlerFunc {
+	return h.handle(h.Restore)
+}
+
+func (h SoftDeleteHandler) handle(change func(context.Context, uuid.UUID) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+
+		id, err := uuid.Parse(mux.Vars(r)[h.idKey])
+		if err != nil {
+			valErr := apperrors.NewValidationError(errors.New("soft delete failed validation"), uuid.Nil, "")
+			valErr.WithValidation("path."+h.idKey, "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+
+		if err := change(r.Context(), id); err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestSoftDeleteHandler() {
+	id := uuid.New()
+	var deleted, restored uuid.UUID
+	softDelete := func(_ context.Context, deletedID uuid.UUID) error {
+		deleted = deletedID
+		return nil
+	}
+	restore := func(_ context.Context, restoredID uuid.UUID) error {
+		restored = restoredID
+		return nil
+	}
+	handler := NewSoftDeleteHandler(s.base, "intake_id", softDelete, restore)
+
+	newRequest := func(method string, rawID string) *http.Request {
+		req, err := http.NewRequest(method, "/system_intake/"+rawID+"/delete", nil)
+		s.NoError(err)
+		return mux.SetURLVars(req, map[string]string{"intake_id": rawID})
+	}
+
+	s.Run("golden path POST soft deletes", func() {
+		rr := httptest.NewRecorder()
+		handler.HandleDelete()(rr, newRequest("POST", id.String()))
+
+		s.Equal(http.StatusNoContent, rr.Code)
+		s.Equal(id, deleted)
+	})
+
+	s.Run("golden path POST restores", func() {
+		rr := httptest.NewRecorder()
+		handler.HandleRestore()(rr, newRequest("POST", id.String()))
+
+		s.Equal(http.StatusNoContent, rr.Code)
+		s.Equal(id, restored)
+	})
+
+	s.Run("the id must be a UUID", func() {
+		rr := httptest.NewRecorder()
+		handler.HandleDelete()(rr, newRequest("POST", "nope"))
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("records that are not found return a 404", func() {
+		notFound := func(context.Context, uuid.UUID) error {
+			return &apperrors.ResourceNotFoundError{Resource: models.SystemIntake{}}
+		}
+		rr := httptest.NewRecorder()
+		NewSoftDeleteHandler(s.base, "intake_id", notFound, notFound).HandleRestore()(rr, newRequest("POST", id.String()))
+
+		s.Equal(http.StatusNotFound, rr.Code)
+	})
+
+	s.Run("only POST is allowed", func() {
+		rr := httptest.NewRecorder()
+		handler.HandleDelete()(rr, newRequest("GET", id.String()))
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+}
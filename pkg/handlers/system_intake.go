@@ -20,6 +20,7 @@ type createSystemIntake func(context context.Context, intake *models.SystemIntak
 type fetchSystemIntakeByID func(context context.Context, id uuid.UUID) (*models.SystemIntake, error)
 type updateSystemIntake func(context context.Context, intake *models.SystemIntake) (*models.SystemIntake, error)
 type archiveSystemIntake func(context context.Context, id uuid.UUID) error
+type patchSystemIntake func(context context.Context, id uuid.UUID, patch map[string]json.RawMessage, version int) (*models.SystemIntake, error)
 
 // NewSystemIntakeHandler is a constructor for SystemIntakeHandler
 func NewSystemIntakeHandler(
@@ -28,6 +29,7 @@ func NewSystemIntakeHandler(
 	update updateSystemIntake,
 	fetch fetchSystemIntakeByID,
 	delete archiveSystemIntake,
+	patch patchSystemIntake,
 ) SystemIntakeHandler {
 	return SystemIntakeHandler{
 		HandlerBase:           base,
@@ -35,6 +37,7 @@ func NewSystemIntakeHandler(
 		UpdateSystemIntake:    update,
 		FetchSystemIntakeByID: fetch,
 		ArchiveSystemIntake:   delete,
+		PatchSystemIntake:     patch,
 	}
 }
 
@@ -45,6 +48,7 @@ type SystemIntakeHandler struct {
 	UpdateSystemIntake    updateSystemIntake
 	FetchSystemIntakeByID fetchSystemIntakeByID
 	ArchiveSystemIntake   archiveSystemIntake
+	PatchSystemIntake     patchSystemIntake
 }
 
 // Handle handles a request for the system intake form
@@ -81,6 +85,8 @@ func (h SystemIntakeHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			w.Header().Set("ETag", etag(intake.Version))
+
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -154,6 +160,11 @@ func (h SystemIntakeHandler) Handle() http.HandlerFunc {
 				return
 			}
 			intake.EUAUserID = null.StringFrom(principal.ID())
+			intake.Version, err = requestedVersion(r, intake.Version)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
 
 			updatedIntake, err := h.UpdateSystemIntake(r.Context(), &intake)
 			if err != nil {
@@ -167,6 +178,52 @@ func (h SystemIntakeHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			w.Header().Set("ETag", etag(updatedIntake.Version))
+
+			_, err = w.Write(responseBody)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			return
+		case "PATCH":
+			id := mux.Vars(r)["intake_id"]
+			valErr := apperrors.NewValidationError(
+				errors.New("system intake failed validation"),
+				models.SystemIntake{},
+				"",
+			)
+			if id == "" {
+				valErr.WithValidation("path.intakeID", "is required")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+			uuid, err := uuid.Parse(id)
+			if err != nil {
+				valErr.WithValidation("path.intakeID", "must be UUID")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+			patch, version, err := decodeMergePatch(r)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			updatedIntake, err := h.PatchSystemIntake(r.Context(), uuid, patch, version)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(updatedIntake)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("ETag", etag(updatedIntake.Version))
+
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -476,3 +533,80 @@ func (h SystemIntakeRejectionHandler) Handle() http.HandlerFunc {
 		}
 	}
 }
+
+// NewSystemIntakeTransferHandler is a constructor for how we handle
+// transferring a request to a new requester
+func NewSystemIntakeTransferHandler(
+	base HandlerBase,
+	transfer func(context.Context, uuid.UUID, string, string) (*models.SystemIntake, error),
+) SystemIntakeTransferHandler {
+	return SystemIntakeTransferHandler{
+		HandlerBase:    base,
+		TransferIntake: transfer,
+	}
+}
+
+// SystemIntakeTransferHandler is the handler for transferring ownership of a SystemIntake
+type SystemIntakeTransferHandler struct {
+	HandlerBase
+	TransferIntake func(context.Context, uuid.UUID, string, string) (*models.SystemIntake, error)
+}
+
+type transferFields struct {
+	EUAUserID string `json:"euaUserId"`
+	Reason    string `json:"reason"`
+}
+
+// Handle handles a request to transfer a system intake
+func (h SystemIntakeTransferHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			if r.Body == nil {
+				h.WriteErrorResponse(
+					r.Context(),
+					w,
+					&apperrors.BadRequestError{Err: errors.New("empty request not allowed")},
+				)
+				return
+			}
+			defer r.Body.Close()
+
+			intakeID, err := uuid.Parse(mux.Vars(r)["intake_id"])
+			if err != nil {
+				valErr := apperrors.NewValidationError(err, models.SystemIntake{}, "")
+				valErr.WithValidation("path.intakeID", "must be UUID")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+			fields := transferFields{}
+			if err = json.NewDecoder(r.Body).Decode(&fields); err != nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.BadRequestError{Err: err})
+				return
+			}
+
+			updatedIntake, err := h.TransferIntake(r.Context(), intakeID, fields.EUAUserID, fields.Reason)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(updatedIntake)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write(responseBody)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			return
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchDelegates func(context.Context, uuid.UUID) (models.SystemIntakeDelegates, error)
+type saveDelegate func(context.Context, *models.SystemIntakeDelegate) (*models.SystemIntakeDelegate, error)
+type deleteDelegate func(context.Context, uuid.UUID, string) error
+
+// NewSystemIntakeDelegatesHandler is a constructor for SystemIntakeDelegatesHandler
+func NewSystemIntakeDelegatesHandler(
+	base HandlerBase,
+	fetch fetchDelegates,
+	save saveDelegate,
+	delete deleteDelegate,
+) SystemIntakeDelegatesHandler {
+	return SystemIntakeDelegatesHandler{
+		HandlerBase:    base,
+		FetchDelegates: fetch,
+		SaveDelegate:   save,
+		DeleteDelegate: delete,
+	}
+}
+
+// SystemIntakeDelegatesHandler is the handler for the people a SystemIntake is shared with
+type SystemIntakeDelegatesHandler struct {
+	HandlerBase
+	FetchDelegates fetchDelegates
+	SaveDelegate   saveDelegate
+	DeleteDelegate deleteDelegate
+}
+
+// Handle handles a web request for the delegates of a system intake
+func (h SystemIntakeDelegatesHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		intakeID, err := uuid.Parse(mux.Vars(r)["intake_id"])
+		if err != nil {
+			valErr := apperrors.NewValidationError(err, models.SystemIntakeDelegate{}, "")
+			valErr.WithValidation("path.intakeID", "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+		euaUserID := mux.Vars(r)["eua_user_id"]
+
+		switch r.Method {
+		case "GET":
+			if euaUserID != "" {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			delegates, err := h.FetchDelegates(r.Context(), intakeID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			h.writeJSON(r.Context(), w, http.StatusOK, delegates)
+		case "PUT":
+			if euaUserID == "" {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			if r.Body == nil {
+				h.WriteErrorResponse(
+					r.Context(),
+					w,
+					&apperrors.BadRequestError{Err: errors.New("empty request not allowed")},
+				)
+				return
+			}
+			defer r.Body.Close()
+
+			delegate := models.SystemIntakeDelegate{}
+			err := json.NewDecoder(r.Body).Decode(&delegate)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.BadRequestError{Err: err})
+				return
+			}
+			delegate.SystemIntakeID = intakeID
+			delegate.EUAUserID = euaUserID
+
+			saved, err := h.SaveDelegate(r.Context(), &delegate)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			h.writeJSON(r.Context(), w, http.StatusOK, saved)
+		case "DELETE":
+			if euaUserID == "" {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			err := h.DeleteDelegate(r.Context(), intakeID, euaUserID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
+
+func (h SystemIntakeDelegatesHandler) writeJSON(ctx context.Context, w http.ResponseWriter, status int, body interface{}) {
+	js, err := json.Marshal(body)
+	if err != nil {
+		h.WriteErrorResponse(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, err = w.Write(js)
+	if err != nil {
+		h.WriteErrorResponse(ctx, w, err)
+		return
+	}
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestSystemIntakeDelegatesHandler() {
+	requestContext := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: true})
+	intakeID := uuid.New()
+
+	fetch := func(_ context.Context, id uuid.UUID) (models.SystemIntakeDelegates, error) {
+		return models.SystemIntakeDelegates{{ID: uuid.New(), SystemIntakeID: id, EUAUserID: "ABCD"}}, nil
+	}
+	save := func(_ context.Context, delegate *models.SystemIntakeDelegate) (*models.SystemIntakeDelegate, error) {
+		return delegate, nil
+	}
+	remove := func(context.Context, uuid.UUID, string) error {
+		return nil
+	}
+	handler := NewSystemIntakeDelegatesHandler(s.base, fetch, save, remove)
+
+	newRequest := func(method string, body []byte, vars map[string]string) *http.Request {
+		req, err := http.NewRequestWithContext(
+			requestContext,
+			method,
+			fmt.Sprintf("/system_intake/%s/delegates", intakeID),
+			bytes.NewBuffer(body),
+		)
+		s.NoError(err)
+		return mux.SetURLVars(req, vars)
+	}
+	body, err := json.Marshal(map[string]string{"role": "CO_OWNER"})
+	s.NoError(err)
+
+	s.Run("golden path GET passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("GET", nil, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusOK, rr.Code)
+		var delegates models.SystemIntakeDelegates
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &delegates))
+		s.Len(delegates, 1)
+	})
+
+	s.Run("golden path PUT passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("PUT", body, map[string]string{
+			"intake_id":   intakeID.String(),
+			"eua_user_id": "ABCD",
+		}))
+
+		s.Equal(http.StatusOK, rr.Code)
+		delegate := models.SystemIntakeDelegate{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &delegate))
+		s.Equal(intakeID, delegate.SystemIntakeID)
+		s.Equal("ABCD", delegate.EUAUserID)
+		s.Equal(models.SystemIntakeDelegateRoleCOOWNER, delegate.Role)
+	})
+
+	s.Run("golden path DELETE passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", nil, map[string]string{
+			"intake_id":   intakeID.String(),
+			"eua_user_id": "ABCD",
+		}))
+
+		s.Equal(http.StatusNoContent, rr.Code)
+	})
+
+	s.Run("PUT without an EUA ID is not allowed", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("PUT", body, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+
+	s.Run("fails with an invalid intake id", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("GET", nil, map[string]string{"intake_id": "not-a-uuid"}))
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("PUT fails with a validation error from the service", func() {
+		failingSave := func(_ context.Context, delegate *models.SystemIntakeDelegate) (*models.SystemIntakeDelegate, error) {
+			valErr := apperrors.NewValidationError(errors.New("failed"), delegate, "")
+			valErr.WithValidation("euaUserId", "must be a valid EUA ID")
+			return nil, &valErr
+		}
+		rr := httptest.NewRecorder()
+		NewSystemIntakeDelegatesHandler(s.base, fetch, failingSave, remove).Handle()(
+			rr,
+			newRequest("PUT", body, map[string]string{"intake_id": intakeID.String(), "eua_user_id": "ZZZZ"}),
+		)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+}
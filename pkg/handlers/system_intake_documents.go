@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchSystemIntakeDocuments func(context.Context, uuid.UUID) (models.SystemIntakeDocuments, error)
+type createSystemIntakeDocument func(context.Context, *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error)
+type deleteSystemIntakeDocument func(context.Context, uuid.UUID, uuid.UUID) error
+type createSystemIntakeDocumentUploadURL func(context.Context, uuid.UUID, string) (*models.PreSignedURL, error)
+
+// NewSystemIntakeDocumentsHandler is a constructor for SystemIntakeDocumentsHandler
+func NewSystemIntakeDocumentsHandler(
+	base HandlerBase,
+	fetch fetchSystemIntakeDocuments,
+	create createSystemIntakeDocument,
+	delete deleteSystemIntakeDocument,
+	createUploadURL createSystemIntakeDocumentUploadURL,
+) SystemIntakeDocumentsHandler {
+	return SystemIntakeDocumentsHandler{
+		HandlerBase:     base,
+		FetchDocuments:  fetch,
+		CreateDocument:  create,
+		DeleteDocument:  delete,
+		CreateUploadURL: createUploadURL,
+	}
+}
+
+// SystemIntakeDocumentsHandler is the handler for interacting with the documents
+// uploaded in support of a SystemIntake
+type SystemIntakeDocumentsHandler struct {
+	HandlerBase
+	FetchDocuments  fetchSystemIntakeDocuments
+	CreateDocument  createSystemIntakeDocument
+	DeleteDocument  deleteSystemIntakeDocument
+	CreateUploadURL createSystemIntakeDocumentUploadURL
+}
+
+// Handle handles a web request for the documents of a system intake
+func (h SystemIntakeDocumentsHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		valErr := apperrors.NewValidationError(
+			errors.New("system intake document failed validation"),
+			models.SystemIntakeDocument{},
+			"",
+		)
+		intakeID, err := uuid.Parse(mux.Vars(r)["intake_id"])
+		if err != nil {
+			valErr.WithValidation("path.intakeID", "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+		var id uuid.UUID
+		if rawID, ok := mux.Vars(r)["document_id"]; ok {
+			id, err = uuid.Parse(rawID)
+			if err != nil {
+				valErr.WithValidation("path.documentID", "must be UUID")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+		}
+
+		switch r.Method {
+		case "GET":
+			if id != uuid.Nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			documents, err := h.FetchDocuments(r.Context(), intakeID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(documents)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		case "POST":
+			if id != uuid.Nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			if r.Body == nil {
+				h.WriteErrorResponse(
+					r.Context(),
+					w,
+					&apperrors.BadRequestError{Err: errors.New("empty request not allowed")},
+				)
+				return
+			}
+			defer r.Body.Close()
+
+			// the bucket and virus scan results are set by the server
+			documentRequest := struct {
+				BusinessCaseID *uuid.UUID                      `json:"businessCaseId"`
+				FileType       string                          `json:"fileType"`
+				Key            string                          `json:"fileKey"`
+				Name           string                          `json:"name"`
+				Size           int                             `json:"size"`
+				DocumentType   models.SystemIntakeDocumentType `json:"documentType"`
+				OtherType      null.String                     `json:"otherType"`
+			}{}
+			err := json.NewDecoder(r.Body).Decode(&documentRequest)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.BadRequestError{Err: err})
+				return
+			}
+			document := models.SystemIntakeDocument{
+				SystemIntakeID: intakeID,
+				BusinessCaseID: documentRequest.BusinessCaseID,
+				FileType:       documentRequest.FileType,
+				Key:            documentRequest.Key,
+				Name:           documentRequest.Name,
+				Size:           documentRequest.Size,
+				DocumentType:   documentRequest.DocumentType,
+				OtherType:      documentRequest.OtherType,
+			}
+
+			saved, err := h.CreateDocument(r.Context(), &document)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(saved)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write(responseBody)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		case "DELETE":
+			if id == uuid.Nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+				return
+			}
+			err := h.DeleteDocument(r.Context(), intakeID, id)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
+
+// HandleUploadURL handles a web request for a pre-signed URL to upload a system intake document
+func (h SystemIntakeDocumentsHandler) HandleUploadURL() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+		intakeID, err := uuid.Parse(mux.Vars(r)["intake_id"])
+		if err != nil {
+			valErr := apperrors.NewValidationError(
+				errors.New("system intake document failed validation"),
+				models.SystemIntakeDocument{},
+				"",
+			)
+			valErr.WithValidation("path.intakeID", "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+		if r.Body == nil {
+			h.WriteErrorResponse(
+				r.Context(),
+				w,
+				&apperrors.BadRequestError{Err: errors.New("empty request not allowed")},
+			)
+			return
+		}
+		defer r.Body.Close()
+
+		urlUploadRequest := struct {
+			FileType string `json:"fileType"`
+		}{}
+		err = json.NewDecoder(r.Body).Decode(&urlUploadRequest)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, &apperrors.BadRequestError{Err: err})
+			return
+		}
+
+		url, err := h.CreateUploadURL(r.Context(), intakeID, urlUploadRequest.FileType)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+
+		responseBody, err := json.Marshal(url)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write(responseBody)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+	}
+}
+
+// NewBusinessCaseDocumentsHandler is a constructor for BusinessCaseDocumentsHandler
+func NewBusinessCaseDocumentsHandler(
+	base HandlerBase,
+	fetch fetchSystemIntakeDocuments,
+) BusinessCaseDocumentsHandler {
+	return BusinessCaseDocumentsHandler{
+		HandlerBase:    base,
+		FetchDocuments: fetch,
+	}
+}
+
+// BusinessCaseDocumentsHandler is the handler for listing the documents attached to a BusinessCase
+type BusinessCaseDocumentsHandler struct {
+	HandlerBase
+	FetchDocuments fetchSystemIntakeDocuments
+}
+
+// Handle handles a web request for the documents of a business case
+func (h BusinessCaseDocumentsHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+		businessCaseID, err := uuid.Parse(mux.Vars(r)["business_case_id"])
+		if err != nil {
+			valErr := apperrors.NewValidationError(
+				errors.New("business case document fetch failed validation"),
+				models.SystemIntakeDocument{},
+				"",
+			)
+			valErr.WithValidation("path.businessCaseID", "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+
+		documents, err := h.FetchDocuments(r.Context(), businessCaseID)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+
+		js, err := json.Marshal(documents)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err = w.Write(js)
+		if err != nil {
+			h.WriteErrorResponse(r.Context(), w, err)
+			return
+		}
+	}
+}
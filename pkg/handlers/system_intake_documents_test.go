@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestSystemIntakeDocumentsHandler() {
+	requestContext := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: true})
+	intakeID := uuid.New()
+	documentID := uuid.New()
+
+	fetch := func(_ context.Context, id uuid.UUID) (models.SystemIntakeDocuments, error) {
+		return models.SystemIntakeDocuments{{ID: uuid.New(), SystemIntakeID: id}}, nil
+	}
+	create := func(_ context.Context, document *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error) {
+		document.ID = documentID
+		return document, nil
+	}
+	remove := func(context.Context, uuid.UUID, uuid.UUID) error {
+		return nil
+	}
+	createUploadURL := func(_ context.Context, _ uuid.UUID, fileType string) (*models.PreSignedURL, error) {
+		return &models.PreSignedURL{URL: "https://signed.example.com/put", Filename: "abc.pdf"}, nil
+	}
+	handler := NewSystemIntakeDocumentsHandler(s.base, fetch, create, remove, createUploadURL)
+
+	newRequest := func(method string, body []byte, vars map[string]string) *http.Request {
+		req, err := http.NewRequestWithContext(
+			requestContext,
+			method,
+			fmt.Sprintf("/system_intake/%s/documents", intakeID),
+			bytes.NewBuffer(body),
+		)
+		s.NoError(err)
+		return mux.SetURLVars(req, vars)
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"fileType":     "application/pdf",
+		"fileKey":      "abc.pdf",
+		"name":         "quote.pdf",
+		"size":         1024,
+		"documentType": "VENDOR_QUOTE",
+	})
+	s.NoError(err)
+
+	s.Run("golden path GET passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("GET", nil, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusOK, rr.Code)
+		var documents models.SystemIntakeDocuments
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &documents))
+		s.Len(documents, 1)
+	})
+
+	s.Run("golden path POST passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("POST", body, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusCreated, rr.Code)
+		document := models.SystemIntakeDocument{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &document))
+		s.Equal(intakeID, document.SystemIntakeID)
+		s.Equal(models.SystemIntakeDocumentTypeVendorQuote, document.DocumentType)
+	})
+
+	s.Run("POST ignores the bucket and virus scan results in the body", func() {
+		forged, err := json.Marshal(map[string]interface{}{
+			"fileType":     "application/pdf",
+			"fileKey":      "abc.pdf",
+			"name":         "quote.pdf",
+			"documentType": "VENDOR_QUOTE",
+			"bucket":       "another-bucket",
+			"virusScanned": true,
+			"virusClean":   true,
+			"status":       "AVAILABLE",
+		})
+		s.NoError(err)
+		var created *models.SystemIntakeDocument
+		capture := func(_ context.Context, document *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error) {
+			created = document
+			return document, nil
+		}
+		rr := httptest.NewRecorder()
+		NewSystemIntakeDocumentsHandler(s.base, fetch, capture, remove, createUploadURL).Handle()(
+			rr,
+			newRequest("POST", forged, map[string]string{"intake_id": intakeID.String()}),
+		)
+
+		s.Equal(http.StatusCreated, rr.Code)
+		s.Equal("abc.pdf", created.Key)
+		s.Empty(created.Bucket)
+		s.Empty(created.Status)
+		s.False(created.VirusScanned.Valid)
+		s.False(created.VirusClean.Valid)
+	})
+
+	s.Run("golden path DELETE passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", nil, map[string]string{
+			"intake_id":   intakeID.String(),
+			"document_id": documentID.String(),
+		}))
+
+		s.Equal(http.StatusNoContent, rr.Code)
+	})
+
+	s.Run("PUT is not allowed", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("PUT", body, map[string]string{
+			"intake_id":   intakeID.String(),
+			"document_id": documentID.String(),
+		}))
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+
+	s.Run("fails with an invalid document id", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", nil, map[string]string{
+			"intake_id":   intakeID.String(),
+			"document_id": "not-a-uuid",
+		}))
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("POST fails when unauthorized", func() {
+		unauthorized := func(context.Context, *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error) {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed")}
+		}
+		rr := httptest.NewRecorder()
+		NewSystemIntakeDocumentsHandler(s.base, fetch, unauthorized, remove, createUploadURL).Handle()(
+			rr,
+			newRequest("POST", body, map[string]string{"intake_id": intakeID.String()}),
+		)
+
+		s.Equal(http.StatusUnauthorized, rr.Code)
+	})
+
+	s.Run("golden path upload URL passes", func() {
+		uploadBody, err := json.Marshal(map[string]string{"fileType": "application/pdf"})
+		s.NoError(err)
+		rr := httptest.NewRecorder()
+		handler.HandleUploadURL()(rr, newRequest("POST", uploadBody, map[string]string{"intake_id": intakeID.String()}))
+
+		s.Equal(http.StatusCreated, rr.Code)
+		url := models.PreSignedURL{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &url))
+		s.Equal("abc.pdf", url.Filename)
+	})
+}
+
+func (s HandlerTestSuite) TestBusinessCaseDocumentsHandler() {
+	requestContext := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: true})
+	businessCaseID := uuid.New()
+	fetch := func(_ context.Context, id uuid.UUID) (models.SystemIntakeDocuments, error) {
+		return models.SystemIntakeDocuments{{ID: uuid.New(), BusinessCaseID: &id}}, nil
+	}
+	handler := NewBusinessCaseDocumentsHandler(s.base, fetch)
+
+	s.Run("golden path GET passes", func() {
+		req, err := http.NewRequestWithContext(requestContext, "GET", fmt.Sprintf("/business_case/%s/documents", businessCaseID), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": businessCaseID.String()})
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		var documents models.SystemIntakeDocuments
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &documents))
+		s.Equal(&businessCaseID, documents[0].BusinessCaseID)
+	})
+
+	s.Run("POST is not allowed", func() {
+		req, err := http.NewRequestWithContext(requestContext, "POST", fmt.Sprintf("/business_case/%s/documents", businessCaseID), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": businessCaseID.String()})
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, req)
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+}
@@ -70,6 +70,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 			FetchSystemIntakeByID: newMockFetchSystemIntakeByID(nil),
 		}.Handle()(rr, req)
 		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(`"0"`, rr.Header().Get("ETag"))
 	})
 
 	s.Run("GET returns an error if the uuid is not valid", func() {
@@ -198,6 +199,39 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		s.Equal(http.StatusOK, rr.Code)
 	})
 
+	s.Run("PUT with If-Match: * updates unconditionally", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString(`{"version": 2}`))
+		s.NoError(err)
+		req.Header.Set("If-Match", "*")
+		SystemIntakeHandler{
+			UpdateSystemIntake: func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+				s.Equal(0, intake.Version)
+				return &models.SystemIntake{Version: 3}, nil
+			},
+			HandlerBase: s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(`"3"`, rr.Header().Get("ETag"))
+	})
+
+	s.Run("PUT without a version updates unconditionally", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString(`{"projectName": "Unversioned"}`))
+		s.NoError(err)
+		SystemIntakeHandler{
+			UpdateSystemIntake: func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+				s.Equal(0, intake.Version)
+				return &models.SystemIntake{Version: 3}, nil
+			},
+			HandlerBase: s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(`"3"`, rr.Header().Get("ETag"))
+	})
+
 	s.Run("PUT fails with bad request body", func() {
 		rr := httptest.NewRecorder()
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString(""))
@@ -332,6 +366,52 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		s.Equal("Failed to send notification", responseErr.Message)
 	})
 
+	s.Run("golden path PATCH passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PATCH", fmt.Sprintf("/system_intake/%s", id.String()), bytes.NewBufferString(`{"projectName": "Patched", "version": 2}`))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": id.String()})
+		SystemIntakeHandler{
+			HandlerBase: s.base,
+			PatchSystemIntake: func(ctx context.Context, intakeID uuid.UUID, patch map[string]json.RawMessage, version int) (*models.SystemIntake, error) {
+				s.Equal(id, intakeID)
+				s.Equal(map[string]json.RawMessage{"projectName": json.RawMessage(`"Patched"`)}, patch)
+				s.Equal(2, version)
+				return &models.SystemIntake{ID: intakeID, ProjectName: null.StringFrom("Patched"), Version: 3}, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(`"3"`, rr.Header().Get("ETag"))
+	})
+
+	s.Run("PATCH fails if the body isn't a JSON object", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PATCH", fmt.Sprintf("/system_intake/%s", id.String()), bytes.NewBufferString(`["projectName"]`))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": id.String()})
+		SystemIntakeHandler{
+			HandlerBase: s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusBadRequest, rr.Code)
+	})
+
+	s.Run("PATCH returns unauthorized for fields the user can't patch", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PATCH", fmt.Sprintf("/system_intake/%s", id.String()), bytes.NewBufferString(`{"lcid": "123456"}`))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": id.String()})
+		SystemIntakeHandler{
+			HandlerBase: s.base,
+			PatchSystemIntake: func(context.Context, uuid.UUID, map[string]json.RawMessage, int) (*models.SystemIntake, error) {
+				return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize patching lcid")}
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnauthorized, rr.Code)
+	})
+
 	s.Run("golden path DELETE passes", func() {
 		rr := httptest.NewRecorder()
 		req, err := http.NewRequestWithContext(requestContext, "DELETE", fmt.Sprintf("/system_intake/%s", id.String()), bytes.NewBufferString(""))
@@ -458,3 +538,66 @@ func (s HandlerTestSuite) TestRejectionHandler() {
 		})
 	}
 }
+
+func (s HandlerTestSuite) TestTransferHandler() {
+	testCases := map[string]struct {
+		verb     string
+		intakeID string
+		body     string
+		status   int
+	}{
+		"happy path": {
+			verb:     "POST",
+			intakeID: uuid.New().String(),
+			body:     `{"euaUserId": "NEWW", "reason": "left CMS"}`,
+			status:   http.StatusCreated,
+		},
+		"write error": {
+			verb:     "POST",
+			intakeID: uuid.Nil.String(),
+			body:     `{"euaUserId": "NEWW", "reason": "left CMS"}`,
+			status:   http.StatusInternalServerError,
+		},
+		"invalid intake id": {
+			verb:     "POST",
+			intakeID: "not-a-uuid",
+			body:     `{"euaUserId": "NEWW", "reason": "left CMS"}`,
+			status:   http.StatusUnprocessableEntity,
+		},
+		"malformed body": {
+			verb:     "POST",
+			intakeID: uuid.New().String(),
+			body:     `{"euaUserId":`,
+			status:   http.StatusBadRequest,
+		},
+		"wrong method": {
+			verb:     "GET",
+			intakeID: uuid.New().String(),
+			status:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	fnTransfer := func(_ context.Context, id uuid.UUID, euaUserID string, reason string) (*models.SystemIntake, error) {
+		if id == uuid.Nil {
+			return nil, errors.New("forced error")
+		}
+		s.Equal("NEWW", euaUserID)
+		s.Equal("left CMS", reason)
+		return &models.SystemIntake{ID: id, EUAUserID: null.StringFrom(euaUserID)}, nil
+	}
+	var handler http.Handler = NewSystemIntakeTransferHandler(s.base, fnTransfer).Handle()
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(tc.verb, "/system_intake/{intake_id}/transfer", bytes.NewBufferString(tc.body))
+			s.NoError(err)
+			req = mux.SetURLVars(req, map[string]string{
+				"intake_id": tc.intakeID,
+			})
+			handler.ServeHTTP(rr, req)
+
+			s.Equal(tc.status, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchUserInfoCacheStats func(context.Context) (*models.UserInfoCacheStats, error)
+type evictUserInfoCacheEntry func(context.Context, string) error
+
+// NewUserInfoCacheHandler is a constructor for UserInfoCacheHandler
+func NewUserInfoCacheHandler(base HandlerBase, fetchStats fetchUserInfoCacheStats, evict evictUserInfoCacheEntry) UserInfoCacheHandler {
+	return UserInfoCacheHandler{
+		HandlerBase: base,
+		FetchStats:  fetchStats,
+		Evict:       evict,
+	}
+}
+
+// UserInfoCacheHandler is the handler for administering the CEDAR LDAP user lookup cache
+type UserInfoCacheHandler struct {
+	HandlerBase
+	FetchStats fetchUserInfoCacheStats
+	Evict      evictUserInfoCacheEntry
+}
+
+// Handle handles a request for the cache's stats, or to evict a user from it
+func (h UserInfoCacheHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		euaID := mux.Vars(r)["eua_id"]
+		switch {
+		case r.Method == "GET" && euaID == "":
+			stats, err := h.FetchStats(r.Context())
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(stats)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		case r.Method == "DELETE" && euaID != "":
+			if err := h.Evict(r.Context(), euaID); err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestUserInfoCacheHandler() {
+	fetchStats := func(context.Context) (*models.UserInfoCacheStats, error) {
+		return &models.UserInfoCacheStats{Entries: 2, Hits: 3, Misses: 2}, nil
+	}
+	evict := func(_ context.Context, euaID string) error {
+		if euaID != "ABCD" {
+			return &apperrors.ResourceNotFoundError{Err: errors.New("user is not cached"), Resource: models.UserInfo{}}
+		}
+		return nil
+	}
+	handler := NewUserInfoCacheHandler(s.base, fetchStats, evict)
+	newRequest := func(method string, euaID string) *http.Request {
+		req, err := http.NewRequest(method, "/cedar/user_info_cache", nil)
+		s.NoError(err)
+		if euaID == "" {
+			return req
+		}
+		return mux.SetURLVars(req, map[string]string{"eua_id": euaID})
+	}
+
+	s.Run("golden path GET passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("GET", ""))
+
+		s.Equal(http.StatusOK, rr.Code)
+		var stats models.UserInfoCacheStats
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &stats))
+		s.Equal(uint64(3), stats.Hits)
+	})
+
+	s.Run("golden path DELETE passes", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", "ABCD"))
+
+		s.Equal(http.StatusNoContent, rr.Code)
+	})
+
+	s.Run("DELETE of an uncached user is not found", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", "NONE"))
+
+		s.Equal(http.StatusNotFound, rr.Code)
+	})
+
+	s.Run("DELETE without an EUA ID is not allowed", func() {
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, newRequest("DELETE", ""))
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+}
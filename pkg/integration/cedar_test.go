@@ -2,11 +2,16 @@ package integration
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"time"
 
 	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
 
+	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedareasi"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/local/cedargateway"
 )
 
 // Since we can't always hit the CEDAR API in tests
@@ -26,6 +31,7 @@ func (s *IntegrationTestSuite) TestCEDARConnection() {
 		s.config.GetString("CEDAR_API_URL"),
 		s.config.GetString("CEDAR_API_KEY"),
 		ldClient,
+		nil,
 	)
 
 	ctx, cxl := context.WithTimeout(context.Background(), time.Second*2)
@@ -38,3 +44,55 @@ func (s *IntegrationTestSuite) TestCEDARConnection() {
 	s.Less(int64(time.Now().Sub(start)), int64(time.Second*3))
 	s.NoError(err)
 }
+
+func (s *IntegrationTestSuite) TestCEDARLocalGateway() {
+	gateway := cedargateway.NewGateway("fake-key", cedargateway.DefaultPeople()...)
+	gatewayServer := httptest.NewServer(gateway.Handler())
+	defer gatewayServer.Close()
+	ctx := context.Background()
+
+	cedarLDAPClient := cedarldap.NewTranslatedClient(gatewayServer.URL, "fake-key", nil)
+
+	s.Run("fetches a known person", func() {
+		userInfo, err := cedarLDAPClient.FetchUserInfo(ctx, "ABCD")
+
+		s.NoError(err)
+		s.Equal("ABCD", userInfo.EuaUserID)
+		s.Equal("Adeline Aarons", userInfo.CommonName)
+	})
+
+	s.Run("fails for an unknown person", func() {
+		_, err := cedarLDAPClient.FetchUserInfo(ctx, "ZZZZ")
+
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+	})
+
+	s.Run("searches people by name and email", func() {
+		byName, err := cedarLDAPClient.SearchPeople(ctx, "adeline aar")
+		s.NoError(err)
+		s.Len(byName, 1)
+		s.Equal("ABCD", byName[0].EuaUserID)
+
+		byEmail, err := cedarLDAPClient.SearchPeople(ctx, "tom.arnold@")
+		s.NoError(err)
+		s.Len(byEmail, 1)
+		s.Equal("TACO", byEmail[0].EuaUserID)
+
+		// a single name matches first or last names
+		byEitherName, err := cedarLDAPClient.SearchPeople(ctx, "t")
+		s.NoError(err)
+		s.Len(byEitherName, 2)
+	})
+
+	s.Run("fails when the gateway does", func() {
+		gateway.InjectFault(cedargateway.Fault{
+			Operation: cedargateway.OperationFetchPerson,
+			Status:    http.StatusInternalServerError,
+			Count:     1,
+		})
+
+		_, err := cedarLDAPClient.FetchUserInfo(ctx, "ABCD")
+
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+	})
+}
@@ -31,3 +31,28 @@ func (c *CedarEasiClient) ValidateAndSubmitSystemIntake(ctx context.Context, int
 		zap.String("AlfabetID", fakeAlfabetID))
 	return fakeAlfabetID, nil
 }
+
+// ValidateAndSubmitBusinessCase submits a business case to CEDAR
+func (c *CedarEasiClient) ValidateAndSubmitBusinessCase(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+	fakeCedarID := "000-000-1"
+	appcontext.ZLogger(ctx).Info("Mock Submit Business Case to CEDAR",
+		zap.String("businessCaseID", businessCase.ID.String()),
+		zap.String("CedarID", fakeCedarID))
+	return fakeCedarID, nil
+}
+
+// UpdateSystemIntake sends the current state of a system intake to CEDAR
+func (c *CedarEasiClient) UpdateSystemIntake(ctx context.Context, intake *models.SystemIntake) error {
+	appcontext.ZLogger(ctx).Info("Mock Update System Intake in CEDAR",
+		zap.String("intakeID", intake.ID.String()),
+		zap.String("AlfabetID", intake.AlfabetID.ValueOrZero()),
+		zap.String("status", string(intake.Status)))
+	return nil
+}
+
+// FetchSystemIntakeStatus returns the status CEDAR has for an intake, which is never known locally
+func (c *CedarEasiClient) FetchSystemIntakeStatus(ctx context.Context, alfabetID string) (string, error) {
+	appcontext.ZLogger(ctx).Info("Mock Fetch System Intake Status from CEDAR",
+		zap.String("AlfabetID", alfabetID))
+	return "", nil
+}
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/local/cedargateway"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -38,3 +39,23 @@ func (c CedarLdapClient) FetchUserInfo(_ context.Context, euaID string) (*models
 		EuaUserID:  euaID,
 	}, nil
 }
+
+// SearchPeople finds people in the local directory whose name or email starts with the query
+func (c CedarLdapClient) SearchPeople(_ context.Context, query string) ([]*models.UserInfo, error) {
+	c.logger.Info("Mock SearchPeople from LDAP", zap.String("query", query))
+	query = strings.ToLower(strings.TrimSpace(query))
+	people := []*models.UserInfo{}
+	for _, person := range cedargateway.DefaultPeople() {
+		for _, value := range []string{person.GivenName, person.SurName, person.CommonName, person.Email} {
+			if query != "" && strings.HasPrefix(strings.ToLower(value), query) {
+				people = append(people, &models.UserInfo{
+					CommonName: person.CommonName,
+					Email:      person.Email,
+					EuaUserID:  person.UserName,
+				})
+				break
+			}
+		}
+	}
+	return people, nil
+}
@@ -0,0 +1,425 @@
+// Package cedargateway is an in-memory stand-in for the CEDAR API gateway,
+// serving the EASi and LDAP endpoints so the real CEDAR clients can be used
+// locally and in integration tests
+package cedargateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gorilla/mux"
+
+	easiclient "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/client"
+	easimodels "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/models"
+	ldapclient "github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/client"
+	ldapmodels "github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/models"
+)
+
+// Operation names a gateway endpoint that faults can be injected into
+type Operation string
+
+const (
+	// OperationHealthCheck is GET /healthCheck
+	OperationHealthCheck Operation = "healthCheck"
+	// OperationSubmitIntake is POST /intake/governance
+	OperationSubmitIntake Operation = "submitIntake"
+	// OperationFetchIntake is GET /intake/governance/{id}
+	OperationFetchIntake Operation = "fetchIntake"
+	// OperationUpdateIntake is PUT /intake/governance/{id}
+	OperationUpdateIntake Operation = "updateIntake"
+	// OperationSubmitBusinessCase is POST /intake/businessCase
+	OperationSubmitBusinessCase Operation = "submitBusinessCase"
+	// OperationFetchBusinessCase is GET /intake/businessCase/{id}
+	OperationFetchBusinessCase Operation = "fetchBusinessCase"
+	// OperationUpdateBusinessCase is PUT /intake/businessCase/{id}
+	OperationUpdateBusinessCase Operation = "updateBusinessCase"
+	// OperationFetchPerson is GET /person/{id}
+	OperationFetchPerson Operation = "fetchPerson"
+	// OperationSearchPeople is GET /person
+	OperationSearchPeople Operation = "searchPeople"
+)
+
+// APIKeyHeader is the header the gateway expects the API key in
+const APIKeyHeader = "x-Gateway-APIKey"
+
+// FaultsPath is where faults can be injected over HTTP when the gateway runs in its own process
+const FaultsPath = "/_gateway/faults"
+
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+// Fault makes an operation fail or slow down for its next Count calls, or every call if Count is 0
+type Fault struct {
+	Operation Operation `json:"operation"`
+	Status    int       `json:"status"`
+	// Delay is sent in nanoseconds over HTTP
+	Delay time.Duration `json:"delay"`
+	// Disconnect closes the connection without a response
+	Disconnect bool `json:"disconnect"`
+	Count      int  `json:"count"`
+}
+
+// Gateway is a fake CEDAR API gateway backed by an in-memory person directory and intake store
+type Gateway struct {
+	apiKey string
+
+	mu            sync.Mutex
+	people        map[string]*ldapmodels.Person
+	intakes       map[string]*easimodels.GovernanceIntake
+	businessCases map[string]*easimodels.BusinessCase
+	nextID        int
+	faults        []*Fault
+	calls         map[Operation]int
+}
+
+// NewGateway returns a gateway that requires apiKey, if given, and knows the given people
+func NewGateway(apiKey string, people ...*ldapmodels.Person) *Gateway {
+	g := &Gateway{
+		apiKey:        apiKey,
+		people:        map[string]*ldapmodels.Person{},
+		intakes:       map[string]*easimodels.GovernanceIntake{},
+		businessCases: map[string]*easimodels.BusinessCase{},
+		calls:         map[Operation]int{},
+	}
+	for _, person := range people {
+		g.AddPerson(person)
+	}
+	return g
+}
+
+// DefaultPeople is the directory used when the gateway is run locally
+func DefaultPeople() []*ldapmodels.Person {
+	return []*ldapmodels.Person{
+		{UserName: "ABCD", CommonName: "Adeline Aarons", GivenName: "Adeline", SurName: "Aarons", Email: "adeline.aarons@local.fake"},
+		{UserName: "TEST", CommonName: "Terry Thompson", GivenName: "Terry", SurName: "Thompson", Email: "terry.thompson@local.fake"},
+		{UserName: "TACO", CommonName: "Tom Arnold", GivenName: "Tom", SurName: "Arnold", Email: "tom.arnold@local.fake"},
+		{UserName: "A11Y", CommonName: "Ally Accessible", GivenName: "Ally", SurName: "Accessible", Email: "ally.accessible@local.fake"},
+		{UserName: "GRTB", CommonName: "Gary Reviewer", GivenName: "Gary", SurName: "Reviewer", Email: "gary.reviewer@local.fake"},
+	}
+}
+
+// AddPerson adds a person to the directory, replacing any with the same user name
+func (g *Gateway) AddPerson(person *ldapmodels.Person) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.people[strings.ToUpper(person.UserName)] = person
+}
+
+// Intake returns the governance intake stored under an alfabet ID
+func (g *Gateway) Intake(alfabetID string) (*easimodels.GovernanceIntake, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	intake, ok := g.intakes[alfabetID]
+	return intake, ok
+}
+
+// BusinessCase returns the business case stored under a CEDAR ID
+func (g *Gateway) BusinessCase(cedarID string) (*easimodels.BusinessCase, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	businessCase, ok := g.businessCases[cedarID]
+	return businessCase, ok
+}
+
+// Calls returns how many requests an operation has received, including failed ones
+func (g *Gateway) Calls(operation Operation) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.calls[operation]
+}
+
+// InjectFault adds a fault, which takes effect on the next request
+func (g *Gateway) InjectFault(fault Fault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.faults = append(g.faults, &fault)
+}
+
+// ClearFaults removes all injected faults
+func (g *Gateway) ClearFaults() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.faults = nil
+}
+
+// takeFault records a call and returns the first fault for the operation, using it up
+func (g *Gateway) takeFault(operation Operation) *Fault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.calls[operation]++
+	for i, fault := range g.faults {
+		if fault.Operation != operation {
+			continue
+		}
+		taken := *fault
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				g.faults = append(g.faults[:i], g.faults[i+1:]...)
+			}
+		}
+		return &taken
+	}
+	return nil
+}
+
+// Handler returns the gateway's routes, mounted under each API's base path
+func (g *Gateway) Handler() http.Handler {
+	r := mux.NewRouter()
+
+	easi := r.PathPrefix(easiclient.DefaultBasePath).Subrouter()
+	easi.HandleFunc("/healthCheck", g.handle(OperationHealthCheck, g.healthCheck)).Methods("GET")
+	easi.HandleFunc("/intake/governance", g.handle(OperationSubmitIntake, g.submitIntake)).Methods("POST")
+	easi.HandleFunc("/intake/governance/{id}", g.handle(OperationFetchIntake, g.fetchIntake)).Methods("GET")
+	easi.HandleFunc("/intake/governance/{id}", g.handle(OperationUpdateIntake, g.updateIntake)).Methods("PUT")
+	easi.HandleFunc("/intake/businessCase", g.handle(OperationSubmitBusinessCase, g.submitBusinessCase)).Methods("POST")
+	easi.HandleFunc("/intake/businessCase/{id}", g.handle(OperationFetchBusinessCase, g.fetchBusinessCase)).Methods("GET")
+	easi.HandleFunc("/intake/businessCase/{id}", g.handle(OperationUpdateBusinessCase, g.updateBusinessCase)).Methods("PUT")
+
+	ldap := r.PathPrefix(ldapclient.DefaultBasePath).Subrouter()
+	ldap.HandleFunc("/person", g.handle(OperationSearchPeople, g.searchPeople)).Methods("GET")
+	ldap.HandleFunc("/person/{id}", g.handle(OperationFetchPerson, g.fetchPerson)).Methods("GET")
+
+	r.HandleFunc(FaultsPath, g.injectFault).Methods("POST")
+	r.HandleFunc(FaultsPath, g.clearFaults).Methods("DELETE")
+	return r
+}
+
+// handle checks the API key and applies any injected fault before calling the endpoint
+func (g *Gateway) handle(operation Operation, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if g.apiKey != "" && r.Header.Get(APIKeyHeader) != g.apiKey {
+			writeResponse(w, http.StatusUnauthorized, resultError, "invalid API key")
+			return
+		}
+		if fault := g.takeFault(operation); fault != nil {
+			if fault.Delay > 0 {
+				select {
+				case <-time.After(fault.Delay):
+				case <-r.Context().Done():
+					return
+				}
+			}
+			if fault.Disconnect {
+				if hijacker, ok := w.(http.Hijacker); ok {
+					if conn, _, err := hijacker.Hijack(); err == nil {
+						_ = conn.Close()
+						return
+					}
+				}
+			}
+			if fault.Status != 0 {
+				writeResponse(w, fault.Status, resultError, "injected fault")
+				return
+			}
+		}
+		next(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeResponse writes the Response body the EASi endpoints return
+func writeResponse(w http.ResponseWriter, status int, result string, messages ...string) {
+	writeJSON(w, status, struct {
+		Response *easimodels.Response1 `json:"Response"`
+	}{&easimodels.Response1{Result: &result, Message: messages}})
+}
+
+// decode reads a request body and validates it against the swagger model
+func decode(r *http.Request, body interface {
+	Validate(strfmt.Registry) error
+}) error {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return err
+	}
+	return body.Validate(strfmt.Default)
+}
+
+func (g *Gateway) newID() string {
+	g.nextID++
+	return fmt.Sprintf("000-%03d-%d", g.nextID/10, g.nextID%10)
+}
+
+func (g *Gateway) healthCheck(w http.ResponseWriter, r *http.Request) {
+	status := "pass"
+	now := time.Now().UTC()
+	datetime := now.Format(time.RFC3339)
+	timestamp := fmt.Sprintf("%d", now.Unix())
+	version := "local"
+	writeJSON(w, http.StatusOK, easimodels.HealthCheckGETResponse{
+		Status:    &status,
+		Datetime:  &datetime,
+		Timestamp: &timestamp,
+		Version:   &version,
+	})
+}
+
+func (g *Gateway) submitIntake(w http.ResponseWriter, r *http.Request) {
+	var body easimodels.Intake
+	if err := decode(r, &body); err != nil {
+		writeResponse(w, http.StatusBadRequest, resultError, err.Error())
+		return
+	}
+	g.mu.Lock()
+	alfabetID := g.newID()
+	g.intakes[alfabetID] = body.Governance
+	g.mu.Unlock()
+	writeResponse(w, http.StatusOK, resultSuccess, alfabetID)
+}
+
+func (g *Gateway) fetchIntake(w http.ResponseWriter, r *http.Request) {
+	intake, ok := g.Intake(mux.Vars(r)["id"])
+	if !ok {
+		writeResponse(w, http.StatusBadRequest, resultError, "intake not found")
+		return
+	}
+	result := resultSuccess
+	writeJSON(w, http.StatusOK, easimodels.IntakegovernanceidGETResponse{
+		Intake:   &easimodels.Intake1{Governance: intake},
+		Response: &easimodels.Response1{Result: &result},
+	})
+}
+
+func (g *Gateway) updateIntake(w http.ResponseWriter, r *http.Request) {
+	alfabetID := mux.Vars(r)["id"]
+	var body easimodels.IntakeUpdate
+	if err := decode(r, &body); err != nil {
+		writeResponse(w, http.StatusBadRequest, resultError, err.Error())
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.intakes[alfabetID]; !ok {
+		writeResponse(w, http.StatusOK, resultError, "intake not found")
+		return
+	}
+	g.intakes[alfabetID] = body.Governance
+	writeResponse(w, http.StatusOK, resultSuccess, alfabetID)
+}
+
+func (g *Gateway) submitBusinessCase(w http.ResponseWriter, r *http.Request) {
+	var body easimodels.Intake2
+	if err := decode(r, &body); err != nil {
+		writeResponse(w, http.StatusBadRequest, resultError, err.Error())
+		return
+	}
+	g.mu.Lock()
+	cedarID := g.newID()
+	g.businessCases[cedarID] = body.BusinessCase
+	g.mu.Unlock()
+	writeResponse(w, http.StatusOK, resultSuccess, cedarID)
+}
+
+func (g *Gateway) fetchBusinessCase(w http.ResponseWriter, r *http.Request) {
+	businessCase, ok := g.BusinessCase(mux.Vars(r)["id"])
+	if !ok {
+		writeResponse(w, http.StatusBadRequest, resultError, "business case not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, easimodels.IntakebusinessCaseidGETResponse{BusinessCase: businessCase})
+}
+
+func (g *Gateway) updateBusinessCase(w http.ResponseWriter, r *http.Request) {
+	cedarID := mux.Vars(r)["id"]
+	var body easimodels.Intake3
+	if err := decode(r, &body); err != nil {
+		writeResponse(w, http.StatusBadRequest, resultError, err.Error())
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.businessCases[cedarID]; !ok {
+		writeResponse(w, http.StatusOK, resultError, "business case not found")
+		return
+	}
+	g.businessCases[cedarID] = body.BusinessCase
+	writeResponse(w, http.StatusOK, resultSuccess, cedarID)
+}
+
+func writeLDAPError(w http.ResponseWriter, status int, message string) {
+	result := resultError
+	writeJSON(w, status, ldapmodels.Response{Result: &result, Message: []string{message}})
+}
+
+func (g *Gateway) fetchPerson(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	person, ok := g.people[strings.ToUpper(mux.Vars(r)["id"])]
+	g.mu.Unlock()
+	if !ok {
+		// LDAP answers unknown IDs with an empty person
+		writeJSON(w, http.StatusOK, ldapmodels.Person{})
+		return
+	}
+	writeJSON(w, http.StatusOK, person)
+}
+
+func (g *Gateway) searchPeople(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filters := map[string]string{
+		"firstName": query.Get("first_name"),
+		"lastName":  query.Get("last_name"),
+		"email":     query.Get("email"),
+		"telephone": query.Get("telephone"),
+	}
+	searching := false
+	for _, value := range filters {
+		if value != "" {
+			searching = true
+		}
+	}
+	if !searching {
+		writeLDAPError(w, http.StatusBadRequest, "at least one search parameter is required")
+		return
+	}
+
+	matches := func(value, filter string) bool {
+		// LDAP filters allow * as a wildcard
+		filter = strings.ToLower(strings.Trim(filter, "*"))
+		return filter == "" || strings.HasPrefix(strings.ToLower(value), filter)
+	}
+	people := []*ldapmodels.Person{}
+	g.mu.Lock()
+	for _, person := range g.people {
+		if matches(person.GivenName, filters["firstName"]) &&
+			matches(person.SurName, filters["lastName"]) &&
+			matches(person.Email, filters["email"]) &&
+			matches(person.TelephoneNumber, filters["telephone"]) {
+			people = append(people, person)
+		}
+	}
+	g.mu.Unlock()
+	sort.Slice(people, func(i, j int) bool { return people[i].UserName < people[j].UserName })
+	if limit, err := strconv.Atoi(query.Get("count_limit")); err == nil && limit >= 0 && limit < len(people) {
+		people = people[:limit]
+	}
+	writeJSON(w, http.StatusOK, ldapmodels.PersonList{PersonList: people})
+}
+
+func (g *Gateway) injectFault(w http.ResponseWriter, r *http.Request) {
+	var fault Fault
+	if err := json.NewDecoder(r.Body).Decode(&fault); err != nil || fault.Operation == "" {
+		http.Error(w, "a fault needs an operation", http.StatusBadRequest)
+		return
+	}
+	g.InjectFault(fault)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (g *Gateway) clearFaults(w http.ResponseWriter, r *http.Request) {
+	g.ClearFaults()
+	w.WriteHeader(http.StatusNoContent)
+}
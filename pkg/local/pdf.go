@@ -0,0 +1,134 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	pdfLineWidth    = 90
+	pdfLinesPerPage = 60
+)
+
+var (
+	pdfInvisibleElements = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+	pdfLineBreakTags     = regexp.MustCompile(`(?i)<(br|/p|/h[1-6]|/dt|/dd|/li|/tr|/div|/table)[^>]*>`)
+	pdfTags              = regexp.MustCompile(`<[^>]*>`)
+	pdfSpaces            = regexp.MustCompile(`[ \t\r]+`)
+)
+
+// NewPDFGenerator returns an in-process PDF generator for local and test environments
+func NewPDFGenerator(logger *zap.Logger) PDFGenerator {
+	return PDFGenerator{logger: logger}
+}
+
+// PDFGenerator renders the text of an HTML document as a plain PDF without any network calls
+type PDFGenerator struct {
+	logger *zap.Logger
+}
+
+// GeneratePDF renders the HTML as a PDF
+func (g PDFGenerator) GeneratePDF(ctx context.Context, document string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	lines := pdfTextLines(document)
+	g.logger.Info("Mock generating PDF", zap.Int("lines", len(lines)))
+	return pdfFromLines(lines), nil
+}
+
+// pdfTextLines extracts the visible text of an HTML document, wrapped to the page width
+func pdfTextLines(document string) []string {
+	text := pdfInvisibleElements.ReplaceAllString(document, "")
+	text = pdfLineBreakTags.ReplaceAllString(text, "\n")
+	text = html.UnescapeString(pdfTags.ReplaceAllString(text, " "))
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(pdfSpaces.ReplaceAllString(line, " "))
+		if line == "" {
+			continue
+		}
+		for len(line) > pdfLineWidth {
+			cut := strings.LastIndex(line[:pdfLineWidth], " ")
+			if cut <= 0 {
+				cut = pdfLineWidth
+			}
+			lines = append(lines, line[:cut])
+			line = strings.TrimSpace(line[cut:])
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// pdfEscape escapes a line for a PDF string literal, replacing characters outside of ASCII
+func pdfEscape(line string) string {
+	var b strings.Builder
+	for _, r := range line {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// pdfFromLines lays out lines of text on letter sized pages
+func pdfFromLines(lines []string) []byte {
+	var pages [][]string
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// objects 1 and 2 are the catalog and page tree, 3 is the font,
+	// and each page is followed by its content stream
+	var objects []string
+	objects = append(objects, "<< /Type /Catalog /Pages 2 0 R >>")
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	objects = append(objects, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	objects = append(objects, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>")
+	for i, page := range pages {
+		var content bytes.Buffer
+		content.WriteString("BT /F1 10 Tf 12 TL 50 750 Td\n")
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) '\n", pdfEscape(line))
+		}
+		content.WriteString("ET")
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", 5+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
@@ -4,13 +4,16 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 )
 
 // AccessibilityRequest models a 508 request
 type AccessibilityRequest struct {
-	ID        uuid.UUID  `json:"id"`
-	Name      string     `json:"name"`
-	IntakeID  uuid.UUID  `db:"intake_id"`
-	CreatedAt *time.Time `db:"created_at" gqlgen:"submittedAt"`
-	UpdatedAt *time.Time `db:"updated_at"`
+	ID        uuid.UUID   `json:"id"`
+	Name      string      `json:"name"`
+	IntakeID  uuid.UUID   `db:"intake_id"`
+	CreatedAt *time.Time  `db:"created_at" gqlgen:"submittedAt"`
+	UpdatedAt *time.Time  `db:"updated_at"`
+	DeletedAt *time.Time  `db:"deleted_at"`
+	DeletedBy null.String `db:"deleted_by"`
 }
@@ -93,4 +93,6 @@ type AccessibilityRequestDocument struct {
 	OtherType          string                                 `db:"other_type"`
 	CreatedAt          *time.Time                             `json:"createdAt" db:"created_at"`
 	UpdatedAt          *time.Time                             `json:"updatedAt" db:"updated_at"`
+	DeletedAt          *time.Time                             `json:"deletedAt" db:"deleted_at"`
+	DeletedBy          null.String                            `json:"deletedBy" db:"deleted_by"`
 }
@@ -47,6 +47,12 @@ const (
 	ActionTypeGUIDERECEIVEDCLOSE ActionType = "GUIDE_RECEIVED_CLOSE"
 	// ActionTypeNOTRESPONDINGCLOSE captures enum value NOT_RESPONDING_CLOSE
 	ActionTypeNOTRESPONDINGCLOSE ActionType = "NOT_RESPONDING_CLOSE"
+	// ActionTypeADDDELEGATE captures enum value ADD_DELEGATE
+	ActionTypeADDDELEGATE ActionType = "ADD_DELEGATE"
+	// ActionTypeREMOVEDELEGATE captures enum value REMOVE_DELEGATE
+	ActionTypeREMOVEDELEGATE ActionType = "REMOVE_DELEGATE"
+	// ActionTypeTRANSFEROWNERSHIP captures enum value TRANSFER_OWNERSHIP
+	ActionTypeTRANSFEROWNERSHIP ActionType = "TRANSFER_OWNERSHIP"
 )
 
 // Action is the model for an action on a system intake
@@ -60,4 +66,6 @@ type Action struct {
 	ActorEUAUserID string      `json:"actorEuaUserId" db:"actor_eua_user_id"`
 	Feedback       null.String `json:"feedback"`
 	CreatedAt      *time.Time  `json:"createdAt" db:"created_at"`
+	// NotifyCollaborators also sends the action's email to the intake's resolved collaborators
+	NotifyCollaborators bool `json:"notifyCollaborators" db:"-"`
 }
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// AuditEventOutcome is how the audited action turned out
+type AuditEventOutcome string
+
+const (
+	// AuditEventOutcomeSUCCEEDED captures enum value SUCCEEDED
+	AuditEventOutcomeSUCCEEDED AuditEventOutcome = "SUCCEEDED"
+	// AuditEventOutcomeFAILED captures enum value FAILED
+	AuditEventOutcomeFAILED AuditEventOutcome = "FAILED"
+	// AuditEventOutcomeDENIED captures enum value DENIED
+	AuditEventOutcomeDENIED AuditEventOutcome = "DENIED"
+)
+
+// AuditEvent records a principal's attempt to read or change a resource
+type AuditEvent struct {
+	ID           uuid.UUID         `json:"id"`
+	EUAUserID    string            `json:"euaUserId" db:"eua_user_id"`
+	Action       string            `json:"action"`
+	ResourceType string            `json:"resourceType" db:"resource_type"`
+	ResourceID   null.String       `json:"resourceId" db:"resource_id"`
+	Outcome      AuditEventOutcome `json:"outcome"`
+	TraceID      *uuid.UUID        `json:"traceId" db:"trace_id"`
+	CreatedAt    *time.Time        `json:"createdAt" db:"created_at"`
+}
+
+// AuditEvents is a list of audit events
+type AuditEvents []AuditEvent
+
+// AuditEventFilter narrows down the audit events to fetch.
+// Zero values match everything.
+type AuditEventFilter struct {
+	EUAUserID    string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Outcome      AuditEventOutcome
+	From         *time.Time
+	To           *time.Time
+	Limit        int
+}
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -52,18 +54,72 @@ const (
 	LifecycleCostYear4 LifecycleCostYear = "4"
 	// LifecycleCostYear5 captures enum value "5"
 	LifecycleCostYear5 LifecycleCostYear = "5"
+
+	// DefaultLifecycleCostHorizon is the number of years costs are estimated for unless configured
+	DefaultLifecycleCostHorizon = 5
+	// MaxLifecycleCostHorizon is the largest number of years costs can be estimated for
+	MaxLifecycleCostHorizon = 10
 )
 
+// LifecycleCostPhases models the list of phases a business case estimates costs for
+type LifecycleCostPhases []LifecycleCostPhase
+
+// DefaultLifecycleCostPhases returns the phases costs are estimated for unless configured
+func DefaultLifecycleCostPhases() LifecycleCostPhases {
+	return LifecycleCostPhases{
+		LifecycleCostPhaseDEVELOPMENT,
+		LifecycleCostPhaseOPERATIONMAINTENANCE,
+		LifecycleCostPhaseOTHER,
+	}
+}
+
+// Scan implements the sql.Scanner interface
+func (p *LifecycleCostPhases) Scan(src interface{}) error {
+	return json.Unmarshal(src.([]byte), p)
+}
+
+// Value implements the driver.Valuer interface
+func (p LifecycleCostPhases) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	phases, err := json.Marshal(p)
+	return string(phases), err
+}
+
+// Value implements the driver.Valuer interface so lines for alternatives
+// without a fixed solution are stored as NULL
+func (s LifecycleCostSolution) Value() (driver.Value, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return string(s), nil
+}
+
 // EstimatedLifecycleCost is the model for the cost of an estimated lifecycle line in the business case.
 type EstimatedLifecycleCost struct {
 	ID             uuid.UUID             `json:"id"`
 	BusinessCaseID uuid.UUID             `json:"business_case" db:"business_case"`
+	AlternativeID  *uuid.UUID            `json:"alternative_id" db:"alternative_id"`
 	Solution       LifecycleCostSolution `json:"solution"`
 	Phase          *LifecycleCostPhase   `json:"phase"`
 	Year           LifecycleCostYear     `json:"year"`
 	Cost           *int                  `json:"cost"`
 }
 
+// ChangeKey identifies a line by its solution or alternative, phase and year
+func (e EstimatedLifecycleCost) ChangeKey() string {
+	solution := string(e.Solution)
+	if solution == "" && e.AlternativeID != nil {
+		solution = e.AlternativeID.String()
+	}
+	phase := ""
+	if e.Phase != nil {
+		phase = string(*e.Phase)
+	}
+	return solution + "/" + phase + "/" + string(e.Year)
+}
+
 // EstimatedLifecycleCosts models a list of EstimatedLifecycleCost line items
 type EstimatedLifecycleCosts []EstimatedLifecycleCost
 
@@ -74,68 +130,124 @@ func (e *EstimatedLifecycleCosts) Scan(src interface{}) error {
 
 // BusinessCase is the model for the business case form.
 type BusinessCase struct {
-	ID                                  uuid.UUID               `json:"id"`
-	EUAUserID                           string                  `json:"euaUserId" db:"eua_user_id"`
-	SystemIntakeID                      uuid.UUID               `json:"systemIntakeId" db:"system_intake"`
-	SystemIntakeStatus                  SystemIntakeStatus      `json:"systemIntakeStatus" db:"system_intake_status"`
-	Status                              BusinessCaseStatus      `json:"status"`
-	ProjectName                         null.String             `json:"projectName" db:"project_name"`
-	Requester                           null.String             `json:"requester"`
-	RequesterPhoneNumber                null.String             `json:"requesterPhoneNumber" db:"requester_phone_number"`
-	BusinessOwner                       null.String             `json:"businessOwner" db:"business_owner"`
-	BusinessNeed                        null.String             `json:"businessNeed" db:"business_need"`
-	CMSBenefit                          null.String             `json:"cmsBenefit" db:"cms_benefit"`
-	PriorityAlignment                   null.String             `json:"priorityAlignment" db:"priority_alignment"`
-	SuccessIndicators                   null.String             `json:"successIndicators" db:"success_indicators"`
-	AsIsTitle                           null.String             `json:"asIsTitle" db:"as_is_title"`
-	AsIsSummary                         null.String             `json:"asIsSummary" db:"as_is_summary"`
-	AsIsPros                            null.String             `json:"asIsPros" db:"as_is_pros"`
-	AsIsCons                            null.String             `json:"asIsCons" db:"as_is_cons"`
-	AsIsCostSavings                     null.String             `json:"asIsCostSavings" db:"as_is_cost_savings"`
-	PreferredTitle                      null.String             `json:"preferredTitle" db:"preferred_title"`
-	PreferredSummary                    null.String             `json:"preferredSummary" db:"preferred_summary"`
-	PreferredAcquisitionApproach        null.String             `json:"preferredAcquisitionApproach" db:"preferred_acquisition_approach"`
-	PreferredSecurityIsApproved         null.Bool               `json:"preferredSecurityIsApproved" db:"preferred_security_is_approved"`
-	PreferredSecurityIsBeingReviewed    null.String             `json:"preferredSecurityIsBeingReviewed" db:"preferred_security_is_being_reviewed"`
-	PreferredHostingType                null.String             `json:"preferredHostingType" db:"preferred_hosting_type"`
-	PreferredHostingLocation            null.String             `json:"preferredHostingLocation" db:"preferred_hosting_location"`
-	PreferredHostingCloudServiceType    null.String             `json:"preferredHostingCloudServiceType" db:"preferred_hosting_cloud_service_type"`
-	PreferredHasUI                      null.String             `json:"preferredHasUI" db:"preferred_has_ui"`
-	PreferredPros                       null.String             `json:"preferredPros" db:"preferred_pros"`
-	PreferredCons                       null.String             `json:"preferredCons" db:"preferred_cons"`
-	PreferredCostSavings                null.String             `json:"preferredCostSavings" db:"preferred_cost_savings"`
-	AlternativeATitle                   null.String             `json:"alternativeATitle" db:"alternative_a_title"`
-	AlternativeASummary                 null.String             `json:"alternativeASummary" db:"alternative_a_summary"`
-	AlternativeAAcquisitionApproach     null.String             `json:"alternativeAAcquisitionApproach" db:"alternative_a_acquisition_approach"`
-	AlternativeASecurityIsApproved      null.Bool               `json:"alternativeASecurityIsApproved" db:"alternative_a_security_is_approved"`
-	AlternativeASecurityIsBeingReviewed null.String             `json:"alternativeASecurityIsBeingReviewed" db:"alternative_a_security_is_being_reviewed"`
-	AlternativeAHostingType             null.String             `json:"alternativeAHostingType" db:"alternative_a_hosting_type"`
-	AlternativeAHostingLocation         null.String             `json:"alternativeAHostingLocation" db:"alternative_a_hosting_location"`
-	AlternativeAHostingCloudServiceType null.String             `json:"alternativeAHostingCloudServiceType" db:"alternative_a_hosting_cloud_service_type"`
-	AlternativeAHasUI                   null.String             `json:"alternativeAHasUI" db:"alternative_a_has_ui"`
-	AlternativeAPros                    null.String             `json:"alternativeAPros" db:"alternative_a_pros"`
-	AlternativeACons                    null.String             `json:"alternativeACons" db:"alternative_a_cons"`
-	AlternativeACostSavings             null.String             `json:"alternativeACostSavings" db:"alternative_a_cost_savings"`
-	AlternativeBTitle                   null.String             `json:"alternativeBTitle" db:"alternative_b_title"`
-	AlternativeBSummary                 null.String             `json:"alternativeBSummary" db:"alternative_b_summary"`
-	AlternativeBAcquisitionApproach     null.String             `json:"alternativeBAcquisitionApproach" db:"alternative_b_acquisition_approach"`
-	AlternativeBSecurityIsApproved      null.Bool               `json:"alternativeBSecurityIsApproved" db:"alternative_b_security_is_approved"`
-	AlternativeBSecurityIsBeingReviewed null.String             `json:"alternativeBSecurityIsBeingReviewed" db:"alternative_b_security_is_being_reviewed"`
-	AlternativeBHostingType             null.String             `json:"alternativeBHostingType" db:"alternative_b_hosting_type"`
-	AlternativeBHostingLocation         null.String             `json:"alternativeBHostingLocation" db:"alternative_b_hosting_location"`
-	AlternativeBHostingCloudServiceType null.String             `json:"alternativeBHostingCloudServiceType" db:"alternative_b_hosting_cloud_service_type"`
-	AlternativeBHasUI                   null.String             `json:"alternativeBHasUI" db:"alternative_b_has_ui"`
-	AlternativeBPros                    null.String             `json:"alternativeBPros" db:"alternative_b_pros"`
-	AlternativeBCons                    null.String             `json:"alternativeBCons" db:"alternative_b_cons"`
-	AlternativeBCostSavings             null.String             `json:"alternativeBCostSavings" db:"alternative_b_cost_savings"`
-	LifecycleCostLines                  EstimatedLifecycleCosts `json:"lifecycleCostLines" db:"lifecycle_cost_lines"`
-	CreatedAt                           *time.Time              `json:"createdAt" db:"created_at"`
-	UpdatedAt                           *time.Time              `json:"updatedAt" db:"updated_at"`
-	SubmittedAt                         *time.Time              `json:"submittedAt" db:"submitted_at"`
-	ArchivedAt                          *time.Time              `db:"archived_at"`
-	InitialSubmittedAt                  *time.Time              `json:"initialSubmittedAt" db:"initial_submitted_at"`
-	LastSubmittedAt                     *time.Time              `json:"lastSubmittedAt" db:"last_submitted_at"`
+	ID                                  uuid.UUID                `json:"id"`
+	EUAUserID                           string                   `json:"euaUserId" db:"eua_user_id"`
+	SystemIntakeID                      uuid.UUID                `json:"systemIntakeId" db:"system_intake"`
+	SystemIntakeStatus                  SystemIntakeStatus       `json:"systemIntakeStatus" db:"system_intake_status"`
+	Status                              BusinessCaseStatus       `json:"status"`
+	ProjectName                         null.String              `json:"projectName" db:"project_name"`
+	Requester                           null.String              `json:"requester"`
+	RequesterPhoneNumber                null.String              `json:"requesterPhoneNumber" db:"requester_phone_number"`
+	BusinessOwner                       null.String              `json:"businessOwner" db:"business_owner"`
+	BusinessNeed                        null.String              `json:"businessNeed" db:"business_need"`
+	CMSBenefit                          null.String              `json:"cmsBenefit" db:"cms_benefit"`
+	PriorityAlignment                   null.String              `json:"priorityAlignment" db:"priority_alignment"`
+	SuccessIndicators                   null.String              `json:"successIndicators" db:"success_indicators"`
+	AsIsTitle                           null.String              `json:"asIsTitle" db:"as_is_title"`
+	AsIsSummary                         null.String              `json:"asIsSummary" db:"as_is_summary"`
+	AsIsPros                            null.String              `json:"asIsPros" db:"as_is_pros"`
+	AsIsCons                            null.String              `json:"asIsCons" db:"as_is_cons"`
+	AsIsCostSavings                     null.String              `json:"asIsCostSavings" db:"as_is_cost_savings"`
+	PreferredTitle                      null.String              `json:"preferredTitle" db:"preferred_title"`
+	PreferredSummary                    null.String              `json:"preferredSummary" db:"preferred_summary"`
+	PreferredAcquisitionApproach        null.String              `json:"preferredAcquisitionApproach" db:"preferred_acquisition_approach"`
+	PreferredSecurityIsApproved         null.Bool                `json:"preferredSecurityIsApproved" db:"preferred_security_is_approved"`
+	PreferredSecurityIsBeingReviewed    null.String              `json:"preferredSecurityIsBeingReviewed" db:"preferred_security_is_being_reviewed"`
+	PreferredHostingType                null.String              `json:"preferredHostingType" db:"preferred_hosting_type"`
+	PreferredHostingLocation            null.String              `json:"preferredHostingLocation" db:"preferred_hosting_location"`
+	PreferredHostingCloudServiceType    null.String              `json:"preferredHostingCloudServiceType" db:"preferred_hosting_cloud_service_type"`
+	PreferredHasUI                      null.String              `json:"preferredHasUI" db:"preferred_has_ui"`
+	PreferredPros                       null.String              `json:"preferredPros" db:"preferred_pros"`
+	PreferredCons                       null.String              `json:"preferredCons" db:"preferred_cons"`
+	PreferredCostSavings                null.String              `json:"preferredCostSavings" db:"preferred_cost_savings"`
+	AlternativeATitle                   null.String              `json:"alternativeATitle" db:"alternative_a_title"`
+	AlternativeASummary                 null.String              `json:"alternativeASummary" db:"alternative_a_summary"`
+	AlternativeAAcquisitionApproach     null.String              `json:"alternativeAAcquisitionApproach" db:"alternative_a_acquisition_approach"`
+	AlternativeASecurityIsApproved      null.Bool                `json:"alternativeASecurityIsApproved" db:"alternative_a_security_is_approved"`
+	AlternativeASecurityIsBeingReviewed null.String              `json:"alternativeASecurityIsBeingReviewed" db:"alternative_a_security_is_being_reviewed"`
+	AlternativeAHostingType             null.String              `json:"alternativeAHostingType" db:"alternative_a_hosting_type"`
+	AlternativeAHostingLocation         null.String              `json:"alternativeAHostingLocation" db:"alternative_a_hosting_location"`
+	AlternativeAHostingCloudServiceType null.String              `json:"alternativeAHostingCloudServiceType" db:"alternative_a_hosting_cloud_service_type"`
+	AlternativeAHasUI                   null.String              `json:"alternativeAHasUI" db:"alternative_a_has_ui"`
+	AlternativeAPros                    null.String              `json:"alternativeAPros" db:"alternative_a_pros"`
+	AlternativeACons                    null.String              `json:"alternativeACons" db:"alternative_a_cons"`
+	AlternativeACostSavings             null.String              `json:"alternativeACostSavings" db:"alternative_a_cost_savings"`
+	AlternativeBTitle                   null.String              `json:"alternativeBTitle" db:"alternative_b_title"`
+	AlternativeBSummary                 null.String              `json:"alternativeBSummary" db:"alternative_b_summary"`
+	AlternativeBAcquisitionApproach     null.String              `json:"alternativeBAcquisitionApproach" db:"alternative_b_acquisition_approach"`
+	AlternativeBSecurityIsApproved      null.Bool                `json:"alternativeBSecurityIsApproved" db:"alternative_b_security_is_approved"`
+	AlternativeBSecurityIsBeingReviewed null.String              `json:"alternativeBSecurityIsBeingReviewed" db:"alternative_b_security_is_being_reviewed"`
+	AlternativeBHostingType             null.String              `json:"alternativeBHostingType" db:"alternative_b_hosting_type"`
+	AlternativeBHostingLocation         null.String              `json:"alternativeBHostingLocation" db:"alternative_b_hosting_location"`
+	AlternativeBHostingCloudServiceType null.String              `json:"alternativeBHostingCloudServiceType" db:"alternative_b_hosting_cloud_service_type"`
+	AlternativeBHasUI                   null.String              `json:"alternativeBHasUI" db:"alternative_b_has_ui"`
+	AlternativeBPros                    null.String              `json:"alternativeBPros" db:"alternative_b_pros"`
+	AlternativeBCons                    null.String              `json:"alternativeBCons" db:"alternative_b_cons"`
+	AlternativeBCostSavings             null.String              `json:"alternativeBCostSavings" db:"alternative_b_cost_savings"`
+	LifecycleCostHorizon                int                      `json:"lifecycleCostHorizon" db:"lifecycle_cost_horizon"`
+	LifecycleCostPhases                 LifecycleCostPhases      `json:"lifecycleCostPhases" db:"lifecycle_cost_phases"`
+	LifecycleCostFiscalYear             *int                     `json:"lifecycleCostFiscalYear" db:"lifecycle_cost_fiscal_year"`
+	Alternatives                        BusinessCaseAlternatives `json:"alternatives" db:"alternatives"`
+	LifecycleCostLines                  EstimatedLifecycleCosts  `json:"lifecycleCostLines" db:"lifecycle_cost_lines"`
+	CreatedAt                           *time.Time               `json:"createdAt" db:"created_at"`
+	UpdatedAt                           *time.Time               `json:"updatedAt" db:"updated_at"`
+	SubmittedAt                         *time.Time               `json:"submittedAt" db:"submitted_at"`
+	ArchivedAt                          *time.Time               `db:"archived_at"`
+	DeletedAt                           *time.Time               `json:"deletedAt" db:"deleted_at"`
+	DeletedBy                           null.String              `json:"deletedBy" db:"deleted_by"`
+	Version                             int                      `json:"version" db:"version"`
+	InitialSubmittedAt                  *time.Time               `json:"initialSubmittedAt" db:"initial_submitted_at"`
+	LastSubmittedAt                     *time.Time               `json:"lastSubmittedAt" db:"last_submitted_at"`
+	CedarID                             null.String              `json:"cedarId" db:"cedar_id"`
+	// SystemIntakeEUAUserID and Delegates come from the business case's intake for authorization
+	SystemIntakeEUAUserID null.String           `json:"-" db:"system_intake_eua_user_id"`
+	Delegates             SystemIntakeDelegates `json:"-" db:"-"`
 }
 
 // BusinessCases is the model for a list of business cases
 type BusinessCases []BusinessCase
+
+// ApplyLifecycleCostDefaults fills in the lifecycle cost horizon and phases when they aren't configured
+func (b *BusinessCase) ApplyLifecycleCostDefaults() {
+	if b.LifecycleCostHorizon == 0 {
+		b.LifecycleCostHorizon = DefaultLifecycleCostHorizon
+	}
+	if b.LifecycleCostPhases == nil {
+		b.LifecycleCostPhases = DefaultLifecycleCostPhases()
+	}
+}
+
+// LifecycleCostYears returns the years the business case estimates costs for
+func (b *BusinessCase) LifecycleCostYears() []LifecycleCostYear {
+	horizon := b.LifecycleCostHorizon
+	if horizon == 0 {
+		horizon = DefaultLifecycleCostHorizon
+	}
+	years := make([]LifecycleCostYear, horizon)
+	for i := range years {
+		years[i] = LifecycleCostYear(strconv.Itoa(i + 1))
+	}
+	return years
+}
+
+// AllowedLifecycleCostPhases returns the phases the business case estimates costs for
+func (b *BusinessCase) AllowedLifecycleCostPhases() LifecycleCostPhases {
+	if b.LifecycleCostPhases == nil {
+		return DefaultLifecycleCostPhases()
+	}
+	return b.LifecycleCostPhases
+}
+
+// FiscalYear returns the fiscal year a lifecycle cost year falls in,
+// or nil if the business case isn't anchored to a fiscal year
+func (b *BusinessCase) FiscalYear(year LifecycleCostYear) *int {
+	if b.LifecycleCostFiscalYear == nil {
+		return nil
+	}
+	offset, err := strconv.Atoi(string(year))
+	if err != nil {
+		return nil
+	}
+	fiscalYear := *b.LifecycleCostFiscalYear + offset - 1
+	return &fiscalYear
+}
@@ -0,0 +1,358 @@
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// BusinessCaseAlternativeRole represents the role an alternative plays in a business case
+type BusinessCaseAlternativeRole string
+
+const (
+	// BusinessCaseAlternativeRoleASIS captures enum value "AS_IS"
+	BusinessCaseAlternativeRoleASIS BusinessCaseAlternativeRole = "AS_IS"
+	// BusinessCaseAlternativeRolePREFERRED captures enum value "PREFERRED"
+	BusinessCaseAlternativeRolePREFERRED BusinessCaseAlternativeRole = "PREFERRED"
+	// BusinessCaseAlternativeRoleOTHER captures enum value "OTHER"
+	BusinessCaseAlternativeRoleOTHER BusinessCaseAlternativeRole = "OTHER"
+)
+
+// BusinessCaseAlternative is the model for a solution presented in a business case.
+// LegacySolution is the A or B slot an other alternative fills in the fixed shape, if any.
+type BusinessCaseAlternative struct {
+	ID                      uuid.UUID                   `json:"id"`
+	BusinessCaseID          uuid.UUID                   `json:"businessCaseId" db:"business_case_id"`
+	Position                int                         `json:"position"`
+	Role                    BusinessCaseAlternativeRole `json:"role"`
+	Title                   null.String                 `json:"title"`
+	Summary                 null.String                 `json:"summary"`
+	AcquisitionApproach     null.String                 `json:"acquisitionApproach" db:"acquisition_approach"`
+	SecurityIsApproved      null.Bool                   `json:"securityIsApproved" db:"security_is_approved"`
+	SecurityIsBeingReviewed null.String                 `json:"securityIsBeingReviewed" db:"security_is_being_reviewed"`
+	HostingType             null.String                 `json:"hostingType" db:"hosting_type"`
+	HostingLocation         null.String                 `json:"hostingLocation" db:"hosting_location"`
+	HostingCloudServiceType null.String                 `json:"hostingCloudServiceType" db:"hosting_cloud_service_type"`
+	HasUI                   null.String                 `json:"hasUI" db:"has_ui"`
+	Pros                    null.String                 `json:"pros"`
+	Cons                    null.String                 `json:"cons"`
+	CostSavings             null.String                 `json:"costSavings" db:"cost_savings"`
+	LegacySolution          null.String                 `json:"legacySolution" db:"legacy_solution"`
+	CreatedAt               *time.Time                  `json:"createdAt" db:"created_at"`
+	UpdatedAt               *time.Time                  `json:"updatedAt" db:"updated_at"`
+}
+
+// BusinessCaseAlternatives models a list of BusinessCaseAlternative
+type BusinessCaseAlternatives []BusinessCaseAlternative
+
+// Scan implements the sql.Scanner interface
+func (a *BusinessCaseAlternatives) Scan(src interface{}) error {
+	return json.Unmarshal(src.([]byte), a)
+}
+
+// LegacySolutions returns, for each alternative, the solution it occupies in the
+// fixed As Is, Preferred, A and B shape. Other alternatives only occupy the A or B slot
+// they're tagged with, and alternatives without a slot get an empty solution.
+func (a BusinessCaseAlternatives) LegacySolutions() []LifecycleCostSolution {
+	solutions := make([]LifecycleCostSolution, len(a))
+	taken := map[LifecycleCostSolution]bool{}
+	for i, alternative := range a {
+		solution := LifecycleCostSolution("")
+		switch alternative.Role {
+		case BusinessCaseAlternativeRoleASIS:
+			solution = LifecycleCostSolutionASIS
+		case BusinessCaseAlternativeRolePREFERRED:
+			solution = LifecycleCostSolutionPREFERRED
+		case BusinessCaseAlternativeRoleOTHER:
+			switch LifecycleCostSolution(alternative.LegacySolution.ValueOrZero()) {
+			case LifecycleCostSolutionA:
+				solution = LifecycleCostSolutionA
+			case LifecycleCostSolutionB:
+				solution = LifecycleCostSolutionB
+			}
+		}
+		if solution != "" && !taken[solution] {
+			solutions[i] = solution
+			taken[solution] = true
+		}
+	}
+	return solutions
+}
+
+// assignLegacySolutions tags other alternatives with the A or B slot they fill. Existing alternatives
+// keep their slot, and only new ones take a free slot, so removing an alternative never moves
+// another one into its place.
+func (a BusinessCaseAlternatives) assignLegacySolutions(existing BusinessCaseAlternatives) {
+	existingSolutions := map[uuid.UUID]null.String{}
+	for _, alternative := range existing {
+		existingSolutions[alternative.ID] = alternative.LegacySolution
+	}
+	taken := map[string]bool{}
+	added := []int{}
+	for i := range a {
+		if a[i].Role != BusinessCaseAlternativeRoleOTHER {
+			a[i].LegacySolution = null.String{}
+			continue
+		}
+		solution, ok := existingSolutions[a[i].ID]
+		if !ok {
+			added = append(added, i)
+			continue
+		}
+		a[i].LegacySolution = null.String{}
+		if solution.Valid && !taken[solution.String] {
+			a[i].LegacySolution = solution
+			taken[solution.String] = true
+		}
+	}
+	for _, i := range added {
+		requested := a[i].LegacySolution.ValueOrZero()
+		a[i].LegacySolution = null.String{}
+		for _, slot := range []string{requested, string(LifecycleCostSolutionA), string(LifecycleCostSolutionB)} {
+			isSlot := slot == string(LifecycleCostSolutionA) || slot == string(LifecycleCostSolutionB)
+			if isSlot && !taken[slot] {
+				a[i].LegacySolution = null.StringFrom(slot)
+				taken[slot] = true
+				break
+			}
+		}
+	}
+}
+
+// LegacyAlternatives builds the alternatives described by the fixed As Is, Preferred, A and B fields
+func (b *BusinessCase) LegacyAlternatives() BusinessCaseAlternatives {
+	alternatives := BusinessCaseAlternatives{
+		{
+			Role:        BusinessCaseAlternativeRoleASIS,
+			Title:       b.AsIsTitle,
+			Summary:     b.AsIsSummary,
+			Pros:        b.AsIsPros,
+			Cons:        b.AsIsCons,
+			CostSavings: b.AsIsCostSavings,
+		},
+		{
+			Role:                    BusinessCaseAlternativeRolePREFERRED,
+			Title:                   b.PreferredTitle,
+			Summary:                 b.PreferredSummary,
+			AcquisitionApproach:     b.PreferredAcquisitionApproach,
+			SecurityIsApproved:      b.PreferredSecurityIsApproved,
+			SecurityIsBeingReviewed: b.PreferredSecurityIsBeingReviewed,
+			HostingType:             b.PreferredHostingType,
+			HostingLocation:         b.PreferredHostingLocation,
+			HostingCloudServiceType: b.PreferredHostingCloudServiceType,
+			HasUI:                   b.PreferredHasUI,
+			Pros:                    b.PreferredPros,
+			Cons:                    b.PreferredCons,
+			CostSavings:             b.PreferredCostSavings,
+		},
+		{
+			Role:                    BusinessCaseAlternativeRoleOTHER,
+			LegacySolution:          null.StringFrom(string(LifecycleCostSolutionA)),
+			Title:                   b.AlternativeATitle,
+			Summary:                 b.AlternativeASummary,
+			AcquisitionApproach:     b.AlternativeAAcquisitionApproach,
+			SecurityIsApproved:      b.AlternativeASecurityIsApproved,
+			SecurityIsBeingReviewed: b.AlternativeASecurityIsBeingReviewed,
+			HostingType:             b.AlternativeAHostingType,
+			HostingLocation:         b.AlternativeAHostingLocation,
+			HostingCloudServiceType: b.AlternativeAHostingCloudServiceType,
+			HasUI:                   b.AlternativeAHasUI,
+			Pros:                    b.AlternativeAPros,
+			Cons:                    b.AlternativeACons,
+			CostSavings:             b.AlternativeACostSavings,
+		},
+	}
+	alternativeB := BusinessCaseAlternative{
+		Role:                    BusinessCaseAlternativeRoleOTHER,
+		LegacySolution:          null.StringFrom(string(LifecycleCostSolutionB)),
+		Title:                   b.AlternativeBTitle,
+		Summary:                 b.AlternativeBSummary,
+		AcquisitionApproach:     b.AlternativeBAcquisitionApproach,
+		SecurityIsApproved:      b.AlternativeBSecurityIsApproved,
+		SecurityIsBeingReviewed: b.AlternativeBSecurityIsBeingReviewed,
+		HostingType:             b.AlternativeBHostingType,
+		HostingLocation:         b.AlternativeBHostingLocation,
+		HostingCloudServiceType: b.AlternativeBHostingCloudServiceType,
+		HasUI:                   b.AlternativeBHasUI,
+		Pros:                    b.AlternativeBPros,
+		Cons:                    b.AlternativeBCons,
+		CostSavings:             b.AlternativeBCostSavings,
+	}
+	if alternativeB.hasContent() || b.hasCostLinesFor(LifecycleCostSolutionB) {
+		alternatives = append(alternatives, alternativeB)
+	}
+	for i := range alternatives {
+		alternatives[i].Position = i
+	}
+	return alternatives
+}
+
+// ChangeKey identifies an alternative by its ID, which is kept across updates
+func (a BusinessCaseAlternative) ChangeKey() string {
+	return a.ID.String()
+}
+
+func (a BusinessCaseAlternative) hasContent() bool {
+	return a.Title.Valid ||
+		a.Summary.Valid ||
+		a.AcquisitionApproach.Valid ||
+		a.Pros.Valid ||
+		a.Cons.Valid ||
+		a.CostSavings.Valid
+}
+
+func (b *BusinessCase) hasCostLinesFor(solution LifecycleCostSolution) bool {
+	for _, line := range b.LifecycleCostLines {
+		if line.AlternativeID == nil && line.Solution == solution {
+			return true
+		}
+	}
+	return false
+}
+
+// SetLegacyFieldsFromAlternatives fills the fixed As Is, Preferred, A and B fields
+// from the alternatives that occupy those slots
+func (b *BusinessCase) SetLegacyFieldsFromAlternatives() {
+	legacy := map[LifecycleCostSolution]BusinessCaseAlternative{}
+	for i, solution := range b.Alternatives.LegacySolutions() {
+		if solution != "" {
+			legacy[solution] = b.Alternatives[i]
+		}
+	}
+
+	asIs := legacy[LifecycleCostSolutionASIS]
+	b.AsIsTitle = asIs.Title
+	b.AsIsSummary = asIs.Summary
+	b.AsIsPros = asIs.Pros
+	b.AsIsCons = asIs.Cons
+	b.AsIsCostSavings = asIs.CostSavings
+
+	preferred := legacy[LifecycleCostSolutionPREFERRED]
+	b.PreferredTitle = preferred.Title
+	b.PreferredSummary = preferred.Summary
+	b.PreferredAcquisitionApproach = preferred.AcquisitionApproach
+	b.PreferredSecurityIsApproved = preferred.SecurityIsApproved
+	b.PreferredSecurityIsBeingReviewed = preferred.SecurityIsBeingReviewed
+	b.PreferredHostingType = preferred.HostingType
+	b.PreferredHostingLocation = preferred.HostingLocation
+	b.PreferredHostingCloudServiceType = preferred.HostingCloudServiceType
+	b.PreferredHasUI = preferred.HasUI
+	b.PreferredPros = preferred.Pros
+	b.PreferredCons = preferred.Cons
+	b.PreferredCostSavings = preferred.CostSavings
+
+	alternativeA := legacy[LifecycleCostSolutionA]
+	b.AlternativeATitle = alternativeA.Title
+	b.AlternativeASummary = alternativeA.Summary
+	b.AlternativeAAcquisitionApproach = alternativeA.AcquisitionApproach
+	b.AlternativeASecurityIsApproved = alternativeA.SecurityIsApproved
+	b.AlternativeASecurityIsBeingReviewed = alternativeA.SecurityIsBeingReviewed
+	b.AlternativeAHostingType = alternativeA.HostingType
+	b.AlternativeAHostingLocation = alternativeA.HostingLocation
+	b.AlternativeAHostingCloudServiceType = alternativeA.HostingCloudServiceType
+	b.AlternativeAHasUI = alternativeA.HasUI
+	b.AlternativeAPros = alternativeA.Pros
+	b.AlternativeACons = alternativeA.Cons
+	b.AlternativeACostSavings = alternativeA.CostSavings
+
+	alternativeB := legacy[LifecycleCostSolutionB]
+	b.AlternativeBTitle = alternativeB.Title
+	b.AlternativeBSummary = alternativeB.Summary
+	b.AlternativeBAcquisitionApproach = alternativeB.AcquisitionApproach
+	b.AlternativeBSecurityIsApproved = alternativeB.SecurityIsApproved
+	b.AlternativeBSecurityIsBeingReviewed = alternativeB.SecurityIsBeingReviewed
+	b.AlternativeBHostingType = alternativeB.HostingType
+	b.AlternativeBHostingLocation = alternativeB.HostingLocation
+	b.AlternativeBHostingCloudServiceType = alternativeB.HostingCloudServiceType
+	b.AlternativeBHasUI = alternativeB.HasUI
+	b.AlternativeBPros = alternativeB.Pros
+	b.AlternativeBCons = alternativeB.Cons
+	b.AlternativeBCostSavings = alternativeB.CostSavings
+}
+
+// SyncAlternatives reconciles the alternatives list with the fixed As Is, Preferred, A and B
+// fields and links lifecycle cost lines to alternatives before the business case is saved.
+// When no alternatives are given, the fixed fields are applied on top of the existing alternatives,
+// so requests in the fixed shape keep alternative IDs and any additional alternatives.
+func (b *BusinessCase) SyncAlternatives(existing BusinessCaseAlternatives) {
+	if len(b.Alternatives) == 0 {
+		b.Alternatives = mergeLegacyAlternatives(existing, b.LegacyAlternatives())
+	}
+	sort.SliceStable(b.Alternatives, func(i, j int) bool {
+		return b.Alternatives[i].Position < b.Alternatives[j].Position
+	})
+	for i := range b.Alternatives {
+		if b.Alternatives[i].ID == uuid.Nil {
+			b.Alternatives[i].ID = uuid.New()
+		}
+		b.Alternatives[i].BusinessCaseID = b.ID
+	}
+	b.Alternatives.assignLegacySolutions(existing)
+	b.SetLegacyFieldsFromAlternatives()
+
+	solutions := b.Alternatives.LegacySolutions()
+	for i, line := range b.LifecycleCostLines {
+		if line.AlternativeID == nil {
+			for j, solution := range solutions {
+				if solution != "" && solution == line.Solution {
+					id := b.Alternatives[j].ID
+					b.LifecycleCostLines[i].AlternativeID = &id
+					break
+				}
+			}
+			continue
+		}
+		b.LifecycleCostLines[i].Solution = ""
+		for j, alternative := range b.Alternatives {
+			if alternative.ID == *line.AlternativeID {
+				b.LifecycleCostLines[i].Solution = solutions[j]
+				break
+			}
+		}
+	}
+}
+
+// mergeLegacyAlternatives replaces the existing alternatives in the fixed slots with the ones
+// built from the fixed fields, keeping their IDs and positions along with any other alternatives
+func mergeLegacyAlternatives(existing BusinessCaseAlternatives, legacy BusinessCaseAlternatives) BusinessCaseAlternatives {
+	if len(existing) == 0 {
+		return legacy
+	}
+	legacyBySolution := map[LifecycleCostSolution]BusinessCaseAlternative{}
+	for i, solution := range legacy.LegacySolutions() {
+		legacyBySolution[solution] = legacy[i]
+	}
+
+	merged := BusinessCaseAlternatives{}
+	nextPosition := 0
+	for i, solution := range existing.LegacySolutions() {
+		if existing[i].Position >= nextPosition {
+			nextPosition = existing[i].Position + 1
+		}
+		if solution == "" {
+			merged = append(merged, existing[i])
+			continue
+		}
+		replacement, ok := legacyBySolution[solution]
+		if !ok {
+			continue
+		}
+		delete(legacyBySolution, solution)
+		replacement.ID = existing[i].ID
+		replacement.Position = existing[i].Position
+		replacement.CreatedAt = existing[i].CreatedAt
+		merged = append(merged, replacement)
+	}
+	for i, solution := range legacy.LegacySolutions() {
+		if _, ok := legacyBySolution[solution]; ok {
+			alternative := legacy[i]
+			alternative.Position = nextPosition
+			nextPosition++
+			merged = append(merged, alternative)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+func (s ModelTestSuite) TestBusinessCaseSyncAlternatives() {
+	s.Run("builds alternatives from the fixed fields", func() {
+		businessCase := BusinessCase{
+			ID:                uuid.New(),
+			AsIsTitle:         null.StringFrom("As Is"),
+			PreferredTitle:    null.StringFrom("Preferred"),
+			AlternativeATitle: null.StringFrom("A"),
+			LifecycleCostLines: EstimatedLifecycleCosts{
+				{Solution: LifecycleCostSolutionPREFERRED, Year: LifecycleCostYear1},
+			},
+		}
+
+		businessCase.SyncAlternatives(nil)
+
+		s.Len(businessCase.Alternatives, 3)
+		s.Equal(BusinessCaseAlternativeRoleASIS, businessCase.Alternatives[0].Role)
+		s.Equal(null.StringFrom("Preferred"), businessCase.Alternatives[1].Title)
+		s.Equal(businessCase.ID, businessCase.Alternatives[2].BusinessCaseID)
+		s.Equal(&businessCase.Alternatives[1].ID, businessCase.LifecycleCostLines[0].AlternativeID)
+	})
+
+	s.Run("keeps existing alternative IDs and extra alternatives", func() {
+		existing := BusinessCaseAlternatives{
+			{ID: uuid.New(), Position: 0, Role: BusinessCaseAlternativeRoleASIS},
+			{ID: uuid.New(), Position: 1, Role: BusinessCaseAlternativeRolePREFERRED},
+			{ID: uuid.New(), Position: 2, Role: BusinessCaseAlternativeRoleOTHER, LegacySolution: null.StringFrom("A")},
+			{ID: uuid.New(), Position: 3, Role: BusinessCaseAlternativeRoleOTHER, LegacySolution: null.StringFrom("B")},
+			{ID: uuid.New(), Position: 4, Role: BusinessCaseAlternativeRoleOTHER, Title: null.StringFrom("C")},
+		}
+		businessCase := BusinessCase{
+			ID:                uuid.New(),
+			AsIsTitle:         null.StringFrom("As Is"),
+			PreferredTitle:    null.StringFrom("Preferred"),
+			AlternativeATitle: null.StringFrom("A"),
+			AlternativeBTitle: null.StringFrom("B"),
+		}
+
+		businessCase.SyncAlternatives(existing)
+
+		s.Len(businessCase.Alternatives, 5)
+		for i := range existing {
+			s.Equal(existing[i].ID, businessCase.Alternatives[i].ID)
+		}
+		s.Equal(null.StringFrom("B"), businessCase.Alternatives[3].Title)
+		s.Equal(null.StringFrom("C"), businessCase.Alternatives[4].Title)
+		s.False(businessCase.Alternatives[4].LegacySolution.Valid)
+	})
+
+	s.Run("doesn't move another alternative into a removed slot", func() {
+		alternativeAID := uuid.New()
+		extraID := uuid.New()
+		existing := BusinessCaseAlternatives{
+			{ID: uuid.New(), Position: 0, Role: BusinessCaseAlternativeRoleASIS},
+			{ID: uuid.New(), Position: 1, Role: BusinessCaseAlternativeRolePREFERRED},
+			{ID: alternativeAID, Position: 2, Role: BusinessCaseAlternativeRoleOTHER, LegacySolution: null.StringFrom("A")},
+			{ID: extraID, Position: 4, Role: BusinessCaseAlternativeRoleOTHER, Title: null.StringFrom("C")},
+		}
+		businessCase := BusinessCase{
+			Alternatives: BusinessCaseAlternatives{
+				{ID: existing[0].ID, Position: 0, Role: BusinessCaseAlternativeRoleASIS, Title: null.StringFrom("As Is")},
+				{ID: existing[1].ID, Position: 1, Role: BusinessCaseAlternativeRolePREFERRED, Title: null.StringFrom("Preferred")},
+				{ID: alternativeAID, Position: 2, Role: BusinessCaseAlternativeRoleOTHER, Title: null.StringFrom("A")},
+				{ID: extraID, Position: 4, Role: BusinessCaseAlternativeRoleOTHER, Title: null.StringFrom("C")},
+			},
+			LifecycleCostLines: EstimatedLifecycleCosts{
+				{AlternativeID: &extraID, Year: LifecycleCostYear1},
+			},
+		}
+
+		businessCase.SyncAlternatives(existing)
+
+		s.Equal(null.StringFrom("A"), businessCase.AlternativeATitle)
+		s.False(businessCase.AlternativeBTitle.Valid)
+		s.False(businessCase.Alternatives[3].LegacySolution.Valid)
+		s.Equal(LifecycleCostSolution(""), businessCase.LifecycleCostLines[0].Solution)
+
+		legacyUpdate := BusinessCase{
+			AsIsTitle:         null.StringFrom("As Is"),
+			PreferredTitle:    null.StringFrom("Preferred"),
+			AlternativeATitle: null.StringFrom("A"),
+			AlternativeBTitle: null.StringFrom("New B"),
+		}
+
+		legacyUpdate.SyncAlternatives(existing)
+
+		s.Len(legacyUpdate.Alternatives, 5)
+		s.Equal(null.StringFrom("C"), legacyUpdate.Alternatives[3].Title)
+		s.False(legacyUpdate.Alternatives[3].LegacySolution.Valid)
+		s.Equal(null.StringFrom("New B"), legacyUpdate.Alternatives[4].Title)
+		s.Equal(null.StringFrom("B"), legacyUpdate.Alternatives[4].LegacySolution)
+		s.Equal(5, legacyUpdate.Alternatives[4].Position)
+	})
+
+	s.Run("fills the fixed fields and cost line solutions from alternatives", func() {
+		otherID := uuid.New()
+		extraID := uuid.New()
+		businessCase := BusinessCase{
+			Alternatives: BusinessCaseAlternatives{
+				{Position: 0, Role: BusinessCaseAlternativeRoleASIS, Title: null.StringFrom("As Is")},
+				{Position: 1, Role: BusinessCaseAlternativeRolePREFERRED, Title: null.StringFrom("Preferred")},
+				{ID: otherID, Position: 2, Role: BusinessCaseAlternativeRoleOTHER, Title: null.StringFrom("A")},
+				{Position: 3, Role: BusinessCaseAlternativeRoleOTHER, Title: null.StringFrom("B")},
+				{ID: extraID, Position: 4, Role: BusinessCaseAlternativeRoleOTHER, Title: null.StringFrom("C")},
+			},
+			LifecycleCostLines: EstimatedLifecycleCosts{
+				{AlternativeID: &otherID, Year: LifecycleCostYear1},
+				{AlternativeID: &extraID, Year: LifecycleCostYear1},
+			},
+		}
+
+		businessCase.SyncAlternatives(nil)
+
+		s.Equal(null.StringFrom("A"), businessCase.AlternativeATitle)
+		s.Equal(null.StringFrom("B"), businessCase.AlternativeBTitle)
+		s.Equal(LifecycleCostSolutionA, businessCase.LifecycleCostLines[0].Solution)
+		s.Equal(LifecycleCostSolution(""), businessCase.LifecycleCostLines[1].Solution)
+	})
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// CedarReconciliationResult is the outcome of reconciling a single system intake with CEDAR
+type CedarReconciliationResult struct {
+	SystemIntakeID uuid.UUID          `json:"systemIntakeId"`
+	ProjectName    string             `json:"projectName"`
+	AlfabetID      string             `json:"alfabetId,omitempty"`
+	EASiStatus     SystemIntakeStatus `json:"easiStatus"`
+	CEDARStatus    string             `json:"cedarStatus,omitempty"`
+	Error          string             `json:"error,omitempty"`
+}
+
+// CedarReconciliation reports what reconciling system intakes with CEDAR found and did
+type CedarReconciliation struct {
+	DryRun              bool                        `json:"dryRun"`
+	Unlinked            []CedarReconciliationResult `json:"unlinked"`
+	Submitted           []CedarReconciliationResult `json:"submitted"`
+	SubmitFailures      []CedarReconciliationResult `json:"submitFailures"`
+	StatusMismatches    []CedarReconciliationResult `json:"statusMismatches"`
+	StatusCheckFailures []CedarReconciliationResult `json:"statusCheckFailures"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// CedarSystemIntakeUpdate is a pending update of a system intake that still needs to be sent to CEDAR.
+// The generation goes up every time the update is queued again,
+// so a retry only removes the update it sent.
+type CedarSystemIntakeUpdate struct {
+	ID             uuid.UUID   `json:"id"`
+	SystemIntakeID uuid.UUID   `json:"systemIntakeId" db:"system_intake_id"`
+	Generation     int         `json:"generation"`
+	Attempts       int         `json:"attempts"`
+	LastError      null.String `json:"lastError" db:"last_error"`
+	NextAttemptAt  *time.Time  `json:"nextAttemptAt" db:"next_attempt_at"`
+	CreatedAt      *time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt      *time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// CedarSystemIntakeUpdates models a list of CedarSystemIntakeUpdate
+type CedarSystemIntakeUpdates []CedarSystemIntakeUpdate
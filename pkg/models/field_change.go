@@ -0,0 +1,135 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// FieldChange is the value of one field before and after an update
+type FieldChange struct {
+	ID           uuid.UUID      `json:"id"`
+	ResourceType string         `json:"resourceType" db:"resource_type"`
+	ResourceID   uuid.UUID      `json:"resourceId" db:"resource_id"`
+	Field        string         `json:"field"`
+	OldValue     types.JSONText `json:"oldValue" db:"old_value"`
+	NewValue     types.JSONText `json:"newValue" db:"new_value"`
+	EUAUserID    string         `json:"euaUserId" db:"eua_user_id"`
+	ChangedAt    *time.Time     `json:"changedAt" db:"changed_at"`
+}
+
+// FieldChanges is a list of field changes
+type FieldChanges []FieldChange
+
+// ChangeKeyer is implemented by list items that are compared one at a time in a change history,
+// rather than as a whole list
+type ChangeKeyer interface {
+	// ChangeKey identifies the item across updates
+	ChangeKey() string
+}
+
+var (
+	changeKeyerType   = reflect.TypeOf((*ChangeKeyer)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// DiffFields compares two values of the same struct type, returning a change for each field
+// whose JSON differs. Fields are named by their JSON names, and ChangeKeyer list items by
+// their key, e.g. "lifecycleCostLines[Preferred/Development/1].cost".
+// Fields named in ignored are skipped at every level.
+func DiffFields(before interface{}, after interface{}, ignored ...string) (FieldChanges, error) {
+	skip := map[string]bool{}
+	for _, name := range ignored {
+		skip[name] = true
+	}
+	oldValues := map[string]types.JSONText{}
+	if err := flattenFields("", reflect.ValueOf(before), skip, oldValues); err != nil {
+		return nil, err
+	}
+	newValues := map[string]types.JSONText{}
+	if err := flattenFields("", reflect.ValueOf(after), skip, newValues); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]bool{}
+	for field := range oldValues {
+		fields[field] = true
+	}
+	for field := range newValues {
+		fields[field] = true
+	}
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	changes := FieldChanges{}
+	for _, field := range names {
+		oldValue, newValue := jsonOrNull(oldValues[field]), jsonOrNull(newValues[field])
+		if string(oldValue) != string(newValue) {
+			changes = append(changes, FieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	return changes, nil
+}
+
+func jsonOrNull(value types.JSONText) types.JSONText {
+	if value == nil {
+		return types.JSONText("null")
+	}
+	return value
+}
+
+func flattenFields(prefix string, value reflect.Value, skip map[string]bool, values map[string]types.JSONText) error {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.PkgPath != "" || name == "-" || name == "" || skip[name] {
+			continue
+		}
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		fieldValue := value.Field(i)
+
+		if field.Type.Kind() == reflect.Slice && field.Type.Elem().Implements(changeKeyerType) {
+			for j := 0; j < fieldValue.Len(); j++ {
+				item := fieldValue.Index(j)
+				itemPath := fmt.Sprintf("%s[%s]", path, item.Interface().(ChangeKeyer).ChangeKey())
+				if err := flattenFields(itemPath, item, skip, values); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct && !field.Type.Implements(jsonMarshalerType) &&
+			!reflect.PtrTo(field.Type).Implements(jsonMarshalerType) && field.Type != reflect.TypeOf(time.Time{}) {
+			if err := flattenFields(path, fieldValue, skip, values); err != nil {
+				return err
+			}
+			continue
+		}
+
+		encoded, err := json.Marshal(fieldValue.Interface())
+		if err != nil {
+			return err
+		}
+		values[path] = encoded
+	}
+	return nil
+}
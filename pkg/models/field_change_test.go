@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx/types"
+)
+
+func (s ModelTestSuite) TestDiffFields() {
+	s.Run("records changed fields by their JSON names", func() {
+		grtDate := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+		before := &SystemIntake{
+			BusinessOwner: null.StringFrom("Ada"),
+			ProjectName:   null.StringFrom("Easy Access"),
+		}
+		after := &SystemIntake{
+			BusinessOwner: null.StringFrom("Grace"),
+			ProjectName:   null.StringFrom("Easy Access"),
+			GRTDate:       &grtDate,
+		}
+
+		changes, err := DiffFields(before, after)
+
+		s.NoError(err)
+		s.Equal(FieldChanges{
+			{Field: "businessOwner", OldValue: types.JSONText(`"Ada"`), NewValue: types.JSONText(`"Grace"`)},
+			{Field: "grtDate", OldValue: types.JSONText(`null`), NewValue: types.JSONText(`"2021-03-01T00:00:00Z"`)},
+		}, changes)
+	})
+
+	s.Run("skips ignored fields", func() {
+		now := time.Now()
+		changes, err := DiffFields(&SystemIntake{}, &SystemIntake{UpdatedAt: &now}, "updatedAt")
+
+		s.NoError(err)
+		s.Empty(changes)
+	})
+
+	s.Run("compares keyed list items one at a time", func() {
+		development := LifecycleCostPhaseDEVELOPMENT
+		oldCost, newCost := 100, 200
+		before := &BusinessCase{LifecycleCostLines: EstimatedLifecycleCosts{
+			{Solution: LifecycleCostSolutionPREFERRED, Phase: &development, Year: LifecycleCostYear1, Cost: &oldCost},
+			{Solution: LifecycleCostSolutionPREFERRED, Phase: &development, Year: LifecycleCostYear2, Cost: &oldCost},
+		}}
+		after := &BusinessCase{LifecycleCostLines: EstimatedLifecycleCosts{
+			{Solution: LifecycleCostSolutionPREFERRED, Phase: &development, Year: LifecycleCostYear1, Cost: &newCost},
+			{Solution: LifecycleCostSolutionPREFERRED, Phase: &development, Year: LifecycleCostYear2, Cost: &oldCost},
+		}}
+
+		changes, err := DiffFields(before, after, "id", "business_case", "alternative_id", "solution", "phase", "year")
+
+		s.NoError(err)
+		s.Equal(FieldChanges{
+			{
+				Field:    "lifecycleCostLines[Preferred/Development/1].cost",
+				OldValue: types.JSONText(`100`),
+				NewValue: types.JSONText(`200`),
+			},
+		}, changes)
+	})
+
+	s.Run("records added and removed list items", func() {
+		cost := 100
+		before := &BusinessCase{LifecycleCostLines: EstimatedLifecycleCosts{
+			{Solution: LifecycleCostSolutionASIS, Year: LifecycleCostYear1, Cost: &cost},
+		}}
+		after := &BusinessCase{LifecycleCostLines: EstimatedLifecycleCosts{
+			{Solution: LifecycleCostSolutionB, Year: LifecycleCostYear1, Cost: &cost},
+		}}
+
+		changes, err := DiffFields(before, after, "id", "business_case", "alternative_id", "solution", "phase", "year")
+
+		s.NoError(err)
+		s.Len(changes, 2)
+		s.Equal("lifecycleCostLines[As Is//1].cost", changes[0].Field)
+		s.Equal(types.JSONText(`null`), changes[0].NewValue)
+		s.Equal("lifecycleCostLines[B//1].cost", changes[1].Field)
+		s.Equal(types.JSONText(`null`), changes[1].OldValue)
+	})
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guregu/null"
+)
+
+// MarshalNullString allows nullable strings to be marshalled by graphql
+func MarshalNullString(s null.String) graphql.Marshaler {
+	if !s.Valid {
+		return graphql.Null
+	}
+	return graphql.MarshalString(s.String)
+}
+
+// UnmarshalNullString allows nullable strings to be unmarshalled by graphql
+func UnmarshalNullString(v interface{}) (null.String, error) {
+	if v == nil {
+		return null.String{}, nil
+	}
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return null.String{}, err
+	}
+	return null.StringFrom(s), nil
+}
+
+// MarshalNullBool allows nullable booleans to be marshalled by graphql
+func MarshalNullBool(b null.Bool) graphql.Marshaler {
+	if !b.Valid {
+		return graphql.Null
+	}
+	return graphql.MarshalBoolean(b.Bool)
+}
+
+// UnmarshalNullBool allows nullable booleans to be unmarshalled by graphql
+func UnmarshalNullBool(v interface{}) (null.Bool, error) {
+	if v == nil {
+		return null.Bool{}, nil
+	}
+	b, err := graphql.UnmarshalBoolean(v)
+	if err != nil {
+		return null.Bool{}, err
+	}
+	return null.BoolFrom(b), nil
+}
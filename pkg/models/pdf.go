@@ -0,0 +1,7 @@
+package models
+
+// PDF is a rendered PDF document
+type PDF struct {
+	Filename string
+	Content  []byte
+}
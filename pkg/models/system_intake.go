@@ -76,59 +76,75 @@ const (
 
 // SystemIntake is the model for the system intake form
 type SystemIntake struct {
-	ID                          uuid.UUID               `json:"id"`
-	EUAUserID                   null.String             `json:"euaUserId" db:"eua_user_id"`
-	Status                      SystemIntakeStatus      `json:"status"`
-	RequestType                 SystemIntakeRequestType `json:"requestType" db:"request_type"`
-	Requester                   string                  `json:"requester"`
-	Component                   null.String             `json:"component"`
-	BusinessOwner               null.String             `json:"businessOwner" db:"business_owner"`
-	BusinessOwnerComponent      null.String             `json:"businessOwnerComponent" db:"business_owner_component"`
-	ProductManager              null.String             `json:"productManager" db:"product_manager"`
-	ProductManagerComponent     null.String             `json:"productManagerComponent" db:"product_manager_component"`
-	ISSO                        null.String             `json:"isso"`
-	ISSOName                    null.String             `json:"issoName" db:"isso_name"`
-	TRBCollaborator             null.String             `json:"trbCollaborator" db:"trb_collaborator"`
-	TRBCollaboratorName         null.String             `json:"trbCollaboratorName" db:"trb_collaborator_name"`
-	OITSecurityCollaborator     null.String             `json:"oitSecurityCollaborator" db:"oit_security_collaborator"`
-	OITSecurityCollaboratorName null.String             `json:"oitSecurityCollaboratorName" db:"oit_security_collaborator_name"`
-	EACollaborator              null.String             `json:"eaCollaborator" db:"ea_collaborator"`
-	EACollaboratorName          null.String             `json:"eaCollaboratorName" db:"ea_collaborator_name"`
-	ProjectName                 null.String             `json:"projectName" db:"project_name"`
-	ProjectAcronym              null.String             `json:"projectAcronym" db:"project_acronym"`
-	ExistingFunding             null.Bool               `json:"existingFunding" db:"existing_funding"`
-	FundingSource               null.String             `json:"fundingSource" db:"funding_source"`
-	FundingNumber               null.String             `json:"fundingNumber" db:"funding_number"`
-	BusinessNeed                null.String             `json:"businessNeed" db:"business_need"`
-	Solution                    null.String             `json:"solution"`
-	ProcessStatus               null.String             `json:"processStatus" db:"process_status"`
-	EASupportRequest            null.Bool               `json:"eaSupportRequest" db:"ea_support_request"`
-	ExistingContract            null.String             `json:"existingContract" db:"existing_contract"`
-	CostIncrease                null.String             `json:"costIncrease" db:"cost_increase"`
-	CostIncreaseAmount          null.String             `json:"costIncreaseAmount" db:"cost_increase_amount"`
-	Contractor                  null.String             `json:"contractor" db:"contractor"`
-	ContractVehicle             null.String             `json:"contractVehicle" db:"contract_vehicle"`
-	ContractStartMonth          null.String             `json:"contractStartMonth" db:"contract_start_month"`
-	ContractStartYear           null.String             `json:"contractStartYear" db:"contract_start_year"`
-	ContractEndMonth            null.String             `json:"contractEndMonth" db:"contract_end_month"`
-	ContractEndYear             null.String             `json:"contractEndYear" db:"contract_end_year"`
-	CreatedAt                   *time.Time              `json:"createdAt" db:"created_at"`
-	UpdatedAt                   *time.Time              `json:"updatedAt" db:"updated_at"`
-	SubmittedAt                 *time.Time              `json:"submittedAt" db:"submitted_at"`
-	DecidedAt                   *time.Time              `json:"decidedAt" db:"decided_at"`
-	ArchivedAt                  *time.Time              `json:"archivedAt" db:"archived_at"`
-	GRTDate                     *time.Time              `json:"grtDate" db:"grt_date"`
-	GRBDate                     *time.Time              `json:"grbDate" db:"grb_date"`
-	AlfabetID                   null.String             `json:"alfabetID" db:"alfabet_id"`
-	GrtReviewEmailBody          null.String             `json:"grtReviewEmailBody" db:"grt_review_email_body"`
-	RequesterEmailAddress       null.String             `json:"requesterEmailAddress" db:"requester_email_address"`
-	BusinessCaseID              *uuid.UUID              `json:"businessCase" db:"business_case_id"`
-	LifecycleID                 null.String             `json:"lcid" db:"lcid"`
-	LifecycleExpiresAt          *time.Time              `json:"lcidExpiresAt" db:"lcid_expires_at"`
-	LifecycleScope              null.String             `json:"lcidScope" db:"lcid_scope"`
-	LifecycleNextSteps          null.String             `json:"lifecycleNextSteps" db:"lcid_next_steps"`
-	DecisionNextSteps           null.String             `json:"decisionNextSteps" db:"decision_next_steps"`
-	RejectionReason             null.String             `json:"rejectionReason" db:"rejection_reason"`
+	ID                               uuid.UUID                        `json:"id"`
+	EUAUserID                        null.String                      `json:"euaUserId" db:"eua_user_id"`
+	Status                           SystemIntakeStatus               `json:"status"`
+	RequestType                      SystemIntakeRequestType          `json:"requestType" db:"request_type"`
+	Requester                        string                           `json:"requester"`
+	Component                        null.String                      `json:"component"`
+	BusinessOwner                    null.String                      `json:"businessOwner" db:"business_owner"`
+	BusinessOwnerComponent           null.String                      `json:"businessOwnerComponent" db:"business_owner_component"`
+	BusinessOwnerEUAUserID           null.String                      `json:"businessOwnerEuaUserId" db:"business_owner_eua_id"`
+	ProductManager                   null.String                      `json:"productManager" db:"product_manager"`
+	ProductManagerComponent          null.String                      `json:"productManagerComponent" db:"product_manager_component"`
+	ISSO                             null.String                      `json:"isso"`
+	ISSOName                         null.String                      `json:"issoName" db:"isso_name"`
+	ISSOEUAUserID                    null.String                      `json:"issoEuaUserId" db:"isso_eua_id"`
+	ISSOEmail                        null.String                      `json:"issoEmail" db:"isso_email"`
+	TRBCollaborator                  null.String                      `json:"trbCollaborator" db:"trb_collaborator"`
+	TRBCollaboratorName              null.String                      `json:"trbCollaboratorName" db:"trb_collaborator_name"`
+	TRBCollaboratorEUAUserID         null.String                      `json:"trbCollaboratorEuaUserId" db:"trb_collaborator_eua_id"`
+	TRBCollaboratorEmail             null.String                      `json:"trbCollaboratorEmail" db:"trb_collaborator_email"`
+	OITSecurityCollaborator          null.String                      `json:"oitSecurityCollaborator" db:"oit_security_collaborator"`
+	OITSecurityCollaboratorName      null.String                      `json:"oitSecurityCollaboratorName" db:"oit_security_collaborator_name"`
+	OITSecurityCollaboratorEUAUserID null.String                      `json:"oitSecurityCollaboratorEuaUserId" db:"oit_security_collaborator_eua_id"`
+	OITSecurityCollaboratorEmail     null.String                      `json:"oitSecurityCollaboratorEmail" db:"oit_security_collaborator_email"`
+	EACollaborator                   null.String                      `json:"eaCollaborator" db:"ea_collaborator"`
+	EACollaboratorName               null.String                      `json:"eaCollaboratorName" db:"ea_collaborator_name"`
+	EACollaboratorEUAUserID          null.String                      `json:"eaCollaboratorEuaUserId" db:"ea_collaborator_eua_id"`
+	EACollaboratorEmail              null.String                      `json:"eaCollaboratorEmail" db:"ea_collaborator_email"`
+	CollaboratorWarnings             SystemIntakeCollaboratorWarnings `json:"collaboratorWarnings" db:"collaborator_warnings"`
+	ProjectName                      null.String                      `json:"projectName" db:"project_name"`
+	ProjectAcronym                   null.String                      `json:"projectAcronym" db:"project_acronym"`
+	ExistingFunding                  null.Bool                        `json:"existingFunding" db:"existing_funding"`
+	FundingSource                    null.String                      `json:"fundingSource" db:"funding_source"`
+	FundingNumber                    null.String                      `json:"fundingNumber" db:"funding_number"`
+	FundingSources                   SystemIntakeFundingSources       `json:"fundingSources" db:"funding_sources"`
+	Documents                        SystemIntakeDocuments            `json:"documents" db:"documents"`
+	BusinessNeed                     null.String                      `json:"businessNeed" db:"business_need"`
+	Solution                         null.String                      `json:"solution"`
+	ProcessStatus                    null.String                      `json:"processStatus" db:"process_status"`
+	EASupportRequest                 null.Bool                        `json:"eaSupportRequest" db:"ea_support_request"`
+	ExistingContract                 null.String                      `json:"existingContract" db:"existing_contract"`
+	CostIncrease                     null.String                      `json:"costIncrease" db:"cost_increase"`
+	CostIncreaseAmount               null.String                      `json:"costIncreaseAmount" db:"cost_increase_amount"`
+	Contractor                       null.String                      `json:"contractor" db:"contractor"`
+	ContractVehicle                  null.String                      `json:"contractVehicle" db:"contract_vehicle"`
+	ContractStartMonth               null.String                      `json:"contractStartMonth" db:"contract_start_month"`
+	ContractStartYear                null.String                      `json:"contractStartYear" db:"contract_start_year"`
+	ContractEndMonth                 null.String                      `json:"contractEndMonth" db:"contract_end_month"`
+	ContractEndYear                  null.String                      `json:"contractEndYear" db:"contract_end_year"`
+	CreatedAt                        *time.Time                       `json:"createdAt" db:"created_at"`
+	UpdatedAt                        *time.Time                       `json:"updatedAt" db:"updated_at"`
+	SubmittedAt                      *time.Time                       `json:"submittedAt" db:"submitted_at"`
+	DecidedAt                        *time.Time                       `json:"decidedAt" db:"decided_at"`
+	ArchivedAt                       *time.Time                       `json:"archivedAt" db:"archived_at"`
+	DeletedAt                        *time.Time                       `json:"deletedAt" db:"deleted_at"`
+	DeletedBy                        null.String                      `json:"deletedBy" db:"deleted_by"`
+	Version                          int                              `json:"version" db:"version"`
+	GRTDate                          *time.Time                       `json:"grtDate" db:"grt_date"`
+	GRBDate                          *time.Time                       `json:"grbDate" db:"grb_date"`
+	AlfabetID                        null.String                      `json:"alfabetID" db:"alfabet_id"`
+	GrtReviewEmailBody               null.String                      `json:"grtReviewEmailBody" db:"grt_review_email_body"`
+	RequesterEmailAddress            null.String                      `json:"requesterEmailAddress" db:"requester_email_address"`
+	BusinessCaseID                   *uuid.UUID                       `json:"businessCase" db:"business_case_id"`
+	LifecycleID                      null.String                      `json:"lcid" db:"lcid" gqlgen:"lcid"`
+	LifecycleExpiresAt               *time.Time                       `json:"lcidExpiresAt" db:"lcid_expires_at" gqlgen:"lcidExpiresAt"`
+	LifecycleScope                   null.String                      `json:"lcidScope" db:"lcid_scope" gqlgen:"lcidScope"`
+	LifecycleNextSteps               null.String                      `json:"lifecycleNextSteps" db:"lcid_next_steps"`
+	DecisionNextSteps                null.String                      `json:"decisionNextSteps" db:"decision_next_steps"`
+	RejectionReason                  null.String                      `json:"rejectionReason" db:"rejection_reason"`
+	Delegates                        SystemIntakeDelegates            `json:"delegates" db:"-"`
 }
 
 // SystemIntakes is a list of System Intakes
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/guregu/null"
+)
+
+// SystemIntakeCollaboratorWarnings maps a collaborator field to why its name could not be resolved
+type SystemIntakeCollaboratorWarnings map[string]string
+
+// Scan implements the sql.Scanner interface
+func (w *SystemIntakeCollaboratorWarnings) Scan(src interface{}) error {
+	switch data := src.(type) {
+	case nil:
+		*w = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(data, w)
+	case string:
+		return json.Unmarshal([]byte(data), w)
+	}
+	return errors.New("unsupported type for collaborator warnings")
+}
+
+// Value implements the driver.Valuer interface
+func (w SystemIntakeCollaboratorWarnings) Value() (driver.Value, error) {
+	if len(w) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(w)
+}
+
+// SystemIntakeCollaborator points at the fields naming one of an intake's collaborators
+type SystemIntakeCollaborator struct {
+	Field     string
+	Name      string
+	EUAUserID *null.String
+	Email     *null.String
+}
+
+// Collaborators returns the intake's named collaborators, preferring the name fields
+// over the older free text ones
+func (si *SystemIntake) Collaborators() []SystemIntakeCollaborator {
+	name := func(values ...null.String) string {
+		for _, value := range values {
+			if strings.TrimSpace(value.String) != "" {
+				return strings.TrimSpace(value.String)
+			}
+		}
+		return ""
+	}
+	collaborators := []SystemIntakeCollaborator{
+		{"isso", name(si.ISSOName, si.ISSO), &si.ISSOEUAUserID, &si.ISSOEmail},
+		{"trbCollaborator", name(si.TRBCollaboratorName, si.TRBCollaborator), &si.TRBCollaboratorEUAUserID, &si.TRBCollaboratorEmail},
+		{"oitSecurityCollaborator", name(si.OITSecurityCollaboratorName, si.OITSecurityCollaborator), &si.OITSecurityCollaboratorEUAUserID, &si.OITSecurityCollaboratorEmail},
+		{"eaCollaborator", name(si.EACollaboratorName, si.EACollaborator), &si.EACollaboratorEUAUserID, &si.EACollaboratorEmail},
+	}
+	named := []SystemIntakeCollaborator{}
+	for _, collaborator := range collaborators {
+		if collaborator.Name != "" {
+			named = append(named, collaborator)
+		}
+	}
+	return named
+}
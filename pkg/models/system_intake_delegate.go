@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SystemIntakeDelegateRole is what a delegate can do with a system intake
+type SystemIntakeDelegateRole string
+
+const (
+	// SystemIntakeDelegateRoleCOOWNER can edit and submit the intake and its business case
+	SystemIntakeDelegateRoleCOOWNER SystemIntakeDelegateRole = "CO_OWNER"
+	// SystemIntakeDelegateRoleVIEWER can read the intake and its business case
+	SystemIntakeDelegateRoleVIEWER SystemIntakeDelegateRole = "VIEWER"
+)
+
+// SystemIntakeDelegate is someone the requester has shared their system intake with
+type SystemIntakeDelegate struct {
+	ID             uuid.UUID                `json:"id"`
+	SystemIntakeID uuid.UUID                `json:"systemIntakeId" db:"system_intake_id"`
+	EUAUserID      string                   `json:"euaUserId" db:"eua_user_id"`
+	CommonName     string                   `json:"commonName" db:"common_name"`
+	Email          string                   `json:"email"`
+	Role           SystemIntakeDelegateRole `json:"role"`
+	CreatedAt      *time.Time               `json:"createdAt" db:"created_at"`
+	UpdatedAt      *time.Time               `json:"updatedAt" db:"updated_at"`
+}
+
+// SystemIntakeDelegates models a list of SystemIntakeDelegate items
+type SystemIntakeDelegates []SystemIntakeDelegate
+
+// RoleFor returns the role of the given EUA ID, or an empty role if they aren't a delegate
+func (d SystemIntakeDelegates) RoleFor(euaUserID string) SystemIntakeDelegateRole {
+	for _, delegate := range d {
+		if delegate.EUAUserID == euaUserID {
+			return delegate.Role
+		}
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// SystemIntakeDocumentType represents the type of a document attached to a system intake
+type SystemIntakeDocumentType string
+
+const (
+	// SystemIntakeDocumentTypeVendorQuote means the document is a vendor quote
+	SystemIntakeDocumentTypeVendorQuote SystemIntakeDocumentType = "VENDOR_QUOTE"
+	// SystemIntakeDocumentTypeArchitectureDiagram means the document is an architecture diagram
+	SystemIntakeDocumentTypeArchitectureDiagram SystemIntakeDocumentType = "ARCHITECTURE_DIAGRAM"
+	// SystemIntakeDocumentTypeCostEstimate means the document is a cost estimate
+	SystemIntakeDocumentTypeCostEstimate SystemIntakeDocumentType = "COST_ESTIMATE"
+	// SystemIntakeDocumentTypeSecurityDocumentation means the document is security documentation
+	SystemIntakeDocumentTypeSecurityDocumentation SystemIntakeDocumentType = "SECURITY_DOCUMENTATION"
+	// SystemIntakeDocumentTypeOther means the document is another type
+	SystemIntakeDocumentTypeOther SystemIntakeDocumentType = "OTHER"
+)
+
+// IsValid returns if the document type is valid
+func (e SystemIntakeDocumentType) IsValid() bool {
+	switch e {
+	case SystemIntakeDocumentTypeVendorQuote,
+		SystemIntakeDocumentTypeArchitectureDiagram,
+		SystemIntakeDocumentTypeCostEstimate,
+		SystemIntakeDocumentTypeSecurityDocumentation,
+		SystemIntakeDocumentTypeOther:
+		return true
+	}
+	return false
+}
+
+// SystemIntakeDocument is the representation of a file uploaded to S3
+// in support of a system intake or its business case
+type SystemIntakeDocument struct {
+	ID             uuid.UUID                          `json:"id"`
+	SystemIntakeID uuid.UUID                          `json:"systemIntakeId" db:"system_intake_id"`
+	BusinessCaseID *uuid.UUID                         `json:"businessCaseId" db:"business_case_id"`
+	FileType       string                             `json:"fileType" db:"file_type"`
+	Bucket         string                             `json:"bucket" db:"bucket"`
+	Key            string                             `json:"fileKey" db:"file_key"`
+	Name           string                             `json:"name" db:"file_name"`
+	Size           int                                `json:"size" db:"file_size"`
+	URL            string                             `json:"url"`
+	Status         AccessibilityRequestDocumentStatus `json:"status"`
+	VirusScanned   null.Bool                          `json:"virusScanned" db:"virus_scanned"`
+	VirusClean     null.Bool                          `json:"virusClean" db:"virus_clean"`
+	DocumentType   SystemIntakeDocumentType           `json:"documentType" db:"document_type"`
+	OtherType      null.String                        `json:"otherType" db:"other_type"`
+	CreatedAt      *time.Time                         `json:"createdAt" db:"created_at"`
+	UpdatedAt      *time.Time                         `json:"updatedAt" db:"updated_at"`
+	DeletedAt      *time.Time                         `json:"deletedAt" db:"deleted_at"`
+	DeletedBy      null.String                        `json:"deletedBy" db:"deleted_by"`
+}
+
+// SystemIntakeDocuments models a list of SystemIntakeDocument items
+type SystemIntakeDocuments []SystemIntakeDocument
+
+// Scan implements the sql.Scanner interface
+func (d *SystemIntakeDocuments) Scan(src interface{}) error {
+	return json.Unmarshal(src.([]byte), d)
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// SystemIntakeFundingSource is the model for a single funding source of a system intake
+type SystemIntakeFundingSource struct {
+	ID             uuid.UUID   `json:"id"`
+	SystemIntakeID uuid.UUID   `json:"systemIntakeId" db:"system_intake_id"`
+	Source         null.String `json:"source"`
+	FundingNumber  null.String `json:"fundingNumber" db:"funding_number"`
+	Amount         *int        `json:"amount"`
+	FiscalYear     *int        `json:"fiscalYear" db:"fiscal_year"`
+	CreatedAt      *time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt      *time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// SystemIntakeFundingSources models a list of SystemIntakeFundingSource items
+type SystemIntakeFundingSources []SystemIntakeFundingSource
+
+// Scan implements the sql.Scanner interface
+func (f *SystemIntakeFundingSources) Scan(src interface{}) error {
+	return json.Unmarshal(src.([]byte), f)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 )
 
 // TestDateTestType represents the test type of a 508 test instance
@@ -16,14 +17,15 @@ const (
 	TestDateTestTypeRemediation TestDateTestType = "REMEDIATION"
 )
 
-// TestDate models a 508 test date
+// TestDate models a 508 test date, scored in tenths of a percent
 type TestDate struct {
 	ID        uuid.UUID        `json:"id"`
-	RequestID uuid.UUID        `db:"request_id"`
-	TestType  TestDateTestType `db:"test_type"`
-	Date      time.Time
-	Score     *int
-	CreatedAt *time.Time `db:"created_at"`
-	UpdatedAt *time.Time `db:"updated_at"`
-	DeletedAt *time.Time `db:"deleted_at"`
+	RequestID uuid.UUID        `json:"requestId" db:"request_id"`
+	TestType  TestDateTestType `json:"testType" db:"test_type"`
+	Date      time.Time        `json:"date"`
+	Score     *int             `json:"score"`
+	CreatedAt *time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt *time.Time       `json:"updatedAt" db:"updated_at"`
+	DeletedAt *time.Time       `json:"deletedAt" db:"deleted_at"`
+	DeletedBy null.String      `json:"deletedBy" db:"deleted_by"`
 }
@@ -2,7 +2,16 @@ package models
 
 // UserInfo is the model for personal details of a user
 type UserInfo struct {
-	CommonName string
-	Email      string
-	EuaUserID  string
+	CommonName string `json:"commonName"`
+	Email      string `json:"email"`
+	EuaUserID  string `json:"euaUserId"`
+}
+
+// UserInfoCacheStats counts how user lookups have been served by the cache
+type UserInfoCacheStats struct {
+	Entries      int    `json:"entries"`
+	Hits         uint64 `json:"hits"`
+	NegativeHits uint64 `json:"negativeHits"`
+	Misses       uint64 `json:"misses"`
+	Evictions    uint64 `json:"evictions"`
 }
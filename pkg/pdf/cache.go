@@ -0,0 +1,51 @@
+package pdf
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cache stores rendered PDFs so we don't need to generate them again
+type Cache interface {
+	Fetch(key string) ([]byte, bool, error)
+	Store(key string, content []byte) error
+}
+
+// objectStore is the storage a S3Cache keeps PDFs in
+type objectStore interface {
+	DownloadObject(key string) ([]byte, bool, error)
+	UploadObject(key string, contentType string, content []byte) error
+}
+
+// S3Cache caches rendered PDFs in S3
+type S3Cache struct {
+	store objectStore
+}
+
+// NewS3Cache returns a Cache backed by S3
+func NewS3Cache(store objectStore) S3Cache {
+	return S3Cache{store: store}
+}
+
+// Fetch returns the cached PDF for the key, and whether it was found
+func (c S3Cache) Fetch(key string) ([]byte, bool, error) {
+	return c.store.DownloadObject(key)
+}
+
+// Store caches the PDF under the key
+func (c S3Cache) Store(key string, content []byte) error {
+	return c.store.UploadObject(key, "application/pdf", content)
+}
+
+// CacheKey returns the cache key of a record's PDF at a given version.
+// It includes a digest of the HTML so template changes don't serve stale documents.
+func CacheKey(kind string, id uuid.UUID, version *time.Time, html string) string {
+	var versionStamp int64
+	if version != nil {
+		versionStamp = version.UnixNano()
+	}
+	return fmt.Sprintf("pdf-cache/%s/%s/%d-%x.pdf", kind, id, versionStamp, sha256.Sum256([]byte(html)))
+}
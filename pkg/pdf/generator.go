@@ -0,0 +1,41 @@
+package pdf
+
+import (
+	"context"
+	"time"
+)
+
+// Generator converts HTML documents into PDFs
+type Generator interface {
+	GeneratePDF(ctx context.Context, html string) ([]byte, error)
+}
+
+// Limits bounds the work done to generate a single PDF
+type Limits struct {
+	Timeout      time.Duration
+	MaxHTMLBytes int
+	MaxPDFBytes  int
+}
+
+const (
+	// DefaultTimeout is how long we wait for a PDF when no timeout is configured
+	DefaultTimeout = 30 * time.Second
+	// DefaultMaxHTMLBytes is the largest HTML document we render when no limit is configured
+	DefaultMaxHTMLBytes = 2 << 20
+	// DefaultMaxPDFBytes is the largest PDF we return when no limit is configured
+	DefaultMaxPDFBytes = 20 << 20
+)
+
+// WithDefaults fills in unset limits with the defaults
+func (l Limits) WithDefaults() Limits {
+	if l.Timeout <= 0 {
+		l.Timeout = DefaultTimeout
+	}
+	if l.MaxHTMLBytes <= 0 {
+		l.MaxHTMLBytes = DefaultMaxHTMLBytes
+	}
+	if l.MaxPDFBytes <= 0 {
+		l.MaxPDFBytes = DefaultMaxPDFBytes
+	}
+	return l
+}
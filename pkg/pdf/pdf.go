@@ -0,0 +1,176 @@
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"path"
+	"time"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// Config holds the configuration for rendering PDF documents
+type Config struct {
+	TemplateDirectory string
+	Limits            Limits
+}
+
+// templateCaller is an interface to helping with testing template dependencies
+type templateCaller interface {
+	Execute(wr io.Writer, data interface{}) error
+}
+
+// templates stores typed templates
+// since the template.Template uses string access
+type templates struct {
+	systemIntakeTemplate templateCaller
+	businessCaseTemplate templateCaller
+	decisionTemplate     templateCaller
+}
+
+// Renderer builds the HTML of the documents we render as PDFs
+type Renderer struct {
+	templates templates
+}
+
+// templateError is just a helper method for formatting errors
+func templateError(name string) error {
+	return fmt.Errorf("failed to get template: %s", name)
+}
+
+// templateFuncs are the helpers available to every PDF template
+var templateFuncs = template.FuncMap{
+	"formatDate": func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("January 2, 2006")
+	},
+	"formatCost": func(cost int) string {
+		return formatDollars(cost)
+	},
+	"deref": func(i *int) int {
+		return *i
+	},
+}
+
+// NewRenderer returns a new PDF document renderer for EASi
+func NewRenderer(config Config) (Renderer, error) {
+	rawTemplates, err := template.New("pdf").Funcs(templateFuncs).ParseGlob(path.Join(config.TemplateDirectory, "*.gohtml"))
+	if err != nil {
+		return Renderer{}, err
+	}
+	appTemplates := templates{}
+
+	systemIntakeTemplateName := "system_intake.gohtml"
+	systemIntakeTemplate := rawTemplates.Lookup(systemIntakeTemplateName)
+	if systemIntakeTemplate == nil {
+		return Renderer{}, templateError(systemIntakeTemplateName)
+	}
+	appTemplates.systemIntakeTemplate = systemIntakeTemplate
+
+	businessCaseTemplateName := "business_case.gohtml"
+	businessCaseTemplate := rawTemplates.Lookup(businessCaseTemplateName)
+	if businessCaseTemplate == nil {
+		return Renderer{}, templateError(businessCaseTemplateName)
+	}
+	appTemplates.businessCaseTemplate = businessCaseTemplate
+
+	decisionTemplateName := "decision.gohtml"
+	decisionTemplate := rawTemplates.Lookup(decisionTemplateName)
+	if decisionTemplate == nil {
+		return Renderer{}, templateError(decisionTemplateName)
+	}
+	appTemplates.decisionTemplate = decisionTemplate
+
+	return Renderer{templates: appTemplates}, nil
+}
+
+func execute(tmpl templateCaller, name string, data interface{}) (string, error) {
+	if tmpl == nil {
+		return "", fmt.Errorf("%s template is nil", name)
+	}
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, data)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// SystemIntakeHTML renders the HTML for a system intake
+func (r Renderer) SystemIntakeHTML(intake *models.SystemIntake) (string, error) {
+	if intake == nil {
+		return "", errors.New("system intake is required")
+	}
+	return execute(r.templates.systemIntakeTemplate, "system intake", intake)
+}
+
+type businessCaseAlternative struct {
+	models.BusinessCaseAlternative
+	TotalCost int
+}
+
+type businessCase struct {
+	*models.BusinessCase
+	AlternativesWithCosts []businessCaseAlternative
+}
+
+// BusinessCaseHTML renders the HTML for a business case
+func (r Renderer) BusinessCaseHTML(bc *models.BusinessCase) (string, error) {
+	if bc == nil {
+		return "", errors.New("business case is required")
+	}
+	data := businessCase{BusinessCase: bc}
+	alternatives := bc.Alternatives
+	if len(alternatives) == 0 {
+		alternatives = bc.LegacyAlternatives()
+	}
+	solutions := alternatives.LegacySolutions()
+	for i, alternative := range alternatives {
+		total := 0
+		for _, line := range bc.LifecycleCostLines {
+			matches := line.AlternativeID != nil && *line.AlternativeID == alternative.ID
+			if line.AlternativeID == nil {
+				matches = solutions[i] != "" && line.Solution == solutions[i]
+			}
+			if matches && line.Cost != nil {
+				total += *line.Cost
+			}
+		}
+		data.AlternativesWithCosts = append(data.AlternativesWithCosts, businessCaseAlternative{
+			BusinessCaseAlternative: alternative,
+			TotalCost:               total,
+		})
+	}
+	return execute(r.templates.businessCaseTemplate, "business case", data)
+}
+
+// DecisionHTML renders the HTML for the decision on a system intake
+func (r Renderer) DecisionHTML(intake *models.SystemIntake) (string, error) {
+	if intake == nil {
+		return "", errors.New("system intake is required")
+	}
+	return execute(r.templates.decisionTemplate, "decision", intake)
+}
+
+// formatDollars formats a whole dollar amount with thousands separators
+func formatDollars(amount int) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	digits := fmt.Sprintf("%d", amount)
+	var b bytes.Buffer
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	return sign + "$" + b.String()
+}
@@ -0,0 +1,128 @@
+package pdf
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+type PDFTestSuite struct {
+	suite.Suite
+	renderer Renderer
+}
+
+type mockFailedTemplateCaller struct{}
+
+func (c mockFailedTemplateCaller) Execute(wr io.Writer, data interface{}) error {
+	return errors.New("template caller had an error")
+}
+
+func TestPDFTestSuite(t *testing.T) {
+	renderer, err := NewRenderer(Config{TemplateDirectory: "templates"})
+	if err != nil {
+		t.Fatalf("failed to create renderer: %v", err)
+	}
+	suite.Run(t, &PDFTestSuite{renderer: renderer})
+}
+
+func (s PDFTestSuite) TestNewRenderer() {
+	s.Run("fails without templates", func() {
+		_, err := NewRenderer(Config{TemplateDirectory: "does-not-exist"})
+		s.Error(err)
+	})
+}
+
+func (s PDFTestSuite) TestSystemIntakeHTML() {
+	intake := testhelpers.NewSystemIntake()
+	intake.ProjectName = null.StringFrom("Easy Access <to> System Information")
+	amount := 1200000
+	intake.FundingSources = models.SystemIntakeFundingSources{
+		{Source: null.StringFrom("CLIA"), FundingNumber: null.StringFrom("123456"), Amount: &amount},
+	}
+
+	s.Run("renders the stored intake", func() {
+		html, err := s.renderer.SystemIntakeHTML(&intake)
+		s.NoError(err)
+		s.Contains(html, "Easy Access &lt;to&gt; System Information")
+		s.Contains(html, intake.Requester)
+		s.Contains(html, "$1,200,000")
+	})
+
+	s.Run("returns an error if the template fails", func() {
+		renderer := Renderer{templates: templates{systemIntakeTemplate: mockFailedTemplateCaller{}}}
+		_, err := renderer.SystemIntakeHTML(&intake)
+		s.Error(err)
+	})
+
+	s.Run("returns an error without an intake", func() {
+		_, err := s.renderer.SystemIntakeHTML(nil)
+		s.Error(err)
+	})
+}
+
+func (s PDFTestSuite) TestBusinessCaseHTML() {
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.ID = uuid.New()
+	businessCase.SyncAlternatives(nil)
+
+	html, err := s.renderer.BusinessCaseHTML(&businessCase)
+	s.NoError(err)
+	s.Contains(html, businessCase.ProjectName.String)
+	s.Contains(html, businessCase.PreferredTitle.String)
+	s.Contains(html, "Total estimated lifecycle cost")
+}
+
+func (s PDFTestSuite) TestDecisionHTML() {
+	s.Run("renders an issued lifecycle ID", func() {
+		expiresAt := time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)
+		intake := testhelpers.NewSystemIntake()
+		intake.Status = models.SystemIntakeStatusLCIDISSUED
+		intake.LifecycleID = null.StringFrom("210001")
+		intake.LifecycleExpiresAt = &expiresAt
+		intake.LifecycleScope = null.StringFrom("The whole thing")
+
+		html, err := s.renderer.DecisionHTML(&intake)
+		s.NoError(err)
+		s.Contains(html, "210001")
+		s.Contains(html, "March 15, 2022")
+	})
+
+	s.Run("renders a rejection", func() {
+		intake := testhelpers.NewSystemIntake()
+		intake.Status = models.SystemIntakeStatusNOTAPPROVED
+		intake.RejectionReason = null.StringFrom("Duplicate of an existing system")
+
+		html, err := s.renderer.DecisionHTML(&intake)
+		s.NoError(err)
+		s.Contains(html, "has not been approved")
+		s.Contains(html, "Duplicate of an existing system")
+	})
+}
+
+func (s PDFTestSuite) TestFormatDollars() {
+	s.Equal("$0", formatDollars(0))
+	s.Equal("$999", formatDollars(999))
+	s.Equal("$1,000", formatDollars(1000))
+	s.Equal("-$12,345,678", formatDollars(-12345678))
+}
+
+func (s PDFTestSuite) TestCacheKey() {
+	id := uuid.New()
+	version := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	later := version.Add(time.Second)
+
+	key := CacheKey("system_intake", id, &version, "<p>hi</p>")
+	s.Contains(key, "pdf-cache/system_intake/"+id.String()+"/")
+	s.Equal(key, CacheKey("system_intake", id, &version, "<p>hi</p>"))
+	s.NotEqual(key, CacheKey("system_intake", id, &later, "<p>hi</p>"))
+	s.NotEqual(key, CacheKey("system_intake", id, &version, "<p>bye</p>"))
+	s.NotEqual(key, CacheKey("decision", id, &version, "<p>hi</p>"))
+}
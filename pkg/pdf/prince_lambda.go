@@ -0,0 +1,73 @@
+package pdf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+)
+
+type generateRequest struct {
+	HTML string `json:"html"`
+}
+
+type generateResponse struct {
+	Content []byte `json:"content"`
+}
+
+// PrinceLambdaGenerator generates PDFs with the Prince lambda function
+type PrinceLambdaGenerator struct {
+	client       lambdaiface.LambdaAPI
+	functionName string
+}
+
+// NewPrinceLambdaGenerator returns a Generator that invokes the Prince lambda
+func NewPrinceLambdaGenerator(client lambdaiface.LambdaAPI, functionName string) PrinceLambdaGenerator {
+	return PrinceLambdaGenerator{
+		client:       client,
+		functionName: functionName,
+	}
+}
+
+// GeneratePDF invokes the lambda with the HTML and returns the PDF content
+func (g PrinceLambdaGenerator) GeneratePDF(ctx context.Context, html string) ([]byte, error) {
+	appcontext.ZLogger(ctx).Info("making request to lambda")
+
+	payload, marshalErr := json.Marshal(generateRequest{HTML: html})
+	if marshalErr != nil {
+		return nil, fmt.Errorf("error marshaling generateRequest: %w", marshalErr)
+	}
+
+	result, invokeErr := g.client.InvokeWithContext(ctx, &lambda.InvokeInput{
+		FunctionName: aws.String(g.functionName),
+		Payload:      payload,
+	})
+	if invokeErr != nil {
+		return nil, fmt.Errorf("error invoking lambda: %w", invokeErr)
+	}
+
+	appcontext.ZLogger(ctx).Info(
+		"response from lambda",
+		zap.Int64p("statusCode", result.StatusCode),
+		zap.String("version", aws.StringValue(result.ExecutedVersion)),
+		zap.Int("payloadLength", len(result.Payload)),
+	)
+
+	if aws.Int64Value(result.StatusCode) != 200 || result.FunctionError != nil {
+		return nil, fmt.Errorf("error invoking lambda: %s", result.Payload)
+	}
+
+	var generated generateResponse
+	jsonErr := json.Unmarshal(result.Payload, &generated)
+	if jsonErr != nil {
+		return nil, fmt.Errorf("error unmarshaling generateResponse: %w", jsonErr)
+	}
+
+	return generated.Content, nil
+}
@@ -0,0 +1,49 @@
+package pdf
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+)
+
+type mockLambdaClient struct {
+	lambdaiface.LambdaAPI
+	input  *lambda.InvokeInput
+	output *lambda.InvokeOutput
+}
+
+func (m *mockLambdaClient) InvokeWithContext(_ aws.Context, input *lambda.InvokeInput, _ ...request.Option) (*lambda.InvokeOutput, error) {
+	m.input = input
+	return m.output, nil
+}
+
+func (s PDFTestSuite) TestPrinceLambdaGenerator() {
+	ctx := context.Background()
+
+	s.Run("invokes the lambda with the HTML", func() {
+		payload, err := json.Marshal(generateResponse{Content: []byte("%PDF-1.4")})
+		s.NoError(err)
+		client := &mockLambdaClient{output: &lambda.InvokeOutput{StatusCode: aws.Int64(200), Payload: payload}}
+
+		content, err := NewPrinceLambdaGenerator(client, "prince").GeneratePDF(ctx, "<p>hi</p>")
+		s.NoError(err)
+		s.Equal([]byte("%PDF-1.4"), content)
+		s.Equal("prince", aws.StringValue(client.input.FunctionName))
+		s.JSONEq(`{"html":"<p>hi</p>"}`, string(client.input.Payload))
+	})
+
+	s.Run("returns an error when the function fails", func() {
+		client := &mockLambdaClient{output: &lambda.InvokeOutput{
+			StatusCode:    aws.Int64(200),
+			FunctionError: aws.String("Unhandled"),
+			Payload:       []byte(`{"errorMessage":"boom"}`),
+		}}
+
+		_, err := NewPrinceLambdaGenerator(client, "prince").GeneratePDF(ctx, "<p>hi</p>")
+		s.Error(err)
+	})
+}
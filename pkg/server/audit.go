@@ -0,0 +1,16 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/cmsgov/easi-app/pkg/audit"
+)
+
+// NewAuditMiddleware returns a handler that records audit events with the recorder
+func NewAuditMiddleware(recorder audit.Recorder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(audit.WithRecorder(r.Context(), recorder)))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
+
+	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedareasi"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/cedar/resilience"
+	"github.com/cmsgov/easi-app/pkg/flags"
+	"github.com/cmsgov/easi-app/pkg/local"
+	"github.com/cmsgov/easi-app/pkg/local/cedargateway"
+	"github.com/cmsgov/easi-app/pkg/services"
+	"github.com/cmsgov/easi-app/pkg/storage"
+)
+
+// useLocalCEDARMocks reports whether CEDAR is replaced by in-process mocks,
+// which local and test environments do unless they use a local gateway
+func (s Server) useLocalCEDARMocks() bool {
+	return (s.environment.Local() || s.environment.Test()) && !s.Config.GetBool(appconfig.CEDARLocalGatewayKey)
+}
+
+// NewCEDAREasiClient returns the CEDAR EASi client for the environment
+func (s Server) NewCEDAREasiClient(ldClient *ld.LDClient, transport http.RoundTripper) cedareasi.Client {
+	if s.useLocalCEDARMocks() {
+		return local.NewCedarEasiClient()
+	}
+	// check we have all of the configs for CEDAR clients
+	s.NewCEDARClientCheck()
+	return cedareasi.NewTranslatedClient(
+		s.Config.GetString(appconfig.CEDARAPIURL),
+		s.Config.GetString(appconfig.CEDARAPIKey),
+		ldClient,
+		transport,
+	)
+}
+
+// NewCEDARLDAPClient returns the CEDAR LDAP client for the environment
+func (s Server) NewCEDARLDAPClient(transport http.RoundTripper) cedarldap.Client {
+	if s.useLocalCEDARMocks() {
+		return local.NewCedarLdapClient(s.logger)
+	}
+	s.NewCEDARClientCheck()
+	return cedarldap.NewTranslatedClient(
+		s.Config.GetString(appconfig.CEDARAPIURL),
+		s.Config.GetString(appconfig.CEDARAPIKey),
+		transport,
+	)
+}
+
+// ReconcileCedarOptions configures a run of ReconcileCedar
+type ReconcileCedarOptions struct {
+	DryRun            bool
+	RequestsPerSecond float64
+}
+
+// ReconcileCedar submits intakes that CEDAR is missing and checks the status of the ones it has,
+// writing a JSON report to stdout
+func ReconcileCedar(config *viper.Viper, options ReconcileCedarOptions) {
+	environment, err := appconfig.NewEnvironment(config.GetString(appconfig.EnvironmentKey))
+	if err != nil {
+		log.Fatalf("Unable to set environment: %v", err)
+	}
+	s := &Server{
+		Config:      config,
+		logger:      newLogger(environment),
+		environment: environment,
+	}
+
+	if options.RequestsPerSecond <= 0 {
+		s.logger.Fatal("requests per second must be positive")
+	}
+
+	ldClient, err := flags.NewLaunchDarklyClient(s.NewFlagConfig())
+	if err != nil {
+		s.logger.Fatal("Failed to create LaunchDarkly client", zap.Error(err))
+	}
+	store, err := storage.NewStore(s.logger, s.NewDBConfig(), ldClient)
+	if err != nil {
+		s.logger.Fatal("Failed to create store", zap.Error(err))
+	}
+	cedarEasiClient := s.NewCEDAREasiClient(
+		ldClient,
+		resilience.NewTransport("CEDAR EASi", s.NewCEDARResilienceConfig(), nil),
+	)
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / options.RequestsPerSecond))
+	defer ticker.Stop()
+	throttle := func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			return nil
+		}
+	}
+
+	reconcile := services.NewReconcileCedarSystemIntakes(
+		services.NewConfig(s.logger, ldClient),
+		store.FetchSubmittedSystemIntakesWithoutAlfabetID,
+		store.FetchSystemIntakesWithAlfabetID,
+		cedarEasiClient.ValidateAndSubmitSystemIntake,
+		store.UpdateSystemIntake,
+		cedarEasiClient.FetchSystemIntakeStatus,
+		store.QueueCedarSystemIntakeUpdate,
+		throttle,
+	)
+
+	ctx := appcontext.WithLogger(context.Background(), s.logger)
+	report, err := reconcile(ctx, options.DryRun)
+	if report != nil {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if encodeErr := encoder.Encode(report); encodeErr != nil {
+			s.logger.Error("Failed to write reconciliation report", zap.Error(encodeErr))
+		}
+	}
+	if err != nil {
+		s.logger.Fatal("Failed to reconcile intakes with CEDAR", zap.Error(err))
+	}
+}
+
+// ServeCEDARGateway serves a fake CEDAR API gateway for local development,
+// requiring CEDAR_API_KEY if it is set
+func ServeCEDARGateway(config *viper.Viper, address string) {
+	gateway := cedargateway.NewGateway(
+		config.GetString(appconfig.CEDARAPIKey),
+		cedargateway.DefaultPeople()...,
+	)
+	log.Printf("Serving fake CEDAR gateway on %s", address)
+	log.Fatal(http.ListenAndServe(address, gateway.Handler()))
+}
@@ -6,8 +6,11 @@ import (
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
 	"github.com/cmsgov/easi-app/pkg/appses"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/cedar/resilience"
 	"github.com/cmsgov/easi-app/pkg/email"
 	"github.com/cmsgov/easi-app/pkg/flags"
+	"github.com/cmsgov/easi-app/pkg/pdf"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/upload"
 )
@@ -55,6 +58,20 @@ func (s Server) NewEmailConfig() email.Config {
 	}
 }
 
+// NewPDFConfig returns a new pdf.Config and checks required fields
+func (s Server) NewPDFConfig() pdf.Config {
+	s.checkRequiredConfig(appconfig.PDFTemplateDirectoryKey)
+
+	return pdf.Config{
+		TemplateDirectory: s.Config.GetString(appconfig.PDFTemplateDirectoryKey),
+		Limits: pdf.Limits{
+			Timeout:      time.Duration(s.Config.GetInt(appconfig.PDFGenerateTimeoutKey)) * time.Second,
+			MaxHTMLBytes: s.Config.GetInt(appconfig.PDFMaxHTMLBytesKey),
+			MaxPDFBytes:  s.Config.GetInt(appconfig.PDFMaxBytesKey),
+		},
+	}
+}
+
 // NewSESConfig returns a new email.Config and checks required fields
 func (s Server) NewSESConfig() appses.Config {
 	s.checkRequiredConfig(appconfig.AWSSESSourceARNKey)
@@ -84,12 +101,75 @@ func (s Server) NewCEDARClientCheck() {
 	s.checkRequiredConfig(appconfig.CEDARAPIKey)
 }
 
+// CEDARRetryInterval returns how often failed CEDAR updates are retried, defaulting to a minute
+func (s Server) CEDARRetryInterval() time.Duration {
+	interval := s.Config.GetInt(appconfig.CEDARRetryIntervalKey)
+	if interval <= 0 {
+		return time.Minute
+	}
+	return time.Duration(interval) * time.Second
+}
+
+// AuditEventRetention returns how long audit events are kept, or zero to keep them forever
+func (s Server) AuditEventRetention() time.Duration {
+	days := s.Config.GetInt(appconfig.AuditEventRetentionDaysKey)
+	if days <= 0 {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// NewCEDARLDAPCacheConfig returns how long CEDAR LDAP user lookups are cached,
+// using the defaults for anything not configured
+func (s Server) NewCEDARLDAPCacheConfig() cedarldap.CacheConfig {
+	config := cedarldap.DefaultCacheConfig()
+	if ttl := s.Config.GetInt(appconfig.CEDARLDAPCacheTTLKey); ttl > 0 {
+		config.TTL = time.Duration(ttl) * time.Second
+	}
+	if negativeTTL := s.Config.GetInt(appconfig.CEDARLDAPCacheNegativeTTLKey); negativeTTL > 0 {
+		config.NegativeTTL = time.Duration(negativeTTL) * time.Second
+	}
+	return config
+}
+
+// NewCEDARResilienceConfig returns the timeout, retry and circuit breaker settings for CEDAR,
+// using the defaults for anything not configured
+func (s Server) NewCEDARResilienceConfig() resilience.Config {
+	config := resilience.DefaultConfig()
+	if timeout := s.Config.GetInt(appconfig.CEDARTimeoutKey); timeout > 0 {
+		config.Timeout = time.Duration(timeout) * time.Second
+	}
+	if s.Config.IsSet(appconfig.CEDARMaxRetriesKey) {
+		config.MaxRetries = s.Config.GetInt(appconfig.CEDARMaxRetriesKey)
+	}
+	if failures := s.Config.GetInt(appconfig.CEDARBreakerFailuresKey); failures > 0 {
+		config.BreakerFailureThreshold = failures
+	}
+	if cooldown := s.Config.GetInt(appconfig.CEDARBreakerCooldownKey); cooldown > 0 {
+		config.BreakerCooldown = time.Duration(cooldown) * time.Second
+	}
+	return config
+}
+
 // LambdaConfig is the config to call a lambda func
 type LambdaConfig struct {
 	Endpoint     string
 	FunctionName string
 }
 
+// PDFGenerator returns which generator should render PDFs,
+// defaulting to the local one in local and test environments
+func (s Server) PDFGenerator() appconfig.PDFGeneratorOption {
+	generator := appconfig.PDFGeneratorOption(s.Config.GetString(appconfig.PDFGeneratorKey))
+	if generator != "" {
+		return generator
+	}
+	if s.environment.Local() || s.environment.Test() {
+		return appconfig.PDFGeneratorLocal
+	}
+	return appconfig.PDFGeneratorPrinceLambda
+}
+
 // NewPrinceLambdaConfig returns the configutation for the prince lambda
 func (s Server) NewPrinceLambdaConfig() LambdaConfig {
 	endpoint := s.Config.GetString(appconfig.LambdaEndpoint)
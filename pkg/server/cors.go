@@ -9,8 +9,9 @@ func newCORSMiddleware(clientAddress string) func(next http.Handler) http.Handle
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// If CORS becomes more complicated, consider using gorilla/handlers.
 			w.Header().Set("Access-Control-Allow-Origin", clientAddress)
-			w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS, PUT, POST, DELETE")
+			w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, If-Match")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS, PUT, PATCH, POST, DELETE")
+			w.Header().Set("Access-Control-Expose-Headers", "ETag")
 			if r.Method == "OPTIONS" {
 				return
 			}
@@ -20,10 +20,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/appses"
 	"github.com/cmsgov/easi-app/pkg/appvalidation"
-	"github.com/cmsgov/easi-app/pkg/cedar/cedareasi"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/cedar/resilience"
 	"github.com/cmsgov/easi-app/pkg/email"
 	"github.com/cmsgov/easi-app/pkg/flags"
 	"github.com/cmsgov/easi-app/pkg/graph"
@@ -32,6 +35,7 @@ import (
 	"github.com/cmsgov/easi-app/pkg/handlers"
 	"github.com/cmsgov/easi-app/pkg/local"
 	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/pdf"
 	"github.com/cmsgov/easi-app/pkg/services"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/upload"
@@ -53,8 +57,20 @@ func (s *Server) routes(
 	// set up handler base
 	base := handlers.NewHandlerBase(s.logger)
 
+	// CEDAR requests go through transports with timeouts, retries and circuit breakers
+	cedarResilienceConfig := s.NewCEDARResilienceConfig()
+	cedarEasiTransport := resilience.NewTransport("CEDAR EASi", cedarResilienceConfig, nil)
+	cedarLDAPTransport := resilience.NewTransport("CEDAR LDAP", cedarResilienceConfig, nil)
+
 	// endpoints that dont require authorization go directly on the main router
-	s.router.HandleFunc("/api/v1/healthcheck", handlers.NewHealthCheckHandler(base, s.Config).Handle())
+	s.router.HandleFunc("/api/v1/healthcheck", handlers.NewHealthCheckHandler(
+		base,
+		s.Config,
+		map[string]func() resilience.BreakerState{
+			"cedarEasi": cedarEasiTransport.BreakerState,
+			"cedarLdap": cedarLDAPTransport.BreakerState,
+		},
+	).Handle())
 	s.router.HandleFunc("/api/graph/playground", playground.Handler("GraphQL playground", "/api/graph/query"))
 
 	// set up Feature Flagging utilities
@@ -64,29 +80,16 @@ func (s *Server) routes(
 	}
 
 	// set up CEDAR client
-	var cedarEasiClient cedareasi.Client = local.NewCedarEasiClient()
-	if !(s.environment.Local() || s.environment.Test()) {
-		// check we have all of the configs for CEDAR clients
-		s.NewCEDARClientCheck()
-
-		cedarEasiClient = cedareasi.NewTranslatedClient(
-			s.Config.GetString(appconfig.CEDARAPIURL),
-			s.Config.GetString(appconfig.CEDARAPIKey),
-			ldClient,
-		)
-		if s.environment.Deployed() {
-			s.CheckCEDAREasiClientConnection(cedarEasiClient)
-		}
+	cedarEasiClient := s.NewCEDAREasiClient(ldClient, cedarEasiTransport)
+	if s.environment.Deployed() {
+		s.CheckCEDAREasiClientConnection(cedarEasiClient)
 	}
 
-	var cedarLDAPClient cedarldap.Client
-	cedarLDAPClient = cedarldap.NewTranslatedClient(
-		s.Config.GetString(appconfig.CEDARAPIURL),
-		s.Config.GetString(appconfig.CEDARAPIKey),
+	// user lookups happen several times per action, so they are cached
+	cedarLDAPClient := cedarldap.NewCachedClient(
+		s.NewCEDARLDAPClient(cedarLDAPTransport),
+		s.NewCEDARLDAPCacheConfig(),
 	)
-	if s.environment.Local() || s.environment.Test() {
-		cedarLDAPClient = local.NewCedarLdapClient(s.logger)
-	}
 
 	// set up Email Client
 	sesConfig := s.NewSESConfig()
@@ -109,6 +112,13 @@ func (s *Server) routes(
 		s.CheckEmailClient(emailClient)
 	}
 
+	// set up PDF renderer
+	pdfConfig := s.NewPDFConfig()
+	pdfRenderer, err := pdf.NewRenderer(pdfConfig)
+	if err != nil {
+		s.logger.Fatal("Failed to create pdf renderer", zap.Error(err))
+	}
+
 	// set up S3 client
 	s3Config := s.NewS3Config()
 	if s.environment.Local() {
@@ -117,19 +127,28 @@ func (s *Server) routes(
 
 	s3Client := upload.NewS3Client(s3Config)
 
-	var lambdaClient *lambda.Lambda
-	var princeLambdaName string
-	lambdaSession := session.Must(session.NewSession())
-
-	princeConfig := s.NewPrinceLambdaConfig()
-	princeLambdaName = princeConfig.FunctionName
-
-	if s.environment.Local() || s.environment.Test() {
-		endpoint := princeConfig.Endpoint
-		lambdaClient = lambda.New(lambdaSession, &aws.Config{Endpoint: &endpoint, Region: aws.String("us-west-2")})
-	} else {
-		lambdaClient = lambda.New(lambdaSession, &aws.Config{})
+	// set up PDF generator
+	var pdfGenerator pdf.Generator
+	switch pdfGeneratorOption := s.PDFGenerator(); pdfGeneratorOption {
+	case appconfig.PDFGeneratorLocal:
+		pdfGenerator = local.NewPDFGenerator(s.logger)
+	case appconfig.PDFGeneratorPrinceLambda:
+		var lambdaClient *lambda.Lambda
+		lambdaSession := session.Must(session.NewSession())
+
+		princeConfig := s.NewPrinceLambdaConfig()
+
+		if s.environment.Local() || s.environment.Test() {
+			endpoint := princeConfig.Endpoint
+			lambdaClient = lambda.New(lambdaSession, &aws.Config{Endpoint: &endpoint, Region: aws.String("us-west-2")})
+		} else {
+			lambdaClient = lambda.New(lambdaSession, &aws.Config{})
+		}
+		pdfGenerator = pdf.NewPrinceLambdaGenerator(lambdaClient, princeConfig.FunctionName)
+	default:
+		s.logger.Fatal("Unknown PDF generator", zap.String("generator", string(pdfGeneratorOption)))
 	}
+	pdfCache := pdf.NewS3Cache(s3Client)
 
 	store, storeErr := storage.NewStore(
 		s.logger,
@@ -142,37 +161,41 @@ func (s *Server) routes(
 
 	serviceConfig := services.NewConfig(s.logger, ldClient)
 
+	// what principals do through services and resolvers is recorded as audit events,
+	// which expire after the retention period
+	auditMiddleware := NewAuditMiddleware(store.CreateAuditEvent)
+	s.purgeAuditEvents = services.NewPurgeAuditEvents(
+		serviceConfig,
+		s.AuditEventRetention(),
+		store.DeleteAuditEventsBefore,
+	)
+	recordFieldChanges := services.NewRecordFieldChanges(serviceConfig, store.CreateFieldChanges)
+
 	// set up GraphQL routes
 	gql := s.router.PathPrefix("/api/graph").Subrouter()
 	gql.Use(authorizationMiddleware) // TODO: see comment at top-level router
-	resolver := graph.NewResolver(
-		store,
-		graph.ResolverService{
-			CreateTestDate: services.NewCreateTestDate(
-				serviceConfig,
-				services.NewAuthorizeHasEASiRole(),
-				store.CreateTestDate,
-			),
-		},
-		&s3Client,
-	)
-	gqlDirectives := generated.DirectiveRoot{HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (res interface{}, err error) {
-		hasRole, err := services.HasRole(ctx, role)
-		if err != nil {
-			return nil, err
-		}
-		if !hasRole {
-			return nil, errors.New("not authorized")
-		}
-		return next(ctx)
-	}}
-	gqlConfig := generated.Config{Resolvers: resolver, Directives: gqlDirectives}
-	graphqlServer := handler.NewDefaultServer(generated.NewExecutableSchema(gqlConfig))
-	gql.Handle("/query", graphqlServer)
+	gql.Use(auditMiddleware)
+	// the query handler is added once the services it shares with the REST handlers are set up
 
 	// API base path is versioned
 	api := s.router.PathPrefix("/api/v1").Subrouter()
 	api.Use(authorizationMiddleware) // TODO: see comment at top-level router
+	api.Use(auditMiddleware)
+
+	// decisions and status changes are queued and sent on to CEDAR in the background
+	updateSystemIntakeAndCedar := services.NewUpdateSystemIntakeAndCedar(
+		serviceConfig,
+		store.UpdateSystemIntake,
+		store.QueueCedarSystemIntakeUpdate,
+	)
+	s.retryCedarUpdates = services.NewRetryCedarSystemIntakeUpdates(
+		serviceConfig,
+		store.ClaimDueCedarSystemIntakeUpdates,
+		store.FetchSystemIntakeByID,
+		cedarEasiClient.UpdateSystemIntake,
+		store.RecordCedarSystemIntakeUpdateFailure,
+		store.DeleteCedarSystemIntakeUpdate,
+	)
 
 	systemIntakeHandler := handlers.NewSystemIntakeHandler(
 		base,
@@ -185,24 +208,34 @@ func (s *Server) routes(
 			store.FetchSystemIntakeByID,
 			store.UpdateSystemIntake,
 			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			recordFieldChanges,
 		),
 		services.NewFetchSystemIntakeByID(
 			serviceConfig,
 			store.FetchSystemIntakeByID,
-			services.NewAuthorizeHasEASiRole(),
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
 		),
 		services.NewArchiveSystemIntake(
 			serviceConfig,
 			store.FetchSystemIntakeByID,
-			store.UpdateSystemIntake,
+			updateSystemIntakeAndCedar,
 			services.NewCloseBusinessCase(
 				serviceConfig,
 				store.FetchBusinessCaseByID,
 				store.UpdateBusinessCase,
 			),
-			services.NewAuthorizeUserIsIntakeRequester(),
+			services.NewAuthorizeSystemIntake(authz.ActionDelete),
 			emailClient.SendWithdrawRequestEmail,
 		),
+		// patches can carry decisions, which go on to CEDAR
+		services.NewPatchSystemIntake(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			updateSystemIntakeAndCedar,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			services.NewAuthorizeRequireGRTJobCode(),
+			recordFieldChanges,
+		),
 	)
 	api.Handle("/system_intake/{intake_id}", systemIntakeHandler.Handle())
 	api.Handle("/system_intake", systemIntakeHandler.Handle())
@@ -214,17 +247,24 @@ func (s *Server) routes(
 			store.FetchSystemIntakesByEuaID,
 			store.FetchSystemIntakes,
 			store.FetchSystemIntakesByStatuses,
-			services.NewAuthorizeHasEASiRole(),
+			services.NewAuthorize(authz.ActionRead, models.SystemIntakes(nil)),
 		),
 	)
 	api.Handle("/system_intakes", systemIntakesHandler.Handle())
 
+	updateBusinessCase := services.NewUpdateBusinessCase(
+		serviceConfig,
+		store.FetchBusinessCaseByID,
+		services.NewAuthorizeUserIsBusinessCaseRequester(),
+		store.UpdateBusinessCase,
+		recordFieldChanges,
+	)
 	businessCaseHandler := handlers.NewBusinessCaseHandler(
 		base,
 		services.NewFetchBusinessCaseByID(
 			serviceConfig,
 			store.FetchBusinessCaseByID,
-			services.NewAuthorizeHasEASiRole(),
+			services.NewAuthorizeBusinessCase(authz.ActionRead),
 		),
 		services.NewCreateBusinessCase(
 			serviceConfig,
@@ -233,13 +273,15 @@ func (s *Server) routes(
 			store.CreateAction,
 			cedarLDAPClient.FetchUserInfo,
 			store.CreateBusinessCase,
-			store.UpdateSystemIntake,
+			updateSystemIntakeAndCedar,
 		),
-		services.NewUpdateBusinessCase(
+		updateBusinessCase,
+		services.NewPatchBusinessCase(
 			serviceConfig,
 			store.FetchBusinessCaseByID,
-			services.NewAuthorizeUserIsBusinessCaseRequester(),
 			store.UpdateBusinessCase,
+			services.NewAuthorizeUserIsBusinessCaseRequester(),
+			recordFieldChanges,
 		),
 	)
 	api.Handle("/business_case/{business_case_id}", businessCaseHandler.Handle())
@@ -250,7 +292,7 @@ func (s *Server) routes(
 		services.NewFetchBusinessCasesByEuaID(
 			serviceConfig,
 			store.FetchBusinessCasesByEuaID,
-			services.NewAuthorizeHasEASiRole(),
+			services.NewAuthorize(authz.ActionRead, models.BusinessCases(nil)),
 		),
 	)
 	api.Handle("/business_cases", businessCasesHandler.Handle())
@@ -261,277 +303,562 @@ func (s *Server) routes(
 	)
 	api.Handle("/metrics", metricsHandler.Handle())
 
+	auditEventsHandler := handlers.NewAuditEventsHandler(
+		base,
+		services.NewFetchAuditEvents(
+			serviceConfig,
+			services.NewAuthorize(authz.ActionRead, (*models.AuditEvent)(nil)),
+			store.FetchAuditEvents,
+		),
+	)
+	api.Handle("/audit_events", auditEventsHandler.Handle())
+
 	saveAction := services.NewSaveAction(
 		store.CreateAction,
 		cedarLDAPClient.FetchUserInfo,
 	)
-	actionHandler := handlers.NewActionHandler(
-		base,
-		services.NewTakeAction(
-			store.FetchSystemIntakeByID,
-			map[models.ActionType]services.ActionExecuter{
-				models.ActionTypeSUBMITINTAKE: services.NewSubmitSystemIntake(
-					serviceConfig,
-					services.NewAuthorizeUserIsIntakeRequester(),
-					store.UpdateSystemIntake,
-					cedarEasiClient.ValidateAndSubmitSystemIntake,
-					saveAction,
-					emailClient.SendSystemIntakeSubmissionEmail,
-				),
-				models.ActionTypeNOTITREQUEST: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusNOTITREQUEST,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeNEEDBIZCASE: services.NewTakeActionUpdateStatus(
+	takeAction := services.NewTakeAction(
+		store.FetchSystemIntakeByID,
+		map[models.ActionType]services.ActionExecuter{
+			models.ActionTypeSUBMITINTAKE: services.NewSubmitSystemIntake(
+				serviceConfig,
+				services.NewAuthorizeUserIsIntakeRequester(),
+				store.UpdateSystemIntake,
+				services.NewResolveSystemIntakeCollaborators(
 					serviceConfig,
-					models.SystemIntakeStatusNEEDBIZCASE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
 					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					cedarLDAPClient.SearchPeople,
+					store.UpdateSystemIntakeCollaborators,
 				),
-				models.ActionTypeREADYFORGRT: services.NewTakeActionUpdateStatus(
+				cedarEasiClient.ValidateAndSubmitSystemIntake,
+				saveAction,
+				emailClient.SendSystemIntakeSubmissionEmail,
+			),
+			models.ActionTypeNOTITREQUEST: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusNOTITREQUEST,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				true,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusREADYFORGRT,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypePROVIDEFEEDBACKNEEDBIZCASE: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypeNEEDBIZCASE: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusNEEDBIZCASE,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusNEEDBIZCASE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypeREADYFORGRB: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypeREADYFORGRT: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusREADYFORGRT,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusREADYFORGRB,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypeSUBMITBIZCASE: services.NewSubmitBusinessCase(
+			),
+			models.ActionTypePROVIDEFEEDBACKNEEDBIZCASE: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusNEEDBIZCASE,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					services.NewAuthorizeUserIsIntakeRequester(),
-					store.FetchOpenBusinessCaseByIntakeID,
-					appvalidation.BusinessCaseForSubmit,
-					saveAction,
-					store.UpdateSystemIntake,
+					store.FetchBusinessCaseByID,
 					store.UpdateBusinessCase,
-					emailClient.SendBusinessCaseSubmissionEmail,
-					models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
 				),
-				models.ActionTypeSUBMITFINALBIZCASE: services.NewSubmitBusinessCase(
+			),
+			models.ActionTypeREADYFORGRB: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusREADYFORGRB,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					services.NewAuthorizeUserIsIntakeRequester(),
-					store.FetchOpenBusinessCaseByIntakeID,
-					appvalidation.BusinessCaseForSubmit,
-					saveAction,
-					store.UpdateSystemIntake,
+					store.FetchBusinessCaseByID,
 					store.UpdateBusinessCase,
-					emailClient.SendBusinessCaseSubmissionEmail,
-					models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
 				),
-				models.ActionTypeBIZCASENEEDSCHANGES: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypeSUBMITBIZCASE: services.NewSubmitBusinessCase(
+				serviceConfig,
+				services.NewAuthorizeUserIsIntakeRequester(),
+				store.FetchOpenBusinessCaseByIntakeID,
+				appvalidation.BusinessCaseForSubmit,
+				cedarEasiClient.ValidateAndSubmitBusinessCase,
+				saveAction,
+				updateSystemIntakeAndCedar,
+				store.UpdateBusinessCase,
+				emailClient.SendBusinessCaseSubmissionEmail,
+				models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+			),
+			models.ActionTypeSUBMITFINALBIZCASE: services.NewSubmitBusinessCase(
+				serviceConfig,
+				services.NewAuthorizeUserIsIntakeRequester(),
+				store.FetchOpenBusinessCaseByIntakeID,
+				appvalidation.BusinessCaseForSubmit,
+				cedarEasiClient.ValidateAndSubmitBusinessCase,
+				saveAction,
+				updateSystemIntakeAndCedar,
+				store.UpdateBusinessCase,
+				emailClient.SendBusinessCaseSubmissionEmail,
+				models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
+			),
+			models.ActionTypeBIZCASENEEDSCHANGES: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusBIZCASECHANGESNEEDED,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusBIZCASECHANGESNEEDED,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypePROVIDEFEEDBACKBIZCASENEEDSCHANGES: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypePROVIDEFEEDBACKBIZCASENEEDSCHANGES: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusBIZCASECHANGESNEEDED,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusBIZCASECHANGESNEEDED,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypePROVIDEFEEDBACKBIZCASEFINAL: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypePROVIDEFEEDBACKBIZCASEFINAL: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusBIZCASEFINALNEEDED,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusBIZCASEFINALNEEDED,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypeNOGOVERNANCENEEDED: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypeNOGOVERNANCENEEDED: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusNOGOVERNANCE,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				true,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusNOGOVERNANCE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypeSENDEMAIL: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypeSENDEMAIL: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusSHUTDOWNINPROGRESS,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				false,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusSHUTDOWNINPROGRESS,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypeGUIDERECEIVEDCLOSE: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypeGUIDERECEIVEDCLOSE: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusSHUTDOWNCOMPLETE,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				true,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusSHUTDOWNCOMPLETE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-				models.ActionTypeNOTRESPONDINGCLOSE: services.NewTakeActionUpdateStatus(
+			),
+			models.ActionTypeNOTRESPONDINGCLOSE: services.NewTakeActionUpdateStatus(
+				serviceConfig,
+				models.SystemIntakeStatusNOGOVERNANCE,
+				updateSystemIntakeAndCedar,
+				services.NewAuthorizeRequireGRTJobCode(),
+				saveAction,
+				cedarLDAPClient.FetchUserInfo,
+				emailClient.SendSystemIntakeReviewEmail,
+				true,
+				services.NewCloseBusinessCase(
 					serviceConfig,
-					models.SystemIntakeStatusNOGOVERNANCE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
+					store.FetchBusinessCaseByID,
+					store.UpdateBusinessCase,
 				),
-			},
-		),
+			),
+		},
+	)
+	actionHandler := handlers.NewActionHandler(
+		base,
+		takeAction,
 		services.NewFetchActionsByRequestID(
-			services.NewAuthorizeRequireGRTJobCode(),
+			services.NewAuthorize(authz.ActionRead, (*models.Action)(nil)),
 			store.GetActionsByRequestID,
 		),
 	)
 	api.Handle("/system_intake/{intake_id}/actions", actionHandler.Handle())
 
+	updateLifecycleFields := services.NewUpdateLifecycleFields(
+		serviceConfig,
+		services.NewAuthorizeRequireGRTJobCode(),
+		store.FetchSystemIntakeByID,
+		updateSystemIntakeAndCedar,
+		saveAction,
+		cedarLDAPClient.FetchUserInfo,
+		emailClient.SendIssueLCIDEmail,
+		store.GenerateLifecycleID,
+		recordFieldChanges,
+	)
 	systemIntakeLifecycleIDHandler := handlers.NewSystemIntakeLifecycleIDHandler(
 		base,
-		services.NewUpdateLifecycleFields(
-			serviceConfig,
-			services.NewAuthorizeRequireGRTJobCode(),
-			store.FetchSystemIntakeByID,
-			store.UpdateSystemIntake,
-			saveAction,
-			cedarLDAPClient.FetchUserInfo,
-			emailClient.SendIssueLCIDEmail,
-			store.GenerateLifecycleID,
-		),
+		updateLifecycleFields,
 	)
 	api.Handle("/system_intake/{intake_id}/lcid", systemIntakeLifecycleIDHandler.Handle())
 
+	updateRejectionFields := services.NewUpdateRejectionFields(
+		serviceConfig,
+		services.NewAuthorizeRequireGRTJobCode(),
+		store.FetchSystemIntakeByID,
+		updateSystemIntakeAndCedar,
+		saveAction,
+		cedarLDAPClient.FetchUserInfo,
+		emailClient.SendRejectRequestEmail,
+		recordFieldChanges,
+	)
 	systemIntakeRejectionHandler := handlers.NewSystemIntakeRejectionHandler(
 		base,
-		services.NewUpdateRejectionFields(
+		updateRejectionFields,
+	)
+	api.Handle("/system_intake/{intake_id}/reject", systemIntakeRejectionHandler.Handle())
+
+	systemIntakeHistoryHandler := handlers.NewFieldChangesHandler(
+		base,
+		"intake_id",
+		services.NewFetchFieldChanges(
 			serviceConfig,
-			services.NewAuthorizeRequireGRTJobCode(),
+			services.NewAuthorize(authz.ActionRead, (*models.FieldChange)(nil)),
+			"SystemIntake",
+			store.FetchFieldChanges,
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/history", systemIntakeHistoryHandler.Handle())
+
+	businessCaseHistoryHandler := handlers.NewFieldChangesHandler(
+		base,
+		"business_case_id",
+		services.NewFetchFieldChanges(
+			serviceConfig,
+			services.NewAuthorize(authz.ActionRead, (*models.FieldChange)(nil)),
+			"BusinessCase",
+			store.FetchFieldChanges,
+		),
+	)
+	api.Handle("/business_case/{business_case_id}/history", businessCaseHistoryHandler.Handle())
+
+	systemIntakeSoftDeleteHandler := handlers.NewSoftDeleteHandler(
+		base,
+		"intake_id",
+		services.NewSoftDelete(
+			serviceConfig,
+			services.NewAuthorize(authz.ActionRemove, (*models.SystemIntake)(nil)),
+			"SystemIntake",
+			store.SoftDeleteSystemIntake,
+		),
+		services.NewRestore(
+			serviceConfig,
+			services.NewAuthorize(authz.ActionRemove, (*models.SystemIntake)(nil)),
+			"SystemIntake",
+			store.RestoreSystemIntake,
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/delete", systemIntakeSoftDeleteHandler.HandleDelete())
+	api.Handle("/system_intake/{intake_id}/restore", systemIntakeSoftDeleteHandler.HandleRestore())
+
+	businessCaseSoftDeleteHandler := handlers.NewSoftDeleteHandler(
+		base,
+		"business_case_id",
+		services.NewSoftDelete(
+			serviceConfig,
+			services.NewAuthorize(authz.ActionRemove, (*models.BusinessCase)(nil)),
+			"BusinessCase",
+			store.SoftDeleteBusinessCase,
+		),
+		services.NewRestore(
+			serviceConfig,
+			services.NewAuthorize(authz.ActionRemove, (*models.BusinessCase)(nil)),
+			"BusinessCase",
+			store.RestoreBusinessCase,
+		),
+	)
+	api.Handle("/business_case/{business_case_id}/delete", businessCaseSoftDeleteHandler.HandleDelete())
+	api.Handle("/business_case/{business_case_id}/restore", businessCaseSoftDeleteHandler.HandleRestore())
+
+	systemIntakeTransferHandler := handlers.NewSystemIntakeTransferHandler(
+		base,
+		services.NewTransferSystemIntakeOwnership(
+			serviceConfig,
+			services.NewAuthorizeSystemIntake(authz.ActionReview),
 			store.FetchSystemIntakeByID,
-			store.UpdateSystemIntake,
-			saveAction,
 			cedarLDAPClient.FetchUserInfo,
-			emailClient.SendRejectRequestEmail,
+			store.TransferSystemIntakeOwnership,
+			emailClient.SendOwnershipTransferEmail,
 		),
 	)
-	api.Handle("/system_intake/{intake_id}/reject", systemIntakeRejectionHandler.Handle())
+	api.Handle("/system_intake/{intake_id}/transfer", systemIntakeTransferHandler.Handle())
 
+	createNote := services.NewCreateNote(
+		serviceConfig,
+		store.CreateNote,
+		services.NewAuthorize(authz.ActionCreate, (*models.Note)(nil)),
+	)
 	notesHandler := handlers.NewNotesHandler(
 		base,
 		services.NewFetchNotes(
 			serviceConfig,
 			store.FetchNotesBySystemIntakeID,
-			services.NewAuthorizeRequireGRTJobCode(),
+			services.NewAuthorize(authz.ActionRead, (*models.Note)(nil)),
 		),
-		services.NewCreateNote(
+		createNote,
+	)
+	api.Handle("/system_intake/{intake_id}/notes", notesHandler.Handle())
+
+	fundingSourcesHandler := handlers.NewFundingSourcesHandler(
+		base,
+		services.NewFetchSystemIntakeFundingSources(
 			serviceConfig,
-			store.CreateNote,
-			services.NewAuthorizeRequireGRTJobCode(),
+			store.FetchSystemIntakeByID,
+			store.FetchSystemIntakeFundingSourcesByIntakeID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+		),
+		services.NewCreateSystemIntakeFundingSource(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.CreateSystemIntakeFundingSource,
+		),
+		services.NewUpdateSystemIntakeFundingSource(
+			serviceConfig,
+			store.FetchSystemIntakeFundingSourceByID,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.UpdateSystemIntakeFundingSource,
+		),
+		services.NewDeleteSystemIntakeFundingSource(
+			serviceConfig,
+			store.FetchSystemIntakeFundingSourceByID,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.DeleteSystemIntakeFundingSource,
 		),
 	)
-	api.Handle("/system_intake/{intake_id}/notes", notesHandler.Handle())
+	api.Handle("/system_intake/{intake_id}/funding_sources", fundingSourcesHandler.Handle())
+	api.Handle("/system_intake/{intake_id}/funding_sources/{funding_source_id}", fundingSourcesHandler.Handle())
+
+	systemIntakeDelegatesHandler := handlers.NewSystemIntakeDelegatesHandler(
+		base,
+		services.NewFetchSystemIntakeDelegates(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+			store.FetchSystemIntakeDelegatesByIntakeID,
+		),
+		services.NewSaveSystemIntakeDelegate(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionShare),
+			cedarLDAPClient.FetchUserInfo,
+			store.SaveSystemIntakeDelegate,
+			saveAction,
+		),
+		services.NewDeleteSystemIntakeDelegate(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionShare),
+			store.DeleteSystemIntakeDelegate,
+			saveAction,
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/delegates", systemIntakeDelegatesHandler.Handle())
+	api.Handle("/system_intake/{intake_id}/delegates/{eua_user_id}", systemIntakeDelegatesHandler.Handle())
+
+	systemIntakeDocumentsHandler := handlers.NewSystemIntakeDocumentsHandler(
+		base,
+		services.NewFetchSystemIntakeDocuments(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+			store.FetchSystemIntakeDocumentsByIntakeID,
+			s3Client.NewGetPresignedURL,
+			s3Client.TagValueForKey,
+		),
+		services.NewCreateSystemIntakeDocument(
+			serviceConfig,
+			s3Config.Bucket,
+			store.FetchSystemIntakeByID,
+			store.FetchBusinessCaseByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.CreateSystemIntakeDocument,
+			s3Client.NewGetPresignedURL,
+		),
+		services.NewDeleteSystemIntakeDocument(
+			serviceConfig,
+			store.FetchSystemIntakeDocumentByID,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.SoftDeleteSystemIntakeDocument,
+		),
+		services.NewCreateSystemIntakeDocumentUploadURL(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			s3Client.NewPutPresignedURLWithPrefix,
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/documents/upload_url", systemIntakeDocumentsHandler.HandleUploadURL())
+	api.Handle("/system_intake/{intake_id}/documents", systemIntakeDocumentsHandler.Handle())
+	api.Handle("/system_intake/{intake_id}/documents/{document_id}", systemIntakeDocumentsHandler.Handle())
+
+	businessCaseDocumentsHandler := handlers.NewBusinessCaseDocumentsHandler(
+		base,
+		services.NewFetchBusinessCaseDocuments(
+			serviceConfig,
+			store.FetchBusinessCaseByID,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+			store.FetchSystemIntakeDocumentsByBusinessCaseID,
+			s3Client.NewGetPresignedURL,
+			s3Client.TagValueForKey,
+		),
+	)
+	api.Handle("/business_case/{business_case_id}/documents", businessCaseDocumentsHandler.Handle())
+
+	// GraphQL mutations share the services behind the REST handlers
+	resolver := graph.NewResolver(
+		store,
+		graph.ResolverService{
+			CreateNote: createNote,
+			CreateTestDate: services.NewCreateTestDate(
+				serviceConfig,
+				services.NewAuthorizeHasEASiRole(),
+				store.CreateTestDate,
+			),
+			DeleteTestDate: services.NewDeleteTestDate(
+				serviceConfig,
+				services.NewAuthorizeHasEASiRole(),
+				store.FetchTestDateByID,
+				store.SoftDeleteTestDate,
+				recordFieldChanges,
+			),
+			FetchActionsByRequestID: services.NewFetchActionsByRequestID(
+				services.NewAuthorize(authz.ActionRead, (*models.Action)(nil)),
+				store.GetActionsByRequestID,
+			),
+			FetchBusinessCaseByID: services.NewFetchBusinessCaseByID(
+				serviceConfig,
+				store.FetchBusinessCaseByID,
+				services.NewAuthorizeBusinessCase(authz.ActionRead),
+			),
+			FetchNotes: services.NewFetchNotes(
+				serviceConfig,
+				store.FetchNotesBySystemIntakeID,
+				services.NewAuthorize(authz.ActionRead, (*models.Note)(nil)),
+			),
+			FetchSystemIntakeByID: services.NewFetchSystemIntakeByID(
+				serviceConfig,
+				store.FetchSystemIntakeByID,
+				services.NewAuthorizeSystemIntake(authz.ActionRead),
+			),
+			FetchSystemIntakes: services.NewFetchSystemIntakes(
+				serviceConfig,
+				store.FetchSystemIntakesByEuaID,
+				store.FetchSystemIntakes,
+				store.FetchSystemIntakesByStatuses,
+				services.NewAuthorize(authz.ActionRead, models.SystemIntakes(nil)),
+			),
+			IssueLifecycleID:   updateLifecycleFields,
+			RejectIntake:       updateRejectionFields,
+			TakeAction:         takeAction,
+			UpdateBusinessCase: updateBusinessCase,
+			UpdateTestDate: services.NewUpdateTestDate(
+				serviceConfig,
+				services.NewAuthorizeHasEASiRole(),
+				store.FetchTestDateByID,
+				store.UpdateTestDate,
+				recordFieldChanges,
+			),
+		},
+		&s3Client,
+	)
+	gqlDirectives := generated.DirectiveRoot{HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (res interface{}, err error) {
+		hasRole, err := services.HasRole(ctx, role)
+		if err != nil {
+			return nil, err
+		}
+		if !hasRole {
+			// resolvers audit what they do, but a resolver this refuses is never called
+			field := graphql.GetFieldContext(ctx)
+			audit.Record(ctx, authz.Action(field.Field.Name), field.Object, "", &apperrors.UnauthorizedError{
+				Err: fmt.Errorf("does not have role %s", role),
+			})
+			return nil, errors.New("not authorized")
+		}
+		return next(ctx)
+	}}
+	gqlConfig := generated.Config{Resolvers: resolver, Directives: gqlDirectives}
+	graphqlServer := handler.NewDefaultServer(generated.NewExecutableSchema(gqlConfig))
+	gql.Handle("/query", graphqlServer)
 
 	// File Upload Handlers
 	fileUploadHandler := handlers.NewFileUploadHandler(
@@ -572,7 +899,50 @@ func (s *Server) routes(
 		base,
 	).Handle())
 
-	api.Handle("/pdf/generate", handlers.NewPDFHandler(services.NewInvokeGeneratePDF(serviceConfig, lambdaClient, princeLambdaName)).Handle())
+	generatePDF := services.NewInvokeGeneratePDF(serviceConfig, pdfGenerator, pdfConfig.Limits)
+
+	systemIntakePDFHandler := handlers.NewRecordPDFHandler(
+		base,
+		"intake_id",
+		services.NewGenerateSystemIntakePDF(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+			pdfRenderer.SystemIntakeHTML,
+			generatePDF,
+			pdfCache,
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/pdf", systemIntakePDFHandler.Handle())
+
+	decisionPDFHandler := handlers.NewRecordPDFHandler(
+		base,
+		"intake_id",
+		services.NewGenerateDecisionPDF(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+			pdfRenderer.DecisionHTML,
+			generatePDF,
+			pdfCache,
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/decision/pdf", decisionPDFHandler.Handle())
+
+	businessCasePDFHandler := handlers.NewRecordPDFHandler(
+		base,
+		"business_case_id",
+		services.NewGenerateBusinessCasePDF(
+			serviceConfig,
+			store.FetchBusinessCaseByID,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeSystemIntake(authz.ActionRead),
+			pdfRenderer.BusinessCaseHTML,
+			generatePDF,
+			pdfCache,
+		),
+	)
+	api.Handle("/business_case/{business_case_id}/pdf", businessCasePDFHandler.Handle())
 
 	systemsHandler := handlers.NewSystemsHandler(
 		base,
@@ -584,6 +954,32 @@ func (s *Server) routes(
 	)
 	api.Handle("/systems", systemsHandler.Handle())
 
+	peopleHandler := handlers.NewPeopleHandler(
+		base,
+		services.NewSearchPeople(
+			serviceConfig,
+			cedarLDAPClient.SearchPeople,
+			services.NewAuthorizeHasEASiRole(),
+		),
+	)
+	api.Handle("/people", peopleHandler.Handle())
+
+	userInfoCacheHandler := handlers.NewUserInfoCacheHandler(
+		base,
+		services.NewFetchUserInfoCacheStats(
+			serviceConfig,
+			cedarLDAPClient.Stats,
+			services.NewAuthorizeRequireGRTJobCode(),
+		),
+		services.NewEvictUserInfoCacheEntry(
+			serviceConfig,
+			cedarLDAPClient.Evict,
+			services.NewAuthorizeRequireGRTJobCode(),
+		),
+	)
+	api.Handle("/cedar/user_info_cache", userInfoCacheHandler.Handle())
+	api.Handle("/cedar/user_info_cache/{eua_id}", userInfoCacheHandler.Handle())
+
 	if ok, _ := strconv.ParseBool(os.Getenv("DEBUG_ROUTES")); ok {
 		// useful for debugging route issues
 		_ = s.router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
@@ -2,9 +2,11 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/oklog/run"
@@ -12,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/handlers"
 	"github.com/cmsgov/easi-app/pkg/local"
 	"github.com/cmsgov/easi-app/pkg/okta"
@@ -23,22 +26,18 @@ type Server struct {
 	Config      *viper.Viper
 	logger      *zap.Logger
 	environment appconfig.Environment
+
+	retryCedarUpdates func(context.Context) error
+	purgeAuditEvents  func(context.Context) error
 }
 
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.router.ServeHTTP(w, r)
 }
 
-// NewServer sets up the dependencies for a server
-func NewServer(config *viper.Viper) *Server {
-
-	// Set environment from config
-	environment, err := appconfig.NewEnvironment(config.GetString(appconfig.EnvironmentKey))
-	if err != nil {
-		log.Fatalf("Unable to set environment: %v", err)
-	}
-
+func newLogger(environment appconfig.Environment) *zap.Logger {
 	var zapLogger *zap.Logger
+	var err error
 	if environment.Dev() || environment.Local() {
 		zapLogger, err = zap.NewDevelopment()
 	} else {
@@ -47,6 +46,19 @@ func NewServer(config *viper.Viper) *Server {
 	if err != nil {
 		log.Fatalf("Failed to initial logger: %v", err)
 	}
+	return zapLogger
+}
+
+// NewServer sets up the dependencies for a server
+func NewServer(config *viper.Viper) *Server {
+
+	// Set environment from config
+	environment, err := appconfig.NewEnvironment(config.GetString(appconfig.EnvironmentKey))
+	if err != nil {
+		log.Fatalf("Unable to set environment: %v", err)
+	}
+
+	zapLogger := newLogger(environment)
 
 	// Set the router
 	r := mux.NewRouter()
@@ -121,5 +133,38 @@ func Serve(config *viper.Viper) {
 		s.logger.Info("Entered https server interrupt function")
 	})
 
+	retryCtx, cancelRetries := context.WithCancel(context.Background())
+	g.Add(func() error {
+		s.logger.Info("Retrying failed CEDAR updates in the background")
+		return s.runPeriodically(retryCtx, s.CEDARRetryInterval(), "Failed to retry CEDAR updates", s.retryCedarUpdates)
+	}, func(error) {
+		cancelRetries()
+	})
+
+	purgeCtx, cancelPurges := context.WithCancel(context.Background())
+	g.Add(func() error {
+		s.logger.Info("Purging expired audit events in the background")
+		return s.runPeriodically(purgeCtx, time.Hour, "Failed to purge audit events", s.purgeAuditEvents)
+	}, func(error) {
+		cancelPurges()
+	})
+
 	log.Fatal(g.Run())
 }
+
+// runPeriodically runs a background job on an interval, until the context is canceled
+func (s *Server) runPeriodically(ctx context.Context, interval time.Duration, failureMessage string, job func(context.Context) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			jobCtx := appcontext.WithLogger(ctx, s.logger)
+			if err := job(jobCtx); err != nil {
+				s.logger.Error(failureMessage, zap.Error(err))
+			}
+		}
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -82,11 +84,15 @@ func NewSubmitSystemIntake(
 	config Config,
 	authorize func(context.Context, *models.SystemIntake) (bool, error),
 	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	resolveCollaborators func(context.Context, *models.SystemIntake),
 	validateAndSubmit func(context.Context, *models.SystemIntake) (string, error),
 	saveAction func(context.Context, *models.Action) error,
 	emailReviewer func(ctx context.Context, requestName string, intakeID uuid.UUID) error,
 ) ActionExecuter {
-	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (err error) {
+		intakeID := intake.ID.String()
+		defer func() { audit.Record(ctx, authz.ActionSubmit, "SystemIntake", intakeID, err) }()
+
 		ok, err := authorize(ctx, intake)
 		if err != nil {
 			return err
@@ -109,6 +115,7 @@ func NewSubmitSystemIntake(
 		}
 
 		intake.SubmittedAt = &updatedTime
+		resolveCollaborators(ctx, intake)
 		alfabetID, validateAndSubmitErr := validateAndSubmit(ctx, intake)
 		if validateAndSubmitErr != nil {
 			return validateAndSubmitErr
@@ -149,13 +156,17 @@ func NewSubmitBusinessCase(
 	authorize func(context.Context, *models.SystemIntake) (bool, error),
 	fetchOpenBusinessCase func(context.Context, uuid.UUID) (*models.BusinessCase, error),
 	validateForSubmit func(businessCase *models.BusinessCase) error,
+	validateAndSubmit func(context.Context, *models.BusinessCase) (string, error),
 	saveAction func(context.Context, *models.Action) error,
 	updateIntake func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
 	updateBusinessCase func(context.Context, *models.BusinessCase) (*models.BusinessCase, error),
 	sendEmail func(ctx context.Context, requestName string, intakeID uuid.UUID) error,
 	newIntakeStatus models.SystemIntakeStatus,
 ) ActionExecuter {
-	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (err error) {
+		intakeID := intake.ID.String()
+		defer func() { audit.Record(ctx, authz.ActionSubmit, "BusinessCase", intakeID, err) }()
+
 		ok, err := authorize(ctx, intake)
 		if err != nil {
 			return err
@@ -191,21 +202,44 @@ func NewSubmitBusinessCase(
 			}
 		}
 
-		err = saveAction(ctx, action)
+		if newIntakeStatus == models.SystemIntakeStatusBIZCASEFINALSUBMITTED {
+			cedarID, validateAndSubmitErr := validateAndSubmit(ctx, businessCase)
+			if validateAndSubmitErr != nil {
+				return validateAndSubmitErr
+			}
+			// nothing comes back when we aren't emitting to CEDAR
+			if cedarID != "" {
+				businessCase.CedarID = null.StringFrom(cedarID)
+			}
+		}
+
+		// save the CEDAR ID before anything else can fail,
+		// so submitting again updates the business case in CEDAR instead of adding another
+		savedBusinessCase, err := updateBusinessCase(ctx, businessCase)
 		if err != nil {
+			if businessCase.CedarID.Valid {
+				// CEDAR has the business case now, so this needs to be fixed by hand
+				appcontext.ZLogger(ctx).Error(
+					"Failed to save CEDAR ID",
+					zap.String("businessCaseID", businessCase.ID.String()),
+					zap.String("cedarID", businessCase.CedarID.String),
+					zap.Error(err),
+				)
+			}
 			return &apperrors.QueryError{
 				Err:       err,
-				Model:     action,
-				Operation: apperrors.QueryPost,
+				Model:     businessCase,
+				Operation: apperrors.QuerySave,
 			}
 		}
+		businessCase = savedBusinessCase
 
-		businessCase, err = updateBusinessCase(ctx, businessCase)
+		err = saveAction(ctx, action)
 		if err != nil {
 			return &apperrors.QueryError{
 				Err:       err,
-				Model:     businessCase,
-				Operation: apperrors.QuerySave,
+				Model:     action,
+				Operation: apperrors.QueryPost,
 			}
 		}
 
@@ -242,7 +276,10 @@ func NewTakeActionUpdateStatus(
 	shouldCloseBusinessCase bool,
 	closeBusinessCase func(context.Context, uuid.UUID) error,
 ) ActionExecuter {
-	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (err error) {
+		intakeID := intake.ID.String()
+		defer func() { audit.Record(ctx, authz.ActionReview, "SystemIntake", intakeID, err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return err
@@ -289,10 +326,14 @@ func NewTakeActionUpdateStatus(
 			}
 		}
 
-		err = sendReviewEmail(ctx, action.Feedback.String, requesterInfo.Email)
+		sendEmail := func(ctx context.Context, recipient string) error {
+			return sendReviewEmail(ctx, action.Feedback.String, recipient)
+		}
+		err = sendEmail(ctx, requesterInfo.Email)
 		if err != nil {
 			return err
 		}
+		notifyCollaborators(ctx, intake, action, requesterInfo.Email, sendEmail)
 
 		return nil
 	}
@@ -303,7 +344,9 @@ func NewFetchActionsByRequestID(
 	authorize func(context.Context) (bool, error),
 	fetch func(context.Context, uuid.UUID) ([]models.Action, error),
 ) func(context.Context, uuid.UUID) ([]models.Action, error) {
-	return func(ctx context.Context, intakeID uuid.UUID) ([]models.Action, error) {
+	return func(ctx context.Context, intakeID uuid.UUID) (_ []models.Action, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "Actions", intakeID.String(), err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
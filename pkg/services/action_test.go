@@ -75,6 +75,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		return nil
 	}
 
+	resolveCollaborators := func(ctx context.Context, intake *models.SystemIntake) {}
 	submit := func(c context.Context, intake *models.SystemIntake) (string, error) {
 		return "ALFABET-ID", nil
 	}
@@ -87,7 +88,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 	s.Run("golden path submit intake", func() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, resolveCollaborators, submit, saveAction, sendSubmitEmail)
 		s.Equal(0, submitEmailCount)
 
 		err := submitSystemIntake(ctx, &intake, &action)
@@ -106,7 +107,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		failAuthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, authorizationError
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, failAuthorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, failAuthorize, update, resolveCollaborators, submit, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.Equal(authorizationError, err)
@@ -118,7 +119,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		unauthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, nil
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, unauthorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, unauthorize, update, resolveCollaborators, submit, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.UnauthorizedError{}, err)
@@ -130,7 +131,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		failCreateAction := func(ctx context.Context, action *models.Action) error {
 			return errors.New("error")
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, submit, failCreateAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, resolveCollaborators, submit, failCreateAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -146,7 +147,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 				Model:   intake,
 			}
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, failValidationSubmit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, resolveCollaborators, failValidationSubmit, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.ValidationError{}, err)
@@ -165,7 +166,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 				Source:    "CEDAR",
 			}
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, failValidationSubmit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, resolveCollaborators, failValidationSubmit, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.ExternalAPIError{}, err)
@@ -178,7 +179,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 			AlfabetID: null.StringFrom("394-141-0"),
 		}
 		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, resolveCollaborators, submit, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &alreadySubmittedIntake, &action)
 
 		s.IsType(&apperrors.ResourceConflictError{}, err)
@@ -191,7 +192,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		failUpdate := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("update error")
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, failUpdate, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, failUpdate, resolveCollaborators, submit, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -220,6 +221,12 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		return nil
 	}
 
+	cedarSubmitCount := 0
+	submitToCedar := func(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+		cedarSubmitCount++
+		return "CEDAR-123", nil
+	}
+
 	saveAction := func(ctx context.Context, action *models.Action) error {
 		return nil
 	}
@@ -233,7 +240,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
 		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
 		s.Equal(0, submitEmailCount)
 
 		err := submitBusinessCase(ctx, &intake, &action)
@@ -248,7 +255,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
 		status := models.SystemIntakeStatusBIZCASEFINALSUBMITTED
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
 		s.Equal(0, submitEmailCount)
 
 		err := submitBusinessCase(ctx, &intake, &action)
@@ -268,7 +275,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failAuthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, authorizationError
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, failAuthorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, failAuthorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.Equal(authorizationError, err)
@@ -281,7 +288,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		unauthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, nil
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, unauthorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, unauthorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.UnauthorizedError{}, err)
@@ -294,7 +301,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failCreateAction := func(ctx context.Context, action *models.Action) error {
 			return errors.New("error")
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, failCreateAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, failCreateAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -311,7 +318,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 				Model:   businessCase,
 			}
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, failValidation, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, failValidation, submitToCedar, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.NoError(err)
@@ -334,13 +341,87 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		fetchOpenBusinessCase = func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
 			return &models.BusinessCase{SystemIntakeStatus: intake.Status}, nil
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, failValidation, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, failValidation, submitToCedar, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.ValidationError{}, err)
 		s.Equal(0, submitEmailCount)
 	})
 
+	s.Run("submits a final Biz Case to CEDAR and stores the CEDAR ID", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEFINALNEEDED}
+		action := models.Action{ActionType: models.ActionTypeSUBMITFINALBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEFINALSUBMITTED
+		var savedBusinessCase *models.BusinessCase
+		saveBusinessCase := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+			savedBusinessCase = businessCase
+			return businessCase, nil
+		}
+		cedarSubmitCount = 0
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, updateIntake, saveBusinessCase, sendSubmitEmail, status)
+		err := submitBusinessCase(ctx, &intake, &action)
+
+		s.NoError(err)
+		s.Equal(1, cedarSubmitCount)
+		s.Equal(null.StringFrom("CEDAR-123"), savedBusinessCase.CedarID)
+
+		submitEmailCount = 0
+	})
+
+	s.Run("keeps the CEDAR ID when saving the action fails", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEFINALNEEDED}
+		action := models.Action{ActionType: models.ActionTypeSUBMITFINALBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEFINALSUBMITTED
+		var savedBusinessCase *models.BusinessCase
+		saveBusinessCase := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+			savedBusinessCase = businessCase
+			return businessCase, nil
+		}
+		failCreateAction := func(ctx context.Context, action *models.Action) error {
+			return errors.New("error")
+		}
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, failCreateAction, updateIntake, saveBusinessCase, sendSubmitEmail, status)
+		err := submitBusinessCase(ctx, &intake, &action)
+
+		s.IsType(&apperrors.QueryError{}, err)
+		s.Equal(null.StringFrom("CEDAR-123"), savedBusinessCase.CedarID)
+		s.Equal(0, submitEmailCount)
+	})
+
+	s.Run("does not submit a draft Biz Case to CEDAR", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusNEEDBIZCASE}
+		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
+		cedarSubmitCount = 0
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		err := submitBusinessCase(ctx, &intake, &action)
+
+		s.NoError(err)
+		s.Equal(0, cedarSubmitCount)
+
+		submitEmailCount = 0
+	})
+
+	s.Run("returns error and saves nothing if CEDAR submission fails", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEFINALNEEDED}
+		action := models.Action{ActionType: models.ActionTypeSUBMITFINALBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEFINALSUBMITTED
+		failSubmitToCedar := func(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+			return "", &apperrors.ExternalAPIError{Err: errors.New("CEDAR error"), Source: "CEDAR"}
+		}
+		updateCount := 0
+		countUpdateBusinessCase := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+			updateCount++
+			return businessCase, nil
+		}
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, failSubmitToCedar, saveAction, updateIntake, countUpdateBusinessCase, sendSubmitEmail, status)
+		err := submitBusinessCase(ctx, &intake, &action)
+
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+		s.Equal(0, updateCount)
+		s.Equal(0, submitEmailCount)
+	})
+
 	s.Run("returns query error if update intake fails", func() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
@@ -348,7 +429,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failUpdateIntake := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("update error")
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, failUpdateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, failUpdateIntake, updateBusinessCase, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -361,7 +442,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failUpdateBizCase := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 			return &models.BusinessCase{}, errors.New("update error")
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, failUpdateBizCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveAction, updateIntake, failUpdateBizCase, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewFetchAuditEvents is a service to fetch audit events for the GRT
+func NewFetchAuditEvents(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, models.AuditEventFilter) (models.AuditEvents, error),
+) func(context.Context, models.AuditEventFilter) (models.AuditEvents, error) {
+	return func(ctx context.Context, filter models.AuditEventFilter) (_ models.AuditEvents, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "AuditEvents", "", err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch audit events")}
+		}
+		if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+			valErr := apperrors.NewValidationError(
+				errors.New("audit event filter failed validation"),
+				models.AuditEvents{},
+				"",
+			)
+			valErr.WithValidation("from", "must be before to")
+			return nil, &valErr
+		}
+		return fetch(ctx, filter)
+	}
+}
+
+// NewPurgeAuditEvents returns a function that removes audit events older than the retention period.
+// A retention of zero keeps audit events forever.
+func NewPurgeAuditEvents(
+	config Config,
+	retention time.Duration,
+	purge func(context.Context, time.Time) (int64, error),
+) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if retention <= 0 {
+			return nil
+		}
+		deleted, err := purge(ctx, config.clock.Now().Add(-retention))
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			appcontext.ZLogger(ctx).Info("Purged audit events", zap.Int64("deleted", deleted))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/facebookgo/clock"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestFetchAuditEvents() {
+	cfg := NewConfig(nil, nil)
+	fetch := func(context.Context, models.AuditEventFilter) (models.AuditEvents, error) {
+		return models.AuditEvents{{Action: "read"}}, nil
+	}
+	fetchAuditEvents := NewFetchAuditEvents(cfg, NewAuthorize(authz.ActionRead, (*models.AuditEvent)(nil)), fetch)
+
+	s.Run("the GRT can fetch audit events", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+		events, err := fetchAuditEvents(ctx, models.AuditEventFilter{})
+
+		s.NoError(err)
+		s.Len(events, 1)
+	})
+
+	s.Run("requesters cannot fetch audit events", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchAuditEvents(ctx, models.AuditEventFilter{})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("the time range must not be backwards", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+		from := time.Now()
+		to := from.Add(-time.Hour)
+
+		_, err := fetchAuditEvents(ctx, models.AuditEventFilter{From: &from, To: &to})
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestPurgeAuditEvents() {
+	cfg := NewConfig(nil, nil)
+	mockClock := clock.NewMock()
+	cfg.clock = mockClock
+	ctx := context.Background()
+
+	s.Run("purges events older than the retention period", func() {
+		var cutoff time.Time
+		purge := func(_ context.Context, before time.Time) (int64, error) {
+			cutoff = before
+			return 2, nil
+		}
+
+		err := NewPurgeAuditEvents(cfg, 24*time.Hour, purge)(ctx)
+
+		s.NoError(err)
+		s.Equal(mockClock.Now().Add(-24*time.Hour), cutoff)
+	})
+
+	s.Run("keeps events forever without a retention period", func() {
+		purge := func(context.Context, time.Time) (int64, error) {
+			s.Fail("should not purge")
+			return 0, nil
+		}
+
+		s.NoError(NewPurgeAuditEvents(cfg, 0, purge)(ctx))
+	})
+}
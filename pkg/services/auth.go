@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/graph/model"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
@@ -49,29 +50,37 @@ func HasRole(ctx context.Context, role model.Role) (bool, error) {
 	}
 }
 
+// NewAuthorizeSystemIntake returns a function
+// that authorizes a user to take the action on the given System Intake
+func NewAuthorizeSystemIntake(action authz.Action) func(context.Context, *models.SystemIntake) (bool, error) {
+	return func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
+		return authz.Authorize(ctx, action, intake), nil
+	}
+}
+
+// NewAuthorizeBusinessCase returns a function
+// that authorizes a user to take the action on the given Business Case
+func NewAuthorizeBusinessCase(action authz.Action) func(context.Context, *models.BusinessCase) (bool, error) {
+	return func(ctx context.Context, businessCase *models.BusinessCase) (bool, error) {
+		return authz.Authorize(ctx, action, businessCase), nil
+	}
+}
+
+// NewAuthorize returns a function that authorizes a user to take the action
+// on any resource of the given type, e.g. (*models.Note)(nil)
+func NewAuthorize(action authz.Action, resource interface{}) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		return authz.Authorize(ctx, action, resource), nil
+	}
+}
+
 // NewAuthorizeUserIsIntakeRequester returns a function
 // that authorizes a user as being the requester of the given System Intake
 func NewAuthorizeUserIsIntakeRequester() func(
 	context.Context,
 	*models.SystemIntake,
 ) (bool, error) {
-	return func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
-		logger := appcontext.ZLogger(ctx)
-		principal := appcontext.Principal(ctx)
-		if !principal.AllowEASi() {
-			logger.Info("does not have EASi job code")
-			return false, nil
-		}
-
-		// If intake is owned by user, authorize
-		if principal.ID() == intake.EUAUserID.ValueOrZero() {
-			return true, nil
-		}
-		// Default to failure to authorize and create a quick audit log
-		logger.With(zap.Bool("Authorized", false)).
-			Info("user unauthorized as owning the system intake")
-		return false, nil
-	}
+	return NewAuthorizeSystemIntake(authz.ActionSubmit)
 }
 
 // NewAuthorizeUserIsBusinessCaseRequester returns a function
@@ -80,23 +89,7 @@ func NewAuthorizeUserIsBusinessCaseRequester() func(
 	context.Context,
 	*models.BusinessCase,
 ) (bool, error) {
-	return func(ctx context.Context, bizCase *models.BusinessCase) (bool, error) {
-		logger := appcontext.ZLogger(ctx)
-		principal := appcontext.Principal(ctx)
-		if !principal.AllowEASi() {
-			logger.Info("does not have EASi job code")
-			return false, nil
-		}
-
-		// If business case is owned by user, authorize
-		if principal.ID() == bizCase.EUAUserID {
-			return true, nil
-		}
-		// Default to failure to authorize and create a quick audit log
-		logger.With(zap.Bool("Authorized", false)).
-			Info("user unauthorized as owning the business case")
-		return false, nil
-	}
+	return NewAuthorizeBusinessCase(authz.ActionUpdate)
 }
 
 // NewAuthorizeHasEASiRole creates an authorizer that the user can use EASi
@@ -120,36 +113,12 @@ func NewAuthorizeHasEASiRole() func(
 // that authorizes a user as being a member of the
 // GRT (Governance Review Team)
 func NewAuthorizeRequireGRTJobCode() func(context.Context) (bool, error) {
-	return func(ctx context.Context) (bool, error) {
-		logger := appcontext.ZLogger(ctx)
-		principal := appcontext.Principal(ctx)
-		if !principal.AllowGRT() {
-			logger.Info("not a member of the GRT")
-			return false, nil
-		}
-		return true, nil
-	}
+	return NewAuthorize(authz.ActionReview, (*models.SystemIntake)(nil))
 }
 
 // NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode returns a function
-// that authorizes a user as being a member of the
-// GRT (Governance Review Team)
+// that authorizes a user as being the requester of the given System Intake
+// or a member of the GRT (Governance Review Team)
 func NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode() func(context.Context, *models.SystemIntake) (bool, error) {
-	return func(ctx context.Context, existingIntake *models.SystemIntake) (bool, error) {
-		authorIsAuthed, errAuthor := NewAuthorizeUserIsIntakeRequester()(ctx, existingIntake)
-		if errAuthor != nil {
-			return false, errAuthor
-		}
-
-		reviewerIsAuthed, errReviewer := NewAuthorizeRequireGRTJobCode()(ctx)
-		if errReviewer != nil {
-			return false, errReviewer
-		}
-
-		if !authorIsAuthed && !reviewerIsAuthed {
-			return false, errAuthor
-		}
-
-		return true, nil
-	}
+	return NewAuthorizeSystemIntake(authz.ActionUpdate)
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/appvalidation"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -17,9 +19,11 @@ import (
 func NewFetchBusinessCaseByID(
 	config Config,
 	fetch func(c context.Context, id uuid.UUID) (*models.BusinessCase, error),
-	authorize func(context.Context) (bool, error),
+	authorize func(context.Context, *models.BusinessCase) (bool, error),
 ) func(c context.Context, id uuid.UUID) (*models.BusinessCase, error) {
-	return func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ *models.BusinessCase, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "BusinessCase", id.String(), err) }()
+
 		logger := appcontext.ZLogger(ctx)
 		businessCase, err := fetch(ctx, id)
 		if err != nil {
@@ -30,7 +34,7 @@ func NewFetchBusinessCaseByID(
 				Operation: apperrors.QueryFetch,
 			}
 		}
-		ok, err := authorize(ctx)
+		ok, err := authorize(ctx, businessCase)
 		if err != nil {
 			logger.Error("failed to authorize fetch business case")
 			return &models.BusinessCase{}, err
@@ -52,7 +56,10 @@ func NewCreateBusinessCase(
 	createBizCase func(context.Context, *models.BusinessCase) (*models.BusinessCase, error),
 	updateIntake func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
 ) func(c context.Context, b *models.BusinessCase) (*models.BusinessCase, error) {
-	return func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+	return func(ctx context.Context, businessCase *models.BusinessCase) (_ *models.BusinessCase, err error) {
+		var businessCaseID string
+		defer func() { audit.Record(ctx, authz.ActionCreate, "BusinessCase", businessCaseID, err) }()
+
 		intake, err := fetchIntake(ctx, businessCase.SystemIntakeID)
 		if err != nil {
 			// We return an empty id in this error because the business case hasn't been created
@@ -116,6 +123,7 @@ func NewCreateBusinessCase(
 		if businessCase, err = createBizCase(ctx, businessCase); err != nil {
 			return &models.BusinessCase{}, err
 		}
+		businessCaseID = businessCase.ID.String()
 
 		intake.Status = models.SystemIntakeStatusBIZCASEDRAFT
 		intake.UpdatedAt = &now
@@ -133,7 +141,9 @@ func NewFetchBusinessCasesByEuaID(
 	fetch func(c context.Context, euaID string) (models.BusinessCases, error),
 	authorize func(c context.Context) (bool, error),
 ) func(c context.Context, euaID string) (models.BusinessCases, error) {
-	return func(ctx context.Context, euaID string) (models.BusinessCases, error) {
+	return func(ctx context.Context, euaID string) (_ models.BusinessCases, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "BusinessCases", euaID, err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			appcontext.ZLogger(ctx).Error("failed to authorize fetch system intakes")
@@ -161,8 +171,12 @@ func NewUpdateBusinessCase(
 	fetchBusinessCase func(c context.Context, id uuid.UUID) (*models.BusinessCase, error),
 	authorize func(c context.Context, b *models.BusinessCase) (bool, error),
 	update func(c context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error),
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
 ) func(c context.Context, b *models.BusinessCase) (*models.BusinessCase, error) {
-	return func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+	return func(ctx context.Context, businessCase *models.BusinessCase) (_ *models.BusinessCase, err error) {
+		businessCaseID := businessCase.ID.String()
+		defer func() { audit.Record(ctx, authz.ActionUpdate, "BusinessCase", businessCaseID, err) }()
+
 		logger := appcontext.ZLogger(ctx)
 		existingBusinessCase, err := fetchBusinessCase(ctx, businessCase.ID)
 		if err != nil {
@@ -184,10 +198,24 @@ func NewUpdateBusinessCase(
 		//if err != nil {
 		//	return &models.BusinessCase{}, err
 		//}
+		if businessCase.LifecycleCostHorizon == 0 {
+			businessCase.LifecycleCostHorizon = existingBusinessCase.LifecycleCostHorizon
+		}
+		if businessCase.LifecycleCostPhases == nil {
+			businessCase.LifecycleCostPhases = existingBusinessCase.LifecycleCostPhases
+		}
+		err = appvalidation.LifecycleCostConfigurationForSave(businessCase)
+		if err != nil {
+			return &models.BusinessCase{}, err
+		}
 		updatedAt := config.clock.Now()
 		businessCase.UpdatedAt = &updatedAt
 
 		businessCase, err = update(ctx, businessCase)
+		var conflictErr *apperrors.ResourceConflictError
+		if errors.As(err, &conflictErr) {
+			return &models.BusinessCase{}, err
+		}
 		if err != nil {
 			logger.Error("failed to update business case")
 			return &models.BusinessCase{}, &apperrors.QueryError{
@@ -197,6 +225,11 @@ func NewUpdateBusinessCase(
 			}
 		}
 
+		// lifecycle cost lines are recreated on save, so compare against what was saved
+		if saved, fetchErr := fetchBusinessCase(ctx, businessCase.ID); fetchErr == nil {
+			recordChanges(ctx, existingBusinessCase, saved)
+		}
+
 		return businessCase, nil
 	}
 }
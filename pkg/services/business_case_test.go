@@ -9,7 +9,9 @@ import (
 	"github.com/guregu/null"
 	"go.uber.org/zap"
 
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 )
@@ -19,7 +21,7 @@ func (s ServicesTestSuite) TestBusinessCaseByIDFetcher() {
 	fakeID := uuid.New()
 	serviceConfig := NewConfig(logger, nil)
 	serviceConfig.clock = clock.NewMock()
-	authorize := func(context context.Context) (bool, error) { return true, nil }
+	authorize := func(context.Context, *models.BusinessCase) (bool, error) { return true, nil }
 
 	s.Run("successfully fetches Business Case by ID without an error", func() {
 		fetch := func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
@@ -45,6 +47,18 @@ func (s ServicesTestSuite) TestBusinessCaseByIDFetcher() {
 		s.IsType(&apperrors.QueryError{}, err)
 		s.Equal(&models.BusinessCase{}, businessCase)
 	})
+
+	s.Run("returns unauthorized for another requester's business case", func() {
+		fetch := func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+			return &models.BusinessCase{ID: id, EUAUserID: "ABCD"}, nil
+		}
+		fetchBusinessCaseByID := NewFetchBusinessCaseByID(serviceConfig, fetch, NewAuthorizeBusinessCase(authz.ActionRead))
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchBusinessCaseByID(ctx, fakeID)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
 }
 
 func (s ServicesTestSuite) TestBusinessCasesByEuaIDFetcher() {
@@ -242,7 +256,7 @@ func (s ServicesTestSuite) TestBusinessCaseUpdater() {
 	}
 
 	s.Run("successfully updates a Business Case without an error", func() {
-		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, fetch, authorize, update)
+		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, fetch, authorize, update, noRecordFieldChanges)
 
 		businessCase, err := updateBusinessCase(ctx, &existingBusinessCase)
 
@@ -254,13 +268,42 @@ func (s ServicesTestSuite) TestBusinessCaseUpdater() {
 		failUpdate := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 			return &models.BusinessCase{}, errors.New("creation failed")
 		}
-		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, fetch, authorize, failUpdate)
+		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, fetch, authorize, failUpdate, noRecordFieldChanges)
 		businessCase, err := updateBusinessCase(ctx, &existingBusinessCase)
 
 		s.IsType(&apperrors.QueryError{}, err)
 		s.Equal(&models.BusinessCase{}, businessCase)
 	})
 
+	s.Run("keeps the existing lifecycle cost configuration when it isn't given", func() {
+		existingBusinessCase.LifecycleCostHorizon = 10
+		existingBusinessCase.LifecycleCostPhases = models.LifecycleCostPhases{models.LifecycleCostPhaseDEVELOPMENT}
+		incoming := testhelpers.NewBusinessCase()
+		incoming.ID = existingBusinessCase.ID
+		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, fetch, authorize, update, noRecordFieldChanges)
+
+		businessCase, err := updateBusinessCase(ctx, &incoming)
+
+		s.NoError(err)
+		s.Equal(10, businessCase.LifecycleCostHorizon)
+		s.Equal(existingBusinessCase.LifecycleCostPhases, businessCase.LifecycleCostPhases)
+	})
+
+	s.Run("returns validation error when a cost is outside the lifecycle cost horizon", func() {
+		year6 := models.LifecycleCostYear("6")
+		incoming := testhelpers.NewBusinessCase()
+		incoming.LifecycleCostHorizon = 5
+		incoming.LifecycleCostLines = models.EstimatedLifecycleCosts{
+			testhelpers.NewEstimatedLifecycleCost(testhelpers.EstimatedLifecycleCostOptions{Year: &year6}),
+		}
+		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, fetch, authorize, update, noRecordFieldChanges)
+
+		businessCase, err := updateBusinessCase(ctx, &incoming)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(&models.BusinessCase{}, businessCase)
+	})
+
 	// Uncomment below when UI has changed for unique lifecycle costs
 	//s.Run("returns validation error when lifecycle cost phases are duplicated", func() {
 	//	existingBusinessCase.LifecycleCostLines = models.EstimatedLifecycleCosts{
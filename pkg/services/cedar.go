@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedareasi"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+const (
+	// CedarUpdateMaxAttempts is how many times a pending CEDAR update is retried before it's left for reconciliation
+	CedarUpdateMaxAttempts = 10
+
+	cedarUpdateBatchSize      = 25
+	cedarUpdateClaimLease     = 15 * time.Minute
+	cedarUpdateRetryBaseDelay = time.Minute
+	cedarUpdateRetryMaxDelay  = 6 * time.Hour
+)
+
+// NewUpdateSystemIntakeAndCedar returns a function that saves a system intake
+// and queues its new state to be sent to CEDAR in the background,
+// so the update doesn't wait on CEDAR or fail with it.
+func NewUpdateSystemIntakeAndCedar(
+	config Config,
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	queueCedarUpdate func(context.Context, uuid.UUID, string) error,
+) func(context.Context, *models.SystemIntake) (*models.SystemIntake, error) {
+	return func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+		updated, err := update(ctx, intake)
+		if err != nil {
+			return updated, err
+		}
+		// CEDAR only knows about intakes that have been submitted to it
+		if !updated.AlfabetID.Valid {
+			return updated, nil
+		}
+
+		// reconciliation finds the status mismatch if this is lost
+		if queueErr := queueCedarUpdate(ctx, updated.ID, ""); queueErr != nil {
+			appcontext.ZLogger(ctx).Error(
+				"Failed to queue CEDAR update",
+				zap.String("intakeID", updated.ID.String()),
+				zap.Error(queueErr),
+			)
+		}
+		return updated, nil
+	}
+}
+
+// cedarUpdateRetryDelay backs off exponentially with the number of failed attempts
+func cedarUpdateRetryDelay(attempts int) time.Duration {
+	delay := cedarUpdateRetryBaseDelay
+	for i := 0; i < attempts; i++ {
+		delay *= 2
+		if delay >= cedarUpdateRetryMaxDelay {
+			return cedarUpdateRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// NewRetryCedarSystemIntakeUpdates returns a function that sends
+// the pending CEDAR updates that are due to be tried again.
+// Updates are claimed for a lease, so instances running this at once don't send the same update.
+// Updates that aren't sent because emitting to CEDAR is off stay queued until their lease runs out.
+func NewRetryCedarSystemIntakeUpdates(
+	config Config,
+	claimDue func(context.Context, int, int, time.Duration) (models.CedarSystemIntakeUpdates, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	updateInCedar func(context.Context, *models.SystemIntake) error,
+	recordFailure func(context.Context, uuid.UUID, int, string, time.Time) error,
+	deleteUpdate func(context.Context, uuid.UUID, int) error,
+) func(context.Context) error {
+	return func(ctx context.Context) error {
+		logger := appcontext.ZLogger(ctx)
+		updates, err := claimDue(ctx, CedarUpdateMaxAttempts, cedarUpdateBatchSize, cedarUpdateClaimLease)
+		if err != nil {
+			return err
+		}
+		for _, update := range updates {
+			updateLogger := logger.With(
+				zap.String("intakeID", update.SystemIntakeID.String()),
+				zap.Int("attempts", update.Attempts),
+			)
+			intake, fetchErr := fetchIntake(ctx, update.SystemIntakeID)
+			if fetchErr == nil {
+				err = updateInCedar(ctx, intake)
+			} else {
+				err = fetchErr
+			}
+			if errors.Is(err, cedareasi.ErrNotEmitted) {
+				updateLogger.Info("Keeping CEDAR update while emitting to CEDAR is off")
+				continue
+			}
+			if err != nil {
+				updateLogger.Warn("Retry of CEDAR update failed", zap.Error(err))
+				nextAttemptAt := config.clock.Now().Add(cedarUpdateRetryDelay(update.Attempts))
+				if recordErr := recordFailure(ctx, update.ID, update.Generation, err.Error(), nextAttemptAt); recordErr != nil {
+					return recordErr
+				}
+				if update.Attempts+1 >= CedarUpdateMaxAttempts {
+					updateLogger.Error("Giving up on CEDAR update until it is reconciled")
+				}
+				continue
+			}
+			if err = deleteUpdate(ctx, update.ID, update.Generation); err != nil {
+				return err
+			}
+			updateLogger.Info("Sent pending CEDAR update")
+		}
+		return nil
+	}
+}
+
+// NewReconcileCedarSystemIntakes returns a function that finds submitted intakes CEDAR doesn't know about
+// and submits them, and checks that CEDAR has the current status of linked intakes.
+// Status mismatches are queued as CEDAR updates. A dry run only reports what it finds.
+// throttle is called before every request to CEDAR to rate limit them.
+func NewReconcileCedarSystemIntakes(
+	config Config,
+	fetchUnlinked func(context.Context) (models.SystemIntakes, error),
+	fetchLinked func(context.Context) (models.SystemIntakes, error),
+	validateAndSubmit func(context.Context, *models.SystemIntake) (string, error),
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	fetchCedarStatus func(context.Context, string) (string, error),
+	queueCedarUpdate func(context.Context, uuid.UUID, string) error,
+	throttle func(context.Context) error,
+) func(context.Context, bool) (*models.CedarReconciliation, error) {
+	return func(ctx context.Context, dryRun bool) (*models.CedarReconciliation, error) {
+		logger := appcontext.ZLogger(ctx)
+		report := &models.CedarReconciliation{DryRun: dryRun}
+
+		unlinked, err := fetchUnlinked(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range unlinked {
+			intake := &unlinked[i]
+			result := models.CedarReconciliationResult{
+				SystemIntakeID: intake.ID,
+				ProjectName:    intake.ProjectName.ValueOrZero(),
+				EASiStatus:     intake.Status,
+			}
+			report.Unlinked = append(report.Unlinked, result)
+			if dryRun {
+				continue
+			}
+
+			if err = throttle(ctx); err != nil {
+				return report, err
+			}
+			alfabetID, submitErr := validateAndSubmit(ctx, intake)
+			if submitErr == nil && alfabetID == "" {
+				submitErr = errors.New("CEDAR did not return an alfabet ID; is emitting to CEDAR turned on?")
+			}
+			if submitErr != nil {
+				logger.Warn("Failed to submit intake to CEDAR", zap.String("intakeID", intake.ID.String()), zap.Error(submitErr))
+				result.Error = submitErr.Error()
+				report.SubmitFailures = append(report.SubmitFailures, result)
+				continue
+			}
+
+			intake.AlfabetID = null.StringFrom(alfabetID)
+			result.AlfabetID = alfabetID
+			if _, err = update(ctx, intake); err != nil {
+				// CEDAR has the intake now, so this needs to be fixed by hand
+				logger.Error("Failed to save alfabet ID", zap.String("intakeID", intake.ID.String()), zap.String("alfabetID", alfabetID), zap.Error(err))
+				result.Error = err.Error()
+				report.SubmitFailures = append(report.SubmitFailures, result)
+				continue
+			}
+			report.Submitted = append(report.Submitted, result)
+		}
+
+		linked, err := fetchLinked(ctx)
+		if err != nil {
+			return report, err
+		}
+		for _, intake := range linked {
+			result := models.CedarReconciliationResult{
+				SystemIntakeID: intake.ID,
+				ProjectName:    intake.ProjectName.ValueOrZero(),
+				AlfabetID:      intake.AlfabetID.String,
+				EASiStatus:     intake.Status,
+			}
+			if err = throttle(ctx); err != nil {
+				return report, err
+			}
+			cedarStatus, fetchErr := fetchCedarStatus(ctx, intake.AlfabetID.String)
+			if fetchErr == nil && cedarStatus == "" {
+				fetchErr = errors.New("CEDAR returned no status")
+			}
+			if fetchErr != nil {
+				result.Error = fetchErr.Error()
+				report.StatusCheckFailures = append(report.StatusCheckFailures, result)
+				continue
+			}
+			result.CEDARStatus = cedarStatus
+			if cedarStatus == string(intake.Status) {
+				continue
+			}
+			report.StatusMismatches = append(report.StatusMismatches, result)
+			if dryRun {
+				continue
+			}
+			if err = queueCedarUpdate(ctx, intake.ID, "status mismatch found by reconciliation"); err != nil {
+				return report, err
+			}
+		}
+		return report, nil
+	}
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/cedar/cedareasi"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestUpdateSystemIntakeAndCedar() {
+	cfg := NewConfig(nil, nil)
+	ctx := context.Background()
+
+	update := func(_ context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+		return intake, nil
+	}
+	queued := map[uuid.UUID]bool{}
+	queue := func(_ context.Context, id uuid.UUID, lastError string) error {
+		s.Empty(lastError)
+		queued[id] = true
+		return nil
+	}
+
+	s.Run("queues submitted intakes to be sent to CEDAR", func() {
+		intake := models.SystemIntake{ID: uuid.New(), AlfabetID: null.StringFrom("000-000-0")}
+		_, err := NewUpdateSystemIntakeAndCedar(cfg, update, queue)(ctx, &intake)
+		s.NoError(err)
+		s.True(queued[intake.ID])
+	})
+
+	s.Run("skips intakes CEDAR doesn't know about", func() {
+		intake := models.SystemIntake{ID: uuid.New()}
+		_, err := NewUpdateSystemIntakeAndCedar(cfg, update, queue)(ctx, &intake)
+		s.NoError(err)
+		s.False(queued[intake.ID])
+	})
+
+	s.Run("doesn't fail the update when queueing fails", func() {
+		failQueue := func(context.Context, uuid.UUID, string) error {
+			return errors.New("queue error")
+		}
+		intake := models.SystemIntake{ID: uuid.New(), AlfabetID: null.StringFrom("000-000-0")}
+		updated, err := NewUpdateSystemIntakeAndCedar(cfg, update, failQueue)(ctx, &intake)
+		s.NoError(err)
+		s.Equal(intake.ID, updated.ID)
+	})
+
+	s.Run("returns update errors without queueing", func() {
+		failUpdate := func(context.Context, *models.SystemIntake) (*models.SystemIntake, error) {
+			return nil, errors.New("update error")
+		}
+		intake := models.SystemIntake{ID: uuid.New(), AlfabetID: null.StringFrom("000-000-0")}
+		_, err := NewUpdateSystemIntakeAndCedar(cfg, failUpdate, queue)(ctx, &intake)
+		s.Error(err)
+		s.False(queued[intake.ID])
+	})
+}
+
+func (s ServicesTestSuite) TestRetryCedarSystemIntakeUpdates() {
+	cfg := NewConfig(nil, nil)
+	mockClock := clock.NewMock()
+	cfg.clock = mockClock
+	ctx := context.Background()
+
+	succeeding := models.CedarSystemIntakeUpdate{ID: uuid.New(), SystemIntakeID: uuid.New()}
+	failing := models.CedarSystemIntakeUpdate{ID: uuid.New(), SystemIntakeID: uuid.New(), Generation: 3, Attempts: 2}
+	claimDue := func(_ context.Context, maxAttempts int, limit int, lease time.Duration) (models.CedarSystemIntakeUpdates, error) {
+		s.Equal(CedarUpdateMaxAttempts, maxAttempts)
+		s.Equal(cedarUpdateClaimLease, lease)
+		return models.CedarSystemIntakeUpdates{succeeding, failing}, nil
+	}
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &models.SystemIntake{ID: id, AlfabetID: null.StringFrom("000-000-0")}, nil
+	}
+	updateInCedar := func(_ context.Context, intake *models.SystemIntake) error {
+		if intake.ID == failing.SystemIntakeID {
+			return errors.New("CEDAR is down")
+		}
+		return nil
+	}
+	failures := map[uuid.UUID]time.Time{}
+	recordFailure := func(_ context.Context, id uuid.UUID, generation int, lastError string, nextAttemptAt time.Time) error {
+		s.Equal(failing.Generation, generation)
+		failures[id] = nextAttemptAt
+		return nil
+	}
+	deleted := map[uuid.UUID]bool{}
+	deleteUpdate := func(_ context.Context, id uuid.UUID, generation int) error {
+		s.Equal(succeeding.Generation, generation)
+		deleted[id] = true
+		return nil
+	}
+
+	s.Run("sends due updates and backs off failures", func() {
+		retry := NewRetryCedarSystemIntakeUpdates(cfg, claimDue, fetchIntake, updateInCedar, recordFailure, deleteUpdate)
+		err := retry(ctx)
+		s.NoError(err)
+		s.True(deleted[succeeding.ID])
+		s.False(deleted[failing.ID])
+		s.Equal(mockClock.Now().Add(4*time.Minute), failures[failing.ID])
+	})
+
+	s.Run("keeps updates queued while emitting to CEDAR is off", func() {
+		notEmitted := func(context.Context, *models.SystemIntake) error {
+			return cedareasi.ErrNotEmitted
+		}
+		deleted = map[uuid.UUID]bool{}
+		failures = map[uuid.UUID]time.Time{}
+		retry := NewRetryCedarSystemIntakeUpdates(cfg, claimDue, fetchIntake, notEmitted, recordFailure, deleteUpdate)
+		err := retry(ctx)
+		s.NoError(err)
+		s.Empty(deleted)
+		s.Empty(failures)
+	})
+
+	s.Run("returns errors fetching due updates", func() {
+		failClaimDue := func(context.Context, int, int, time.Duration) (models.CedarSystemIntakeUpdates, error) {
+			return nil, errors.New("fetch error")
+		}
+		retry := NewRetryCedarSystemIntakeUpdates(cfg, failClaimDue, fetchIntake, updateInCedar, recordFailure, deleteUpdate)
+		s.Error(retry(ctx))
+	})
+}
+
+func (s ServicesTestSuite) TestCedarUpdateRetryDelay() {
+	s.Equal(time.Minute, cedarUpdateRetryDelay(0))
+	s.Equal(8*time.Minute, cedarUpdateRetryDelay(3))
+	s.Equal(cedarUpdateRetryMaxDelay, cedarUpdateRetryDelay(50))
+}
+
+func (s ServicesTestSuite) TestReconcileCedarSystemIntakes() {
+	cfg := NewConfig(nil, nil)
+	ctx := context.Background()
+
+	unlinkedOK := models.SystemIntake{ID: uuid.New(), Status: models.SystemIntakeStatusINTAKESUBMITTED}
+	unlinkedInvalid := models.SystemIntake{ID: uuid.New(), Status: models.SystemIntakeStatusINTAKESUBMITTED}
+	linkedMatching := models.SystemIntake{ID: uuid.New(), Status: models.SystemIntakeStatusLCIDISSUED, AlfabetID: null.StringFrom("1")}
+	linkedMismatched := models.SystemIntake{ID: uuid.New(), Status: models.SystemIntakeStatusNOTAPPROVED, AlfabetID: null.StringFrom("2")}
+
+	fetchUnlinked := func(context.Context) (models.SystemIntakes, error) {
+		return models.SystemIntakes{unlinkedOK, unlinkedInvalid}, nil
+	}
+	fetchLinked := func(context.Context) (models.SystemIntakes, error) {
+		return models.SystemIntakes{linkedMatching, linkedMismatched}, nil
+	}
+	validateAndSubmit := func(_ context.Context, intake *models.SystemIntake) (string, error) {
+		if intake.ID == unlinkedInvalid.ID {
+			return "", errors.New("validation failed")
+		}
+		return "000-000-0", nil
+	}
+	var saved []*models.SystemIntake
+	update := func(_ context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+		saved = append(saved, intake)
+		return intake, nil
+	}
+	fetchCedarStatus := func(_ context.Context, alfabetID string) (string, error) {
+		return string(models.SystemIntakeStatusLCIDISSUED), nil
+	}
+	var queued []uuid.UUID
+	queue := func(_ context.Context, id uuid.UUID, _ string) error {
+		queued = append(queued, id)
+		return nil
+	}
+	throttled := 0
+	throttle := func(context.Context) error {
+		throttled++
+		return nil
+	}
+	reconcile := NewReconcileCedarSystemIntakes(cfg, fetchUnlinked, fetchLinked, validateAndSubmit, update, fetchCedarStatus, queue, throttle)
+
+	s.Run("a dry run only reports", func() {
+		saved, queued, throttled = nil, nil, 0
+		report, err := reconcile(ctx, true)
+		s.NoError(err)
+		s.True(report.DryRun)
+		s.Len(report.Unlinked, 2)
+		s.Empty(report.Submitted)
+		s.Len(report.StatusMismatches, 1)
+		s.Equal(linkedMismatched.ID, report.StatusMismatches[0].SystemIntakeID)
+		s.Empty(saved)
+		s.Empty(queued)
+		s.Equal(2, throttled)
+	})
+
+	s.Run("submits unlinked intakes and queues mismatched statuses", func() {
+		saved, queued, throttled = nil, nil, 0
+		report, err := reconcile(ctx, false)
+		s.NoError(err)
+		s.Len(report.Submitted, 1)
+		s.Equal("000-000-0", report.Submitted[0].AlfabetID)
+		s.Len(report.SubmitFailures, 1)
+		s.Equal("validation failed", report.SubmitFailures[0].Error)
+		s.Len(saved, 1)
+		s.Equal(null.StringFrom("000-000-0"), saved[0].AlfabetID)
+		s.Equal([]uuid.UUID{linkedMismatched.ID}, queued)
+		s.Equal(4, throttled)
+	})
+
+	s.Run("reports intakes CEDAR returns no ID for", func() {
+		submitWithoutEmitting := func(context.Context, *models.SystemIntake) (string, error) {
+			return "", nil
+		}
+		reconcile := NewReconcileCedarSystemIntakes(cfg, fetchUnlinked, fetchLinked, submitWithoutEmitting, update, fetchCedarStatus, queue, throttle)
+		report, err := reconcile(ctx, false)
+		s.NoError(err)
+		s.Empty(report.Submitted)
+		s.Len(report.SubmitFailures, 2)
+	})
+
+	s.Run("stops when throttling is canceled", func() {
+		cancelled := func(context.Context) error { return context.Canceled }
+		reconcile := NewReconcileCedarSystemIntakes(cfg, fetchUnlinked, fetchLinked, validateAndSubmit, update, fetchCedarStatus, queue, cancelled)
+		report, err := reconcile(ctx, false)
+		s.Equal(context.Canceled, err)
+		s.Len(report.Unlinked, 1)
+	})
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewResolveSystemIntakeCollaborators returns a function that looks up an intake's named collaborators
+// in CEDAR LDAP, saving their EUA IDs and emails and a warning for any that can't be resolved.
+// Collaborators resolved before are checked by EUA ID, and others are matched on their full name.
+// Resolving is the only way these are saved, so clients can't point collaborator emails elsewhere.
+func NewResolveSystemIntakeCollaborators(
+	config Config,
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	searchPeople func(context.Context, string) ([]*models.UserInfo, error),
+	saveCollaborators func(context.Context, *models.SystemIntake) error,
+) func(context.Context, *models.SystemIntake) {
+	return func(ctx context.Context, intake *models.SystemIntake) {
+		logger := appcontext.ZLogger(ctx).With(zap.String("intakeID", intake.ID.String()))
+		warnings := models.SystemIntakeCollaboratorWarnings{}
+		for _, collaborator := range intake.Collaborators() {
+			userInfo, warning := resolveCollaborator(ctx, collaborator, fetchUserInfo, searchPeople)
+			if userInfo == nil {
+				warnings[collaborator.Field] = warning
+				// don't keep emailing whoever the name resolved to before
+				*collaborator.EUAUserID = null.String{}
+				*collaborator.Email = null.String{}
+				continue
+			}
+			*collaborator.EUAUserID = null.StringFrom(userInfo.EuaUserID)
+			*collaborator.Email = null.StringFrom(userInfo.Email)
+		}
+		intake.CollaboratorWarnings = warnings
+		if len(warnings) > 0 {
+			logger.Info("Could not resolve system intake collaborators", zap.Any("warnings", warnings))
+		}
+		if err := saveCollaborators(ctx, intake); err != nil {
+			logger.Error("Failed to save system intake collaborators", zap.Error(err))
+		}
+	}
+}
+
+func resolveCollaborator(
+	ctx context.Context,
+	collaborator models.SystemIntakeCollaborator,
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	searchPeople func(context.Context, string) ([]*models.UserInfo, error),
+) (*models.UserInfo, string) {
+	if collaborator.EUAUserID.ValueOrZero() != "" {
+		userInfo, err := fetchUserInfo(ctx, collaborator.EUAUserID.String)
+		if errors.Is(err, cedarldap.ErrUserNotFound) {
+			return nil, "EUA ID " + collaborator.EUAUserID.String + " could not be found"
+		}
+		if err != nil || userInfo == nil {
+			return nil, "could not be verified"
+		}
+		return userInfo, ""
+	}
+
+	people, err := searchPeople(ctx, collaborator.Name)
+	if err != nil {
+		return nil, "could not be verified"
+	}
+	var matches []*models.UserInfo
+	for _, person := range people {
+		if strings.EqualFold(strings.TrimSpace(person.CommonName), collaborator.Name) {
+			matches = append(matches, person)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, collaborator.Name + " could not be found"
+	case 1:
+		return matches[0], ""
+	default:
+		return nil, collaborator.Name + " matches more than one person"
+	}
+}
+
+// notifyCollaborators sends an action's email to the intake's resolved collaborators when the reviewer
+// asked for it. The requester has already been emailed, so failures are logged rather than returned.
+func notifyCollaborators(
+	ctx context.Context,
+	intake *models.SystemIntake,
+	action *models.Action,
+	requesterEmail string,
+	send func(ctx context.Context, recipient string) error,
+) {
+	if !action.NotifyCollaborators {
+		return
+	}
+	sent := map[string]bool{strings.ToLower(requesterEmail): true}
+	for _, collaborator := range intake.Collaborators() {
+		email := collaborator.Email.ValueOrZero()
+		if email == "" || sent[strings.ToLower(email)] {
+			continue
+		}
+		sent[strings.ToLower(email)] = true
+		if err := send(ctx, email); err != nil {
+			appcontext.ZLogger(ctx).Warn(
+				"Failed to email collaborator about action",
+				zap.String("intakeID", intake.ID.String()),
+				zap.String("collaborator", collaborator.Field),
+				zap.Error(err),
+			)
+		}
+	}
+}
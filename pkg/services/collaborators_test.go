@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestResolveSystemIntakeCollaborators() {
+	cfg := NewConfig(nil, nil)
+	ctx := context.Background()
+	people := []*models.UserInfo{
+		{CommonName: "Adeline Aarons", Email: "adeline@example.com", EuaUserID: "ABCD"},
+		{CommonName: "Tom Arnold", Email: "tom@example.com", EuaUserID: "TACO"},
+		{CommonName: "Tom Arnold", Email: "tom.arnold@example.com", EuaUserID: "TOMA"},
+		{CommonName: "Adeline Aaronson", Email: "aaronson@example.com", EuaUserID: "AARO"},
+	}
+	fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+		for _, person := range people {
+			if person.EuaUserID == euaID {
+				return person, nil
+			}
+		}
+		return nil, &apperrors.ExternalAPIError{Err: cedarldap.ErrUserNotFound, Source: "CEDAR LDAP"}
+	}
+	searchPeople := func(_ context.Context, query string) ([]*models.UserInfo, error) {
+		return people, nil
+	}
+	var saved *models.SystemIntake
+	saveCollaborators := func(_ context.Context, intake *models.SystemIntake) error {
+		saved = intake
+		return nil
+	}
+	resolve := NewResolveSystemIntakeCollaborators(cfg, fetchUserInfo, searchPeople, saveCollaborators)
+
+	s.Run("resolves a collaborator with one exact match", func() {
+		intake := models.SystemIntake{ISSOName: null.StringFrom("adeline aarons")}
+
+		resolve(ctx, &intake)
+
+		s.Equal("ABCD", intake.ISSOEUAUserID.String)
+		s.Equal("adeline@example.com", intake.ISSOEmail.String)
+		s.Empty(intake.CollaboratorWarnings)
+		s.Equal(&intake, saved)
+	})
+
+	s.Run("verifies a collaborator chosen by EUA ID", func() {
+		intake := models.SystemIntake{
+			TRBCollaboratorName:      null.StringFrom("Tom Arnold"),
+			TRBCollaboratorEUAUserID: null.StringFrom("TOMA"),
+		}
+
+		resolve(ctx, &intake)
+
+		s.Equal("tom.arnold@example.com", intake.TRBCollaboratorEmail.String)
+		s.Empty(intake.CollaboratorWarnings)
+	})
+
+	s.Run("warns about names that can't be resolved", func() {
+		intake := models.SystemIntake{
+			ISSOName:                null.StringFrom("Nobody Here"),
+			TRBCollaboratorName:     null.StringFrom("Tom Arnold"),
+			EACollaboratorName:      null.StringFrom("Adeline Aarons"),
+			EACollaboratorEUAUserID: null.StringFrom("GONE"),
+			EACollaboratorEmail:     null.StringFrom("gone@example.com"),
+		}
+
+		resolve(ctx, &intake)
+
+		s.Equal(models.SystemIntakeCollaboratorWarnings{
+			"isso":            "Nobody Here could not be found",
+			"trbCollaborator": "Tom Arnold matches more than one person",
+			"eaCollaborator":  "EUA ID GONE could not be found",
+		}, intake.CollaboratorWarnings)
+		s.False(intake.TRBCollaboratorEmail.Valid)
+		s.False(intake.EACollaboratorEUAUserID.Valid)
+		s.False(intake.EACollaboratorEmail.Valid)
+		s.Equal(&intake, saved)
+	})
+
+	s.Run("warns when CEDAR LDAP is unavailable", func() {
+		failSearch := func(context.Context, string) ([]*models.UserInfo, error) {
+			return nil, errors.New("cedar is down")
+		}
+		intake := models.SystemIntake{ISSOName: null.StringFrom("Adeline Aarons")}
+
+		NewResolveSystemIntakeCollaborators(cfg, fetchUserInfo, failSearch, saveCollaborators)(ctx, &intake)
+
+		s.Equal("could not be verified", intake.CollaboratorWarnings["isso"])
+	})
+}
+
+func (s ServicesTestSuite) TestNotifyCollaborators() {
+	ctx := context.Background()
+	intake := models.SystemIntake{
+		ISSOName:            null.StringFrom("Adeline Aarons"),
+		ISSOEmail:           null.StringFrom("adeline@example.com"),
+		TRBCollaboratorName: null.StringFrom("Tom Arnold"),
+		// the requester is only emailed once
+		TRBCollaboratorEmail: null.StringFrom("Requester@example.com"),
+		EACollaboratorName:   null.StringFrom("Unresolved Person"),
+	}
+
+	s.Run("emails resolved collaborators when asked to", func() {
+		var recipients []string
+		send := func(_ context.Context, recipient string) error {
+			recipients = append(recipients, recipient)
+			return errors.New("failures are only logged")
+		}
+
+		notifyCollaborators(ctx, &intake, &models.Action{NotifyCollaborators: true}, "requester@example.com", send)
+
+		s.Equal([]string{"adeline@example.com"}, recipients)
+	})
+
+	s.Run("doesn't email collaborators by default", func() {
+		sent := 0
+		send := func(context.Context, string) error {
+			sent++
+			return nil
+		}
+
+		notifyCollaborators(ctx, &intake, &models.Action{}, "requester@example.com", send)
+
+		s.Equal(0, sent)
+	})
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// fields that change on every update, are derived from other tables,
+// or are only there to link list items to their business case
+var (
+	systemIntakeHistoryIgnored = []string{
+		"id", "updatedAt", "version", "fundingSources", "documents", "delegates",
+	}
+	businessCaseHistoryIgnored = []string{
+		"id", "updatedAt", "version", "systemIntakeStatus",
+		"business_case", "businessCaseId", "alternative_id", "solution", "phase", "year",
+	}
+	// deletions are recorded by who deleted the test date, at the time of the change
+	testDateHistoryIgnored = []string{
+		"id", "requestId", "createdAt", "updatedAt", "deletedAt",
+	}
+)
+
+// NewRecordFieldChanges returns a function that saves what the context's principal changed
+// between two versions of a SystemIntake, BusinessCase or TestDate.
+// The update has already happened by then, so failures are logged rather than returned.
+func NewRecordFieldChanges(
+	config Config,
+	save func(context.Context, models.FieldChanges) error,
+) func(ctx context.Context, before interface{}, after interface{}) {
+	return func(ctx context.Context, before interface{}, after interface{}) {
+		logger := appcontext.ZLogger(ctx)
+		var resourceType string
+		var resourceID uuid.UUID
+		var ignored []string
+		switch b := before.(type) {
+		case *models.SystemIntake:
+			resourceType, resourceID, ignored = "SystemIntake", b.ID, systemIntakeHistoryIgnored
+		case *models.BusinessCase:
+			resourceType, resourceID, ignored = "BusinessCase", b.ID, businessCaseHistoryIgnored
+		case *models.TestDate:
+			resourceType, resourceID, ignored = "TestDate", b.ID, testDateHistoryIgnored
+		default:
+			logger.Error("Unable to record field changes", zap.String("resource", fmt.Sprintf("%T", before)))
+			return
+		}
+
+		changes, err := models.DiffFields(before, after, ignored...)
+		if err != nil {
+			logger.Error("Failed to compare field changes", zap.Error(err))
+			return
+		}
+		if len(changes) == 0 {
+			return
+		}
+		changedAt := config.clock.Now()
+		euaUserID := appcontext.Principal(ctx).ID()
+		for i := range changes {
+			changes[i].ResourceType = resourceType
+			changes[i].ResourceID = resourceID
+			changes[i].EUAUserID = euaUserID
+			changes[i].ChangedAt = &changedAt
+		}
+		if err := save(ctx, changes); err != nil {
+			logger.Error(
+				"Failed to record field changes",
+				zap.Error(err),
+				zap.String("resourceID", resourceID.String()),
+			)
+		}
+	}
+}
+
+// NewFetchFieldChanges is a service to fetch the change history of a SystemIntake or BusinessCase
+func NewFetchFieldChanges(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	resourceType string,
+	fetch func(context.Context, string, uuid.UUID) (models.FieldChanges, error),
+) func(context.Context, uuid.UUID) (models.FieldChanges, error) {
+	return func(ctx context.Context, resourceID uuid.UUID) (_ models.FieldChanges, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "FieldChanges", resourceID.String(), err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch field changes")}
+		}
+		return fetch(ctx, resourceType, resourceID)
+	}
+}
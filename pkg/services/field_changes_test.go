@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx/types"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func noRecordFieldChanges(context.Context, interface{}, interface{}) {}
+
+func (s ServicesTestSuite) TestRecordFieldChanges() {
+	cfg := NewConfig(s.logger, nil)
+	mockClock := clock.NewMock()
+	cfg.clock = mockClock
+	principal := testhelpers.NewReviewerPrincipal()
+	ctx := appcontext.WithPrincipal(context.Background(), principal)
+	ctx = appcontext.WithLogger(ctx, s.logger)
+
+	s.Run("saves the changed fields of an intake", func() {
+		var saved models.FieldChanges
+		save := func(_ context.Context, changes models.FieldChanges) error {
+			saved = changes
+			return nil
+		}
+		id := uuid.New()
+		before := &models.SystemIntake{ID: id, ProjectName: null.StringFrom("Old")}
+		after := &models.SystemIntake{ID: id, ProjectName: null.StringFrom("New")}
+
+		NewRecordFieldChanges(cfg, save)(ctx, before, after)
+
+		s.Len(saved, 1)
+		s.Equal("SystemIntake", saved[0].ResourceType)
+		s.Equal(id, saved[0].ResourceID)
+		s.Equal("projectName", saved[0].Field)
+		s.Equal(types.JSONText(`"Old"`), saved[0].OldValue)
+		s.Equal(types.JSONText(`"New"`), saved[0].NewValue)
+		s.Equal(principal.ID(), saved[0].EUAUserID)
+		s.Equal(mockClock.Now(), *saved[0].ChangedAt)
+	})
+
+	s.Run("saves nothing when nothing changed", func() {
+		save := func(context.Context, models.FieldChanges) error {
+			s.Fail("should not save")
+			return nil
+		}
+		businessCase := &models.BusinessCase{ID: uuid.New(), ProjectName: null.StringFrom("Same")}
+
+		NewRecordFieldChanges(cfg, save)(ctx, businessCase, businessCase)
+	})
+
+	s.Run("a failed save does not panic", func() {
+		save := func(context.Context, models.FieldChanges) error {
+			return errors.New("failed to save")
+		}
+
+		NewRecordFieldChanges(cfg, save)(ctx, &models.SystemIntake{}, &models.SystemIntake{Requester: "Someone"})
+	})
+}
+
+func (s ServicesTestSuite) TestFetchFieldChanges() {
+	cfg := NewConfig(s.logger, nil)
+	id := uuid.New()
+	var fetchedType string
+	fetch := func(_ context.Context, resourceType string, resourceID uuid.UUID) (models.FieldChanges, error) {
+		fetchedType = resourceType
+		return models.FieldChanges{{ResourceType: resourceType, ResourceID: resourceID}}, nil
+	}
+	fetchFieldChanges := NewFetchFieldChanges(cfg, NewAuthorize(authz.ActionRead, (*models.FieldChange)(nil)), "BusinessCase", fetch)
+
+	s.Run("the GRT can fetch the history", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+		changes, err := fetchFieldChanges(ctx, id)
+
+		s.NoError(err)
+		s.Len(changes, 1)
+		s.Equal("BusinessCase", fetchedType)
+	})
+
+	s.Run("requesters cannot fetch the history", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchFieldChanges(ctx, id)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
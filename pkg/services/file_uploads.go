@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/upload"
 )
@@ -22,7 +24,9 @@ type fetchFunc func(context.Context, uuid.UUID) (*models.AccessibilityRequestDoc
 
 // NewCreateFileUploadURL is a service to create a file upload URL via a pre-signed URL in S3
 func NewCreateFileUploadURL(config Config, authorize authFunc, s3client upload.S3Client) func(ctx context.Context, fileType string) (*models.PreSignedURL, error) {
-	return func(ctx context.Context, fileType string) (*models.PreSignedURL, error) {
+	return func(ctx context.Context, fileType string) (_ *models.PreSignedURL, err error) {
+		defer func() { audit.Record(ctx, authz.ActionCreate, "PreSignedURL", "", err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
@@ -30,7 +34,7 @@ func NewCreateFileUploadURL(config Config, authorize authFunc, s3client upload.S
 
 		if !ok {
 			return nil, &apperrors.ResourceNotFoundError{
-				Err:      errors.New("failed to authorize pre-signed url generation"),
+				Err:      &apperrors.UnauthorizedError{Err: errors.New("failed to authorize pre-signed url generation")},
 				Resource: models.PreSignedURL{},
 			}
 		}
@@ -45,7 +49,9 @@ func NewCreateFileUploadURL(config Config, authorize authFunc, s3client upload.S
 
 // NewCreateFileDownloadURL is a services to create a file download URL via a pre-signed s3 URL
 func NewCreateFileDownloadURL(config Config, authorize authFunc, s3client upload.S3Client) func(ctx context.Context, key string) (*models.PreSignedURL, error) {
-	return func(ctx context.Context, key string) (*models.PreSignedURL, error) {
+	return func(ctx context.Context, key string) (_ *models.PreSignedURL, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "File", key, err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
@@ -53,7 +59,7 @@ func NewCreateFileDownloadURL(config Config, authorize authFunc, s3client upload
 
 		if !ok {
 			return nil, &apperrors.ResourceNotFoundError{
-				Err:      errors.New("failed to authorize pre-signed url generation"),
+				Err:      &apperrors.UnauthorizedError{Err: errors.New("failed to authorize pre-signed url generation")},
 				Resource: models.PreSignedURL{},
 			}
 		}
@@ -70,7 +76,10 @@ func NewCreateFileDownloadURL(config Config, authorize authFunc, s3client upload
 
 // NewCreateAccessibilityRequestDocument returns a function that saves the metadata of an uploaded file
 func NewCreateAccessibilityRequestDocument(config Config, authorize authFunc, create createFunc) func(ctx context.Context, file *models.AccessibilityRequestDocument) (*models.AccessibilityRequestDocument, error) {
-	return func(ctx context.Context, file *models.AccessibilityRequestDocument) (*models.AccessibilityRequestDocument, error) {
+	return func(ctx context.Context, file *models.AccessibilityRequestDocument) (_ *models.AccessibilityRequestDocument, err error) {
+		var documentID string
+		defer func() { audit.Record(ctx, authz.ActionCreate, "AccessibilityRequestDocument", documentID, err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
@@ -78,18 +87,25 @@ func NewCreateAccessibilityRequestDocument(config Config, authorize authFunc, cr
 
 		if !ok {
 			return nil, &apperrors.ResourceNotFoundError{
-				Err:      errors.New("failed to authorize save uploaded file metadata"),
+				Err:      &apperrors.UnauthorizedError{Err: errors.New("failed to authorize save uploaded file metadata")},
 				Resource: models.PreSignedURL{},
 			}
 		}
 
-		return create(ctx, file)
+		document, err := create(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		documentID = document.ID.String()
+		return document, nil
 	}
 }
 
 // NewFetchAccessibilityRequestDocument returns a function that fetches the metadata of an uploaded file
 func NewFetchAccessibilityRequestDocument(config Config, authorize authFunc, fetch fetchFunc) func(ctx context.Context, id uuid.UUID) (*models.AccessibilityRequestDocument, error) {
-	return func(ctx context.Context, id uuid.UUID) (*models.AccessibilityRequestDocument, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ *models.AccessibilityRequestDocument, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "AccessibilityRequestDocument", id.String(), err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
@@ -97,7 +113,7 @@ func NewFetchAccessibilityRequestDocument(config Config, authorize authFunc, fet
 
 		if !ok {
 			return nil, &apperrors.ResourceNotFoundError{
-				Err:      errors.New("failed to authorize fetch uploaded file metadata"),
+				Err:      &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch uploaded file metadata")},
 				Resource: models.PreSignedURL{},
 			}
 		}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/appvalidation"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewFetchSystemIntakeFundingSources is a service to fetch all funding sources
+// associated with a given SystemIntake
+func NewFetchSystemIntakeFundingSources(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	fetchFundingSources func(context.Context, uuid.UUID) (models.SystemIntakeFundingSources, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+) func(context.Context, uuid.UUID) (models.SystemIntakeFundingSources, error) {
+	return func(ctx context.Context, intakeID uuid.UUID) (_ models.SystemIntakeFundingSources, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "SystemIntakeFundingSources", intakeID.String(), err) }()
+
+		intake, err := fetchIntake(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch funding sources")}
+		}
+		return fetchFundingSources(ctx, intake.ID)
+	}
+}
+
+// NewCreateSystemIntakeFundingSource is a service to add a funding source to a SystemIntake
+func NewCreateSystemIntakeFundingSource(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	create func(context.Context, *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error),
+) func(context.Context, *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+	return func(ctx context.Context, fundingSource *models.SystemIntakeFundingSource) (_ *models.SystemIntakeFundingSource, err error) {
+		var fundingSourceID string
+		defer func() { audit.Record(ctx, authz.ActionCreate, "SystemIntakeFundingSource", fundingSourceID, err) }()
+
+		intake, err := fetchIntake(ctx, fundingSource.SystemIntakeID)
+		if err != nil {
+			return nil, &apperrors.ResourceConflictError{
+				Err:        errors.New("system intake is required to create a funding source"),
+				Resource:   models.SystemIntakeFundingSource{},
+				ResourceID: "",
+			}
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize create funding source")}
+		}
+		err = appvalidation.SystemIntakeFundingSourceForSave(fundingSource)
+		if err != nil {
+			return nil, err
+		}
+		created, err := create(ctx, fundingSource)
+		if err != nil {
+			return nil, err
+		}
+		fundingSourceID = created.ID.String()
+		return created, nil
+	}
+}
+
+// NewUpdateSystemIntakeFundingSource is a service to update a funding source of a SystemIntake
+func NewUpdateSystemIntakeFundingSource(
+	config Config,
+	fetchFundingSource func(context.Context, uuid.UUID) (*models.SystemIntakeFundingSource, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	update func(context.Context, *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error),
+) func(context.Context, *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+	return func(ctx context.Context, fundingSource *models.SystemIntakeFundingSource) (_ *models.SystemIntakeFundingSource, err error) {
+		defer func() {
+			audit.Record(ctx, authz.ActionUpdate, "SystemIntakeFundingSource", fundingSource.ID.String(), err)
+		}()
+
+		intake, err := fetchFundingSourceIntake(ctx, fundingSource.ID, fundingSource.SystemIntakeID, fetchFundingSource, fetchIntake)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize update funding source")}
+		}
+		err = appvalidation.SystemIntakeFundingSourceForSave(fundingSource)
+		if err != nil {
+			return nil, err
+		}
+		updatedAt := config.clock.Now()
+		fundingSource.UpdatedAt = &updatedAt
+		return update(ctx, fundingSource)
+	}
+}
+
+// NewDeleteSystemIntakeFundingSource is a service to remove a funding source from a SystemIntake
+func NewDeleteSystemIntakeFundingSource(
+	config Config,
+	fetchFundingSource func(context.Context, uuid.UUID) (*models.SystemIntakeFundingSource, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	remove func(context.Context, uuid.UUID) error,
+) func(context.Context, uuid.UUID, uuid.UUID) error {
+	return func(ctx context.Context, intakeID uuid.UUID, id uuid.UUID) (err error) {
+		defer func() { audit.Record(ctx, authz.ActionDelete, "SystemIntakeFundingSource", id.String(), err) }()
+
+		intake, err := fetchFundingSourceIntake(ctx, id, intakeID, fetchFundingSource, fetchIntake)
+		if err != nil {
+			return err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize delete funding source")}
+		}
+		return remove(ctx, id)
+	}
+}
+
+// fetchFundingSourceIntake fetches the intake that owns a funding source,
+// making sure the funding source belongs to the expected intake
+func fetchFundingSourceIntake(
+	ctx context.Context,
+	id uuid.UUID,
+	intakeID uuid.UUID,
+	fetchFundingSource func(context.Context, uuid.UUID) (*models.SystemIntakeFundingSource, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+) (*models.SystemIntake, error) {
+	existing, err := fetchFundingSource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.SystemIntakeID != intakeID {
+		appcontext.ZLogger(ctx).Info("funding source does not belong to system intake")
+		return nil, &apperrors.ResourceNotFoundError{
+			Err:      errors.New("funding source does not belong to system intake"),
+			Resource: models.SystemIntakeFundingSource{},
+		}
+	}
+	return fetchIntake(ctx, existing.SystemIntakeID)
+}
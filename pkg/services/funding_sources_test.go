@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestFetchSystemIntakeFundingSources() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		if id == intake.ID {
+			return &intake, nil
+		}
+		return nil, &apperrors.ResourceNotFoundError{Err: errors.New("not found"), Resource: models.SystemIntake{}}
+	}
+	fetchFundingSources := func(_ context.Context, id uuid.UUID) (models.SystemIntakeFundingSources, error) {
+		return models.SystemIntakeFundingSources{
+			{ID: uuid.New(), SystemIntakeID: id},
+			{ID: uuid.New(), SystemIntakeID: id},
+		}, nil
+	}
+
+	s.Run("fetches the funding sources of an intake", func() {
+		fetch := NewFetchSystemIntakeFundingSources(cfg, fetchIntake, fetchFundingSources, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode())
+		fundingSources, err := fetch(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fundingSources, 2)
+	})
+
+	s.Run("returns an error if the intake does not exist", func() {
+		fetch := NewFetchSystemIntakeFundingSources(cfg, fetchIntake, fetchFundingSources, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode())
+		_, err := fetch(ctx, uuid.New())
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("returns unauthorized if the user is not authorized", func() {
+		notAuthorized := func(context.Context, *models.SystemIntake) (bool, error) { return false, nil }
+		fetch := NewFetchSystemIntakeFundingSources(cfg, fetchIntake, fetchFundingSources, notAuthorized)
+		_, err := fetch(ctx, intake.ID)
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestCreateSystemIntakeFundingSource() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		if id == intake.ID {
+			return &intake, nil
+		}
+		return nil, errors.New("forced error")
+	}
+	create := func(_ context.Context, fundingSource *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+		fundingSource.ID = uuid.New()
+		return fundingSource, nil
+	}
+	createFundingSource := NewCreateSystemIntakeFundingSource(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), create)
+
+	s.Run("creates a valid funding source", func() {
+		fundingSource, err := createFundingSource(ctx, &models.SystemIntakeFundingSource{
+			SystemIntakeID: intake.ID,
+			Source:         null.StringFrom("CLIA"),
+			FundingNumber:  null.StringFrom("123456"),
+		})
+		s.NoError(err)
+		s.NotEqual(uuid.Nil, fundingSource.ID)
+	})
+
+	s.Run("returns a conflict if the intake does not exist", func() {
+		_, err := createFundingSource(ctx, &models.SystemIntakeFundingSource{
+			SystemIntakeID: uuid.New(),
+			Source:         null.StringFrom("CLIA"),
+			FundingNumber:  null.StringFrom("123456"),
+		})
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
+	s.Run("returns a validation error for an invalid funding source", func() {
+		_, err := createFundingSource(ctx, &models.SystemIntakeFundingSource{
+			SystemIntakeID: intake.ID,
+			Source:         null.StringFrom("CLIA"),
+			FundingNumber:  null.StringFrom("12"),
+		})
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("returns unauthorized if the user is not the requester or a reviewer", func() {
+		requesterCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+		_, err := createFundingSource(requesterCtx, &models.SystemIntakeFundingSource{
+			SystemIntakeID: intake.ID,
+			Source:         null.StringFrom("CLIA"),
+			FundingNumber:  null.StringFrom("123456"),
+		})
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestUpdateAndDeleteSystemIntakeFundingSource() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	existing := models.SystemIntakeFundingSource{
+		ID:             uuid.New(),
+		SystemIntakeID: intake.ID,
+		Source:         null.StringFrom("CLIA"),
+		FundingNumber:  null.StringFrom("123456"),
+	}
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	fetchFundingSource := func(_ context.Context, id uuid.UUID) (*models.SystemIntakeFundingSource, error) {
+		if id == existing.ID {
+			return &existing, nil
+		}
+		return nil, &apperrors.ResourceNotFoundError{Err: errors.New("not found"), Resource: models.SystemIntakeFundingSource{}}
+	}
+	update := func(_ context.Context, fundingSource *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+		return fundingSource, nil
+	}
+	deleted := false
+	remove := func(context.Context, uuid.UUID) error {
+		deleted = true
+		return nil
+	}
+	updateFundingSource := NewUpdateSystemIntakeFundingSource(cfg, fetchFundingSource, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), update)
+	deleteFundingSource := NewDeleteSystemIntakeFundingSource(cfg, fetchFundingSource, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), remove)
+
+	s.Run("updates a funding source", func() {
+		fundingSource, err := updateFundingSource(ctx, &models.SystemIntakeFundingSource{
+			ID:             existing.ID,
+			SystemIntakeID: intake.ID,
+			Source:         null.StringFrom("Fed Admin"),
+			FundingNumber:  null.StringFrom("654321"),
+		})
+		s.NoError(err)
+		s.Equal(null.StringFrom("Fed Admin"), fundingSource.Source)
+		s.NotNil(fundingSource.UpdatedAt)
+	})
+
+	s.Run("does not update a funding source of another intake", func() {
+		_, err := updateFundingSource(ctx, &models.SystemIntakeFundingSource{
+			ID:             existing.ID,
+			SystemIntakeID: uuid.New(),
+			Source:         null.StringFrom("Fed Admin"),
+			FundingNumber:  null.StringFrom("654321"),
+		})
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("does not delete a funding source of another intake", func() {
+		err := deleteFundingSource(ctx, uuid.New(), existing.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+		s.False(deleted)
+	})
+
+	s.Run("deletes a funding source", func() {
+		err := deleteFundingSource(ctx, intake.ID, existing.ID)
+		s.NoError(err)
+		s.True(deleted)
+	})
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// applyMergePatch applies a JSON Merge Patch (RFC 7396) to the JSON encoding of original
+// and decodes the result into patched
+func applyMergePatch(original interface{}, patch map[string]json.RawMessage, patched interface{}) error {
+	encoded, err := json.Marshal(original)
+	if err != nil {
+		return err
+	}
+	var target interface{}
+	if err = decodeJSONNumbers(encoded, &target); err != nil {
+		return err
+	}
+	patchValue := map[string]interface{}{}
+	for field, raw := range patch {
+		var value interface{}
+		if err = decodeJSONNumbers(raw, &value); err != nil {
+			return err
+		}
+		patchValue[field] = value
+	}
+
+	merged, err := json.Marshal(mergeValue(target, patchValue))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(merged, patched)
+}
+
+// decodeJSONNumbers keeps numbers as written, so large integers survive the round trip
+func decodeJSONNumbers(data []byte, value interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+// mergeValue merges objects key by key, removing keys patched to null,
+// and replaces anything else, including arrays, with the patch
+func mergeValue(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergeValue(targetObject[key], value)
+		}
+	}
+	return targetObject
+}
+
+// unpatchableFields returns, in order, the patched fields that are in readOnly
+// or are not fields of model at all
+func unpatchableFields(patch map[string]json.RawMessage, model interface{}, readOnly []string) []string {
+	known := map[string]bool{}
+	modelType := reflect.TypeOf(model)
+	for i := 0; i < modelType.NumField(); i++ {
+		name := strings.Split(modelType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			known[name] = true
+		}
+	}
+	for _, field := range readOnly {
+		known[field] = false
+	}
+	return patchedFields(patch, func(field string) bool { return !known[field] })
+}
+
+// patchedFields returns, in order, the patched fields that match
+func patchedFields(patch map[string]json.RawMessage, match func(string) bool) []string {
+	fields := []string{}
+	for field := range patch {
+		if match(field) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -18,14 +20,16 @@ func NewFetchNotes(
 	fetchBySystemIntakeID func(context.Context, uuid.UUID) ([]*models.Note, error),
 	authorize func(context.Context) (bool, error),
 ) func(context.Context, uuid.UUID) ([]*models.Note, error) {
-	return func(ctx context.Context, id uuid.UUID) ([]*models.Note, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ []*models.Note, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "Notes", id.String(), err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
 		}
 		if !ok {
 			return nil, &apperrors.ResourceNotFoundError{
-				Err:      errors.New("failed to authorize fetch notes"),
+				Err:      &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch notes")},
 				Resource: models.Note{},
 			}
 		}
@@ -40,19 +44,27 @@ func NewCreateNote(
 	create func(context.Context, *models.Note) (*models.Note, error),
 	authorize func(context.Context) (bool, error),
 ) func(context.Context, *models.Note) (*models.Note, error) {
-	return func(ctx context.Context, note *models.Note) (*models.Note, error) {
+	return func(ctx context.Context, note *models.Note) (_ *models.Note, err error) {
+		var noteID string
+		defer func() { audit.Record(ctx, authz.ActionCreate, "Note", noteID, err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
 		}
 		if !ok {
 			return nil, &apperrors.ResourceNotFoundError{
-				Err:      errors.New("failed to authorize create note"),
+				Err:      &apperrors.UnauthorizedError{Err: errors.New("failed to authorize create note")},
 				Resource: models.Note{},
 			}
 		}
 		note.AuthorEUAID = appcontext.Principal(ctx).ID()
 
-		return create(ctx, note)
+		created, err := create(ctx, note)
+		if err != nil {
+			return nil, err
+		}
+		noteID = created.ID.String()
+		return created, nil
 	}
 }
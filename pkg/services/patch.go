@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/appvalidation"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// fields that the server keeps, or that only change through actions or their own endpoints
+var (
+	systemIntakeReadOnlyFields = []string{
+		"id", "euaUserId", "status", "createdAt", "updatedAt", "submittedAt", "decidedAt", "archivedAt",
+		"deletedAt", "deletedBy", "version", "alfabetID", "businessCase", "fundingSources", "documents", "delegates",
+		// only resolving the collaborators sets these
+		"issoEuaUserId", "issoEmail", "trbCollaboratorEuaUserId", "trbCollaboratorEmail",
+		"oitSecurityCollaboratorEuaUserId", "oitSecurityCollaboratorEmail",
+		"eaCollaboratorEuaUserId", "eaCollaboratorEmail", "collaboratorWarnings",
+	}
+	businessCaseReadOnlyFields = []string{
+		"id", "euaUserId", "systemIntakeId", "systemIntakeStatus", "status", "createdAt", "updatedAt",
+		"submittedAt", "initialSubmittedAt", "lastSubmittedAt", "deletedAt", "deletedBy", "version", "cedarId",
+	}
+	// decisions are only the GRT's to make
+	systemIntakeDecisionFields = map[string]bool{
+		"lcid":               true,
+		"lcidExpiresAt":      true,
+		"lcidScope":          true,
+		"lifecycleNextSteps": true,
+		"decisionNextSteps":  true,
+		"rejectionReason":    true,
+		"grtDate":            true,
+		"grbDate":            true,
+		"grtReviewEmailBody": true,
+	}
+)
+
+// NewPatchSystemIntake is a service to apply a JSON Merge Patch to a stored SystemIntake.
+// A version of 0 patches whichever version is stored when the patch is applied.
+func NewPatchSystemIntake(
+	config Config,
+	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	authorizeDecisionFields func(context.Context) (bool, error),
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
+) func(context.Context, uuid.UUID, map[string]json.RawMessage, int) (*models.SystemIntake, error) {
+	return func(ctx context.Context, id uuid.UUID, patch map[string]json.RawMessage, version int) (_ *models.SystemIntake, err error) {
+		defer func() { audit.Record(ctx, authz.ActionUpdate, "SystemIntake", id.String(), err) }()
+
+		existing, err := fetch(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, existing)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize patch system intake")}
+		}
+
+		valErr := apperrors.NewValidationError(
+			errors.New("system intake patch failed validation"),
+			models.SystemIntake{},
+			id.String(),
+		)
+		for _, field := range unpatchableFields(patch, models.SystemIntake{}, systemIntakeReadOnlyFields) {
+			valErr.WithValidation(field, "cannot be patched")
+		}
+		if len(valErr.Validations) > 0 {
+			return nil, &valErr
+		}
+		decisionFields := patchedFields(patch, func(field string) bool { return systemIntakeDecisionFields[field] })
+		if len(decisionFields) > 0 {
+			ok, err = authorizeDecisionFields(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, &apperrors.UnauthorizedError{
+					Err: fmt.Errorf("failed to authorize patching %s", strings.Join(decisionFields, ", ")),
+				}
+			}
+		}
+
+		patched := &models.SystemIntake{}
+		if err = applyMergePatch(existing, patch, patched); err != nil {
+			return nil, patchError(err, &valErr)
+		}
+		patched.Version = existing.Version
+		if version != 0 {
+			patched.Version = version
+		}
+		if err = appvalidation.SystemIntakeForUpdate(patched); err != nil {
+			return nil, err
+		}
+		updatedAt := config.clock.Now()
+		patched.UpdatedAt = &updatedAt
+
+		updated, err := update(ctx, patched)
+		var conflictErr *apperrors.ResourceConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, &apperrors.QueryError{
+				Err:       err,
+				Model:     patched,
+				Operation: apperrors.QuerySave,
+			}
+		}
+
+		if saved, fetchErr := fetch(ctx, id); fetchErr == nil {
+			recordChanges(ctx, existing, saved)
+		}
+		return updated, nil
+	}
+}
+
+// NewPatchBusinessCase is a service to apply a JSON Merge Patch to a stored BusinessCase.
+// A version of 0 patches whichever version is stored when the patch is applied.
+// The fixed As Is, Preferred, A and B fields can be patched, or the alternatives, but not both at once.
+func NewPatchBusinessCase(
+	config Config,
+	fetch func(context.Context, uuid.UUID) (*models.BusinessCase, error),
+	update func(context.Context, *models.BusinessCase) (*models.BusinessCase, error),
+	authorize func(context.Context, *models.BusinessCase) (bool, error),
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
+) func(context.Context, uuid.UUID, map[string]json.RawMessage, int) (*models.BusinessCase, error) {
+	return func(ctx context.Context, id uuid.UUID, patch map[string]json.RawMessage, version int) (_ *models.BusinessCase, err error) {
+		defer func() { audit.Record(ctx, authz.ActionUpdate, "BusinessCase", id.String(), err) }()
+
+		existing, err := fetch(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, existing)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize patch business case")}
+		}
+
+		valErr := apperrors.NewValidationError(
+			errors.New("business case patch failed validation"),
+			models.BusinessCase{},
+			id.String(),
+		)
+		for _, field := range unpatchableFields(patch, models.BusinessCase{}, businessCaseReadOnlyFields) {
+			valErr.WithValidation(field, "cannot be patched")
+		}
+		legacyFields := patchedFields(patch, isLegacyAlternativeField)
+		if _, ok := patch["alternatives"]; ok {
+			for _, field := range legacyFields {
+				valErr.WithValidation(field, "cannot be patched along with alternatives")
+			}
+		}
+		if len(valErr.Validations) > 0 {
+			return nil, &valErr
+		}
+
+		patched := &models.BusinessCase{}
+		if err = applyMergePatch(existing, patch, patched); err != nil {
+			return nil, patchError(err, &valErr)
+		}
+		// saving rebuilds the fixed fields from the alternatives,
+		// so a patch to the fixed fields has to be saved without them
+		if len(legacyFields) > 0 {
+			patched.Alternatives = nil
+		}
+		patched.Version = existing.Version
+		if version != 0 {
+			patched.Version = version
+		}
+		if err = appvalidation.LifecycleCostConfigurationForSave(patched); err != nil {
+			return nil, err
+		}
+		updatedAt := config.clock.Now()
+		patched.UpdatedAt = &updatedAt
+
+		updated, err := update(ctx, patched)
+		var conflictErr *apperrors.ResourceConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, &apperrors.QueryError{
+				Err:       err,
+				Model:     patched,
+				Operation: apperrors.QuerySave,
+			}
+		}
+
+		// lifecycle cost lines are recreated on save, so compare against what was saved
+		if saved, fetchErr := fetch(ctx, id); fetchErr == nil {
+			recordChanges(ctx, existing, saved)
+		}
+		return updated, nil
+	}
+}
+
+// isLegacyAlternativeField is whether a business case field belongs to
+// the fixed As Is, Preferred, A and B alternatives
+func isLegacyAlternativeField(field string) bool {
+	for _, prefix := range []string{"asIs", "preferred", "alternativeA", "alternativeB"} {
+		if strings.HasPrefix(field, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// patchError reports a patched value of the wrong type as a validation error on that field.
+// Values that fail their own decoding, like malformed times, are a bad request.
+func patchError(err error, valErr *apperrors.ValidationError) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		valErr.WithValidation(typeErr.Field, "cannot be a "+typeErr.Value)
+		return valErr
+	}
+	return &apperrors.BadRequestError{Err: err}
+}
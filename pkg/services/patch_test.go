@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func newMergePatch(s ServicesTestSuite, patch string) map[string]json.RawMessage {
+	decoded := map[string]json.RawMessage{}
+	s.NoError(json.Unmarshal([]byte(patch), &decoded))
+	return decoded
+}
+
+func (s ServicesTestSuite) TestApplyMergePatch() {
+	s.Run("merges objects, removes nulls and replaces arrays", func() {
+		original := map[string]interface{}{
+			"kept":     "value",
+			"removed":  "value",
+			"replaced": []int{1, 2},
+			"nested":   map[string]interface{}{"a": 1, "b": 2},
+		}
+		patch := newMergePatch(s, `{"removed": null, "replaced": [3], "nested": {"b": null, "c": 3}, "added": 12345678901234}`)
+
+		var patched map[string]interface{}
+		s.NoError(applyMergePatch(original, patch, &patched))
+		s.Equal(map[string]interface{}{
+			"kept":     "value",
+			"replaced": []interface{}{float64(3)},
+			"nested":   map[string]interface{}{"a": float64(1), "c": float64(3)},
+			"added":    float64(12345678901234),
+		}, patched)
+	})
+}
+
+func (s ServicesTestSuite) TestPatchSystemIntake() {
+	serviceConfig := NewConfig(s.logger, nil)
+	ctx := context.Background()
+	id := uuid.New()
+	lcidExpiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &models.SystemIntake{
+			ID:                 id,
+			EUAUserID:          null.StringFrom("ABCD"),
+			Status:             models.SystemIntakeStatusINTAKEDRAFT,
+			RequestType:        models.SystemIntakeRequestTypeNEW,
+			Requester:          "Test Requester",
+			ProjectName:        null.StringFrom("Project"),
+			ISSO:               null.StringFrom("ISSO"),
+			LifecycleID:        null.StringFrom("123456"),
+			LifecycleExpiresAt: &lcidExpiresAt,
+			Version:            4,
+		}, nil
+	}
+	update := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+		return intake, nil
+	}
+	authorize := func(context.Context, *models.SystemIntake) (bool, error) { return true, nil }
+	isGRT := func(context.Context) (bool, error) { return true, nil }
+	isNotGRT := func(context.Context) (bool, error) { return false, nil }
+
+	s.Run("patches only the fields in the patch", func() {
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, update, authorize, isNotGRT, noRecordFieldChanges)
+		patched, err := patchSystemIntake(ctx, id, newMergePatch(s, `{"projectName": "New Project", "isso": null}`), 0)
+
+		s.NoError(err)
+		s.Equal(id, patched.ID)
+		s.Equal("New Project", patched.ProjectName.String)
+		s.False(patched.ISSO.Valid)
+		s.Equal("Test Requester", patched.Requester)
+		s.Equal("ABCD", patched.EUAUserID.String)
+		s.Equal(models.SystemIntakeStatusINTAKEDRAFT, patched.Status)
+		s.Equal(4, patched.Version)
+		s.NotNil(patched.UpdatedAt)
+	})
+
+	s.Run("checks the given version", func() {
+		var updatedVersion int
+		versionedUpdate := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			updatedVersion = intake.Version
+			return nil, &apperrors.ResourceConflictError{Err: errors.New("stale"), CurrentVersion: 4}
+		}
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, versionedUpdate, authorize, isNotGRT, noRecordFieldChanges)
+		_, err := patchSystemIntake(ctx, id, newMergePatch(s, `{"projectName": "New Project"}`), 3)
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+		s.Equal(3, updatedVersion)
+	})
+
+	s.Run("refuses read only and unknown fields", func() {
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, update, authorize, isGRT, noRecordFieldChanges)
+		_, err := patchSystemIntake(ctx, id, newMergePatch(s, `{"status": "APPROVED", "euaUserId": "EFGH", "color": "blue"}`), 0)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(map[string]string{
+			"color":     "cannot be patched",
+			"euaUserId": "cannot be patched",
+			"status":    "cannot be patched",
+		}, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("refuses resolved collaborator fields", func() {
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, update, authorize, isGRT, noRecordFieldChanges)
+		_, err := patchSystemIntake(ctx, id, newMergePatch(s, `{"issoEmail": "someone@example.com", "eaCollaboratorEuaUserId": "ABCD", "collaboratorWarnings": {}}`), 0)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(map[string]string{
+			"collaboratorWarnings":    "cannot be patched",
+			"eaCollaboratorEuaUserId": "cannot be patched",
+			"issoEmail":               "cannot be patched",
+		}, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("only the GRT can patch decision fields", func() {
+		patch := newMergePatch(s, `{"lcidScope": "Everything", "decisionNextSteps": "Nothing"}`)
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, update, authorize, isNotGRT, noRecordFieldChanges)
+		_, err := patchSystemIntake(ctx, id, patch, 0)
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+
+		patchSystemIntake = NewPatchSystemIntake(serviceConfig, fetch, update, authorize, isGRT, noRecordFieldChanges)
+		patched, err := patchSystemIntake(ctx, id, patch, 0)
+		s.NoError(err)
+		s.Equal("Everything", patched.LifecycleScope.String)
+	})
+
+	s.Run("returns unauthorized for someone else's intake", func() {
+		unauthorized := func(context.Context, *models.SystemIntake) (bool, error) { return false, nil }
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, update, unauthorized, isGRT, noRecordFieldChanges)
+		_, err := patchSystemIntake(ctx, id, newMergePatch(s, `{"projectName": "New Project"}`), 0)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("validates the patched values", func() {
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, update, authorize, isGRT, noRecordFieldChanges)
+		_, err := patchSystemIntake(ctx, id, newMergePatch(s, `{"requester": 5}`), 0)
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(map[string]string{"requester": "cannot be a number"}, err.(*apperrors.ValidationError).Validations.Map())
+
+		_, err = patchSystemIntake(ctx, id, newMergePatch(s, `{"requestType": "OTHER", "lcidExpiresAt": null}`), 0)
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(map[string]string{
+			"RequestType":        "is invalid",
+			"LifecycleExpiresAt": "is required when there is a lifecycle ID",
+		}, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("returns query error if update fails", func() {
+		failUpdate := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			return nil, errors.New("update error")
+		}
+		patchSystemIntake := NewPatchSystemIntake(serviceConfig, fetch, failUpdate, authorize, isNotGRT, noRecordFieldChanges)
+		_, err := patchSystemIntake(ctx, id, newMergePatch(s, `{"projectName": "New Project"}`), 0)
+
+		s.IsType(&apperrors.QueryError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestPatchBusinessCase() {
+	serviceConfig := NewConfig(s.logger, nil)
+	ctx := context.Background()
+	id := uuid.New()
+	phase := models.LifecycleCostPhaseDEVELOPMENT
+	cost := 100
+
+	fetch := func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+		return &models.BusinessCase{
+			ID:                   id,
+			EUAUserID:            "ABCD",
+			SystemIntakeID:       uuid.New(),
+			Status:               models.BusinessCaseStatusOPEN,
+			ProjectName:          null.StringFrom("Project"),
+			BusinessNeed:         null.StringFrom("Need"),
+			LifecycleCostHorizon: 5,
+			LifecycleCostPhases:  models.LifecycleCostPhases{phase},
+			LifecycleCostLines: models.EstimatedLifecycleCosts{
+				{Solution: models.LifecycleCostSolutionPREFERRED, Phase: &phase, Year: models.LifecycleCostYear1, Cost: &cost},
+			},
+			Version: 2,
+		}, nil
+	}
+	update := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+		return businessCase, nil
+	}
+	authorize := func(context.Context, *models.BusinessCase) (bool, error) { return true, nil }
+
+	s.Run("patches only the fields in the patch", func() {
+		patchBusinessCase := NewPatchBusinessCase(serviceConfig, fetch, update, authorize, noRecordFieldChanges)
+		patched, err := patchBusinessCase(ctx, id, newMergePatch(s, `{"businessNeed": "New need"}`), 0)
+
+		s.NoError(err)
+		s.Equal("New need", patched.BusinessNeed.String)
+		s.Equal("Project", patched.ProjectName.String)
+		s.Len(patched.LifecycleCostLines, 1)
+		s.Equal(2, patched.Version)
+	})
+
+	s.Run("refuses read only fields", func() {
+		patchBusinessCase := NewPatchBusinessCase(serviceConfig, fetch, update, authorize, noRecordFieldChanges)
+		_, err := patchBusinessCase(ctx, id, newMergePatch(s, `{"systemIntakeId": "`+uuid.New().String()+`"}`), 0)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(map[string]string{"systemIntakeId": "cannot be patched"}, err.(*apperrors.ValidationError).Validations.Map())
+	})
+
+	s.Run("validates the lifecycle cost configuration", func() {
+		patchBusinessCase := NewPatchBusinessCase(serviceConfig, fetch, update, authorize, noRecordFieldChanges)
+		_, err := patchBusinessCase(ctx, id, newMergePatch(s, `{"lifecycleCostHorizon": 50}`), 0)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("returns unauthorized for someone else's business case", func() {
+		unauthorized := func(context.Context, *models.BusinessCase) (bool, error) { return false, nil }
+		patchBusinessCase := NewPatchBusinessCase(serviceConfig, fetch, update, unauthorized, noRecordFieldChanges)
+		_, err := patchBusinessCase(ctx, id, newMergePatch(s, `{"businessNeed": "New need"}`), 0)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("saves a patch to the fixed alternative fields without the alternatives list", func() {
+		withAlternatives := func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+			businessCase, err := fetch(ctx, id)
+			businessCase.Alternatives = businessCase.LegacyAlternatives()
+			return businessCase, err
+		}
+		patchBusinessCase := NewPatchBusinessCase(serviceConfig, withAlternatives, update, authorize, noRecordFieldChanges)
+		patched, err := patchBusinessCase(ctx, id, newMergePatch(s, `{"preferredTitle": "Preferred"}`), 0)
+
+		s.NoError(err)
+		s.Equal("Preferred", patched.PreferredTitle.String)
+		s.Empty(patched.Alternatives)
+	})
+
+	s.Run("refuses fixed alternative fields patched along with alternatives", func() {
+		patchBusinessCase := NewPatchBusinessCase(serviceConfig, fetch, update, authorize, noRecordFieldChanges)
+		_, err := patchBusinessCase(ctx, id, newMergePatch(s, `{"preferredTitle": "Preferred", "alternatives": []}`), 0)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(
+			map[string]string{"preferredTitle": "cannot be patched along with alternatives"},
+			err.(*apperrors.ValidationError).Validations.Map(),
+		)
+	})
+
+	s.Run("passes on a stale version conflict", func() {
+		staleUpdate := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+			return nil, &apperrors.ResourceConflictError{Err: errors.New("stale"), CurrentVersion: 3}
+		}
+		patchBusinessCase := NewPatchBusinessCase(serviceConfig, fetch, staleUpdate, authorize, noRecordFieldChanges)
+		_, err := patchBusinessCase(ctx, id, newMergePatch(s, `{"businessNeed": "New need"}`), 2)
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestPatchBusinessCaseFixedAlternativeFields() {
+	serviceConfig := NewConfig(s.logger, nil)
+	ctx := context.Background()
+	authorize := func(context.Context, *models.BusinessCase) (bool, error) { return true, nil }
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.SystemIntakeID = intake.ID
+	created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+	existing, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+	s.NoError(err)
+
+	patchBusinessCase := NewPatchBusinessCase(serviceConfig, s.store.FetchBusinessCaseByID, s.store.UpdateBusinessCase, authorize, noRecordFieldChanges)
+	_, err = patchBusinessCase(ctx, created.ID, newMergePatch(s, `{"preferredTitle": "Patched title"}`), existing.Version)
+	s.NoError(err)
+
+	saved, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+	s.NoError(err)
+	s.Equal("Patched title", saved.PreferredTitle.String)
+	s.Equal(existing.AsIsTitle, saved.AsIsTitle)
+	s.Len(saved.Alternatives, len(existing.Alternatives))
+	for i, alternative := range saved.Alternatives {
+		s.Equal(existing.Alternatives[i].ID, alternative.ID)
+		if alternative.Role == models.BusinessCaseAlternativeRolePREFERRED {
+			s.Equal("Patched title", alternative.Title.String)
+		}
+	}
+}
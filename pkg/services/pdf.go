@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/pdf"
+)
+
+// pdfGeneratorSource names the PDF generator in external API errors
+const pdfGeneratorSource = "PDF Generator"
+
+// NewInvokeGeneratePDF returns a function that renders HTML as a PDF
+// with the given generator, within the given limits
+func NewInvokeGeneratePDF(config Config, generator pdf.Generator, limits pdf.Limits) func(ctx context.Context, html string) ([]byte, error) {
+	limits = limits.WithDefaults()
+	return func(ctx context.Context, html string) ([]byte, error) {
+		if len(html) > limits.MaxHTMLBytes {
+			return nil, &apperrors.BadRequestError{
+				Err: fmt.Errorf("html of %d bytes exceeds the limit of %d bytes", len(html), limits.MaxHTMLBytes),
+			}
+		}
+
+		generateCtx, cancel := context.WithTimeout(ctx, limits.Timeout)
+		defer cancel()
+
+		content, err := generator.GeneratePDF(generateCtx, html)
+		if err == nil && generateCtx.Err() != nil {
+			err = generateCtx.Err()
+		}
+		if err != nil {
+			return nil, &apperrors.ExternalAPIError{
+				Err:       err,
+				Model:     models.PDF{},
+				Operation: apperrors.Submit,
+				Source:    pdfGeneratorSource,
+			}
+		}
+		if len(content) > limits.MaxPDFBytes {
+			return nil, &apperrors.ExternalAPIError{
+				Err:       fmt.Errorf("pdf of %d bytes exceeds the limit of %d bytes", len(content), limits.MaxPDFBytes),
+				Model:     models.PDF{},
+				Operation: apperrors.Submit,
+				Source:    pdfGeneratorSource,
+			}
+		}
+		return content, nil
+	}
+}
+
+// NewGenerateSystemIntakePDF is a service to render a stored SystemIntake as a PDF
+func NewGenerateSystemIntakePDF(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	renderHTML func(*models.SystemIntake) (string, error),
+	generatePDF func(context.Context, string) ([]byte, error),
+	cache pdf.Cache,
+) func(context.Context, uuid.UUID) (*models.PDF, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ *models.PDF, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "SystemIntakePDF", id.String(), err) }()
+
+		intake, err := fetchIntake(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize generate system intake pdf")}
+		}
+		html, err := renderHTML(intake)
+		if err != nil {
+			return nil, err
+		}
+		return generateCachedPDF(
+			ctx,
+			generatePDF,
+			cache,
+			pdf.CacheKey("system_intake", intake.ID, intake.UpdatedAt, html),
+			html,
+			fmt.Sprintf("system_intake_%s.pdf", intake.ID),
+		)
+	}
+}
+
+// NewGenerateBusinessCasePDF is a service to render a stored BusinessCase as a PDF
+func NewGenerateBusinessCasePDF(
+	config Config,
+	fetchBusinessCase func(context.Context, uuid.UUID) (*models.BusinessCase, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	renderHTML func(*models.BusinessCase) (string, error),
+	generatePDF func(context.Context, string) ([]byte, error),
+	cache pdf.Cache,
+) func(context.Context, uuid.UUID) (*models.PDF, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ *models.PDF, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "BusinessCasePDF", id.String(), err) }()
+
+		businessCase, err := fetchBusinessCase(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		intake, err := fetchIntake(ctx, businessCase.SystemIntakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize generate business case pdf")}
+		}
+		html, err := renderHTML(businessCase)
+		if err != nil {
+			return nil, err
+		}
+		return generateCachedPDF(
+			ctx,
+			generatePDF,
+			cache,
+			pdf.CacheKey("business_case", businessCase.ID, businessCase.UpdatedAt, html),
+			html,
+			fmt.Sprintf("business_case_%s.pdf", businessCase.ID),
+		)
+	}
+}
+
+// NewGenerateDecisionPDF is a service to render the decision on a SystemIntake as a PDF
+func NewGenerateDecisionPDF(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	renderHTML func(*models.SystemIntake) (string, error),
+	generatePDF func(context.Context, string) ([]byte, error),
+	cache pdf.Cache,
+) func(context.Context, uuid.UUID) (*models.PDF, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ *models.PDF, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "DecisionPDF", id.String(), err) }()
+
+		intake, err := fetchIntake(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize generate decision pdf")}
+		}
+		if !intake.LifecycleID.Valid && !intake.RejectionReason.Valid {
+			return nil, &apperrors.ResourceConflictError{
+				Err:        errors.New("system intake does not have a decision"),
+				Resource:   intake,
+				ResourceID: intake.ID.String(),
+			}
+		}
+		html, err := renderHTML(intake)
+		if err != nil {
+			return nil, err
+		}
+		return generateCachedPDF(
+			ctx,
+			generatePDF,
+			cache,
+			pdf.CacheKey("decision", intake.ID, intake.UpdatedAt, html),
+			html,
+			fmt.Sprintf("decision_%s.pdf", intake.ID),
+		)
+	}
+}
+
+// generateCachedPDF returns the cached PDF for the key, generating and caching it if needed.
+// Cache failures are logged rather than returned, since we can always generate the PDF again.
+func generateCachedPDF(
+	ctx context.Context,
+	generatePDF func(context.Context, string) ([]byte, error),
+	cache pdf.Cache,
+	key string,
+	html string,
+	filename string,
+) (*models.PDF, error) {
+	logger := appcontext.ZLogger(ctx).With(zap.String("cacheKey", key))
+	content, found, err := cache.Fetch(key)
+	if err != nil {
+		logger.Warn("Failed to fetch cached pdf", zap.Error(err))
+	}
+	if found {
+		return &models.PDF{Filename: filename, Content: content}, nil
+	}
+
+	content, err = generatePDF(ctx, html)
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.Store(key, content); err != nil {
+		logger.Warn("Failed to cache pdf", zap.Error(err))
+	}
+	return &models.PDF{Filename: filename, Content: content}, nil
+}
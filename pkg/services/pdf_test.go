@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/pdf"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+type mockPDFCache struct {
+	content  map[string][]byte
+	fetchErr error
+}
+
+func newMockPDFCache() *mockPDFCache {
+	return &mockPDFCache{content: map[string][]byte{}}
+}
+
+func (c *mockPDFCache) Fetch(key string) ([]byte, bool, error) {
+	if c.fetchErr != nil {
+		return nil, false, c.fetchErr
+	}
+	content, ok := c.content[key]
+	return content, ok, nil
+}
+
+func (c *mockPDFCache) Store(key string, content []byte) error {
+	c.content[key] = content
+	return nil
+}
+
+type mockPDFGenerator func(context.Context, string) ([]byte, error)
+
+func (g mockPDFGenerator) GeneratePDF(ctx context.Context, html string) ([]byte, error) {
+	return g(ctx, html)
+}
+
+func (s ServicesTestSuite) TestInvokeGeneratePDF() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := context.Background()
+	generator := mockPDFGenerator(func(_ context.Context, html string) ([]byte, error) {
+		return []byte("%PDF " + html), nil
+	})
+
+	s.Run("generates a PDF", func() {
+		generate := NewInvokeGeneratePDF(cfg, generator, pdf.Limits{})
+		content, err := generate(ctx, "<p>hi</p>")
+		s.NoError(err)
+		s.Equal([]byte("%PDF <p>hi</p>"), content)
+	})
+
+	s.Run("rejects HTML over the size limit", func() {
+		generate := NewInvokeGeneratePDF(cfg, generator, pdf.Limits{MaxHTMLBytes: 4})
+		_, err := generate(ctx, "<p>hi</p>")
+		s.IsType(&apperrors.BadRequestError{}, err)
+	})
+
+	s.Run("rejects PDFs over the size limit", func() {
+		generate := NewInvokeGeneratePDF(cfg, generator, pdf.Limits{MaxPDFBytes: 4})
+		_, err := generate(ctx, strings.Repeat("x", 10))
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+	})
+
+	s.Run("times out slow generators", func() {
+		slow := mockPDFGenerator(func(ctx context.Context, _ string) ([]byte, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		generate := NewInvokeGeneratePDF(cfg, slow, pdf.Limits{Timeout: time.Millisecond})
+		_, err := generate(ctx, "<p>hi</p>")
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+		s.True(errors.Is(err, context.DeadlineExceeded))
+	})
+}
+
+func (s ServicesTestSuite) TestGenerateSystemIntakePDF() {
+	cfg := NewConfig(nil, nil)
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	renderHTML := func(i *models.SystemIntake) (string, error) {
+		return "<p>" + i.ID.String() + "</p>", nil
+	}
+	var renderedHTML string
+	generatePDF := func(_ context.Context, html string) ([]byte, error) {
+		renderedHTML = html
+		return []byte("%PDF"), nil
+	}
+
+	s.Run("renders the stored intake", func() {
+		generate := NewGenerateSystemIntakePDF(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), renderHTML, generatePDF, newMockPDFCache())
+		pdf, err := generate(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal([]byte("%PDF"), pdf.Content)
+		s.Equal("system_intake_"+intake.ID.String()+".pdf", pdf.Filename)
+		s.Equal("<p>"+intake.ID.String()+"</p>", renderedHTML)
+	})
+
+	s.Run("returns unauthorized if the user is not authorized", func() {
+		requesterCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+		generate := NewGenerateSystemIntakePDF(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), renderHTML, generatePDF, newMockPDFCache())
+		_, err := generate(requesterCtx, intake.ID)
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns the cached PDF for the same version", func() {
+		cache := newMockPDFCache()
+		calls := 0
+		countingGenerate := func(context.Context, string) ([]byte, error) {
+			calls++
+			return []byte("%PDF"), nil
+		}
+		generate := NewGenerateSystemIntakePDF(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), renderHTML, countingGenerate, cache)
+		_, err := generate(ctx, intake.ID)
+		s.NoError(err)
+		_, err = generate(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(1, calls)
+		s.Len(cache.content, 1)
+
+		updatedAt := time.Now()
+		intake.UpdatedAt = &updatedAt
+		_, err = generate(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(2, calls)
+	})
+
+	s.Run("generates the PDF when the cache fails", func() {
+		cache := newMockPDFCache()
+		cache.fetchErr = errors.New("forced error")
+		generate := NewGenerateSystemIntakePDF(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), renderHTML, generatePDF, cache)
+		pdf, err := generate(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal([]byte("%PDF"), pdf.Content)
+	})
+
+	s.Run("returns an error if the render fails", func() {
+		failingRender := func(*models.SystemIntake) (string, error) { return "", errors.New("forced error") }
+		generate := NewGenerateSystemIntakePDF(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), failingRender, generatePDF, newMockPDFCache())
+		_, err := generate(ctx, intake.ID)
+		s.Error(err)
+	})
+}
+
+func (s ServicesTestSuite) TestGenerateBusinessCasePDF() {
+	cfg := NewConfig(nil, nil)
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.SystemIntakeID = intake.ID
+	fetchBusinessCase := func(_ context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+		return &businessCase, nil
+	}
+	var fetchedIntakeID uuid.UUID
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		fetchedIntakeID = id
+		return &intake, nil
+	}
+	renderHTML := func(*models.BusinessCase) (string, error) { return "<p>business case</p>", nil }
+	generatePDF := func(context.Context, string) ([]byte, error) { return []byte("%PDF"), nil }
+
+	generate := NewGenerateBusinessCasePDF(cfg, fetchBusinessCase, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), renderHTML, generatePDF, newMockPDFCache())
+	pdf, err := generate(ctx, businessCase.ID)
+	s.NoError(err)
+	s.Equal(intake.ID, fetchedIntakeID)
+	s.Equal("business_case_"+businessCase.ID.String()+".pdf", pdf.Filename)
+}
+
+func (s ServicesTestSuite) TestGenerateDecisionPDF() {
+	cfg := NewConfig(nil, nil)
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	renderHTML := func(*models.SystemIntake) (string, error) { return "<p>decision</p>", nil }
+	generatePDF := func(context.Context, string) ([]byte, error) { return []byte("%PDF"), nil }
+
+	s.Run("renders an issued lifecycle ID", func() {
+		intake := testhelpers.NewSystemIntake()
+		intake.LifecycleID = null.StringFrom("210001")
+		fetchIntake := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return &intake, nil }
+		generate := NewGenerateDecisionPDF(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), renderHTML, generatePDF, newMockPDFCache())
+		pdf, err := generate(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal("decision_"+intake.ID.String()+".pdf", pdf.Filename)
+	})
+
+	s.Run("returns a conflict if there is no decision yet", func() {
+		intake := testhelpers.NewSystemIntake()
+		fetchIntake := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return &intake, nil }
+		generate := NewGenerateDecisionPDF(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), renderHTML, generatePDF, newMockPDFCache())
+		_, err := generate(ctx, intake.ID)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewSearchPeople returns a function that searches the CEDAR directory for people
+func NewSearchPeople(
+	config Config,
+	search func(context.Context, string) ([]*models.UserInfo, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, string) ([]*models.UserInfo, error) {
+	return func(ctx context.Context, query string) (_ []*models.UserInfo, err error) {
+		// the query is someone's name, so it isn't saved with the audit event
+		defer func() { audit.Record(ctx, authz.ActionRead, "People", "", err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize search people")}
+		}
+		return search(ctx, query)
+	}
+}
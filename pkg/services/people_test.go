@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestSearchPeople() {
+	cfg := NewConfig(nil, nil)
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+	search := func(_ context.Context, query string) ([]*models.UserInfo, error) {
+		return []*models.UserInfo{{CommonName: query, EuaUserID: "ABCD"}}, nil
+	}
+
+	s.Run("searches for people", func() {
+		searchPeople := NewSearchPeople(cfg, search, NewAuthorizeHasEASiRole())
+
+		people, err := searchPeople(ctx, "Ada")
+
+		s.NoError(err)
+		s.Len(people, 1)
+		s.Equal("ABCD", people[0].EuaUserID)
+	})
+
+	s.Run("returns unauthorized for users without EASi access", func() {
+		searchPeople := NewSearchPeople(cfg, search, NewAuthorizeHasEASiRole())
+
+		_, err := searchPeople(context.Background(), "Ada")
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+)
+
+// NewSoftDelete is a service to soft delete a resource, recording who deleted it
+func NewSoftDelete(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	resourceType string,
+	softDelete func(context.Context, uuid.UUID, string) error,
+) func(context.Context, uuid.UUID) error {
+	return func(ctx context.Context, id uuid.UUID) (err error) {
+		defer func() { audit.Record(ctx, authz.ActionRemove, resourceType, id.String(), err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize soft delete")}
+		}
+		return softDelete(ctx, id, appcontext.Principal(ctx).ID())
+	}
+}
+
+// NewRestore is a service to bring back a soft deleted resource
+func NewRestore(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	resourceType string,
+	restore func(context.Context, uuid.UUID) error,
+) func(context.Context, uuid.UUID) error {
+	return func(ctx context.Context, id uuid.UUID) (err error) {
+		defer func() { audit.Record(ctx, "restore", resourceType, id.String(), err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize restore")}
+		}
+		return restore(ctx, id)
+	}
+}
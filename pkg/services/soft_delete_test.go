@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestSoftDelete() {
+	cfg := NewConfig(s.logger, nil)
+	authorize := NewAuthorize(authz.ActionRemove, (*models.SystemIntake)(nil))
+	id := uuid.New()
+
+	s.Run("the GRT can soft delete, recording who did it", func() {
+		reviewer := testhelpers.NewReviewerPrincipal()
+		ctx := appcontext.WithPrincipal(context.Background(), reviewer)
+		var deletedBy string
+		softDelete := func(_ context.Context, deletedID uuid.UUID, euaUserID string) error {
+			s.Equal(id, deletedID)
+			deletedBy = euaUserID
+			return nil
+		}
+
+		err := NewSoftDelete(cfg, authorize, "SystemIntake", softDelete)(ctx, id)
+
+		s.NoError(err)
+		s.Equal(reviewer.ID(), deletedBy)
+	})
+
+	s.Run("requesters cannot soft delete", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+		softDelete := func(context.Context, uuid.UUID, string) error {
+			s.Fail("should not delete")
+			return nil
+		}
+
+		err := NewSoftDelete(cfg, authorize, "SystemIntake", softDelete)(ctx, id)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestRestore() {
+	cfg := NewConfig(s.logger, nil)
+	authorize := NewAuthorize(authz.ActionRemove, (*models.BusinessCase)(nil))
+	id := uuid.New()
+
+	s.Run("the GRT can restore", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+		restored := false
+		restore := func(_ context.Context, restoredID uuid.UUID) error {
+			restored = restoredID == id
+			return nil
+		}
+
+		s.NoError(NewRestore(cfg, authorize, "SystemIntake", restore)(ctx, id))
+		s.True(restored)
+	})
+
+	s.Run("requesters cannot restore", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+		restore := func(context.Context, uuid.UUID) error {
+			s.Fail("should not restore")
+			return nil
+		}
+
+		err := NewRestore(cfg, authorize, "SystemIntake", restore)(ctx, id)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
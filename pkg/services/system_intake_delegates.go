@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+var delegateRoleNames = map[models.SystemIntakeDelegateRole]string{
+	models.SystemIntakeDelegateRoleCOOWNER: "co-owner",
+	models.SystemIntakeDelegateRoleVIEWER:  "viewer",
+}
+
+// NewFetchSystemIntakeDelegates is a service to fetch the people a SystemIntake is shared with
+func NewFetchSystemIntakeDelegates(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	fetchDelegates func(context.Context, uuid.UUID) (models.SystemIntakeDelegates, error),
+) func(context.Context, uuid.UUID) (models.SystemIntakeDelegates, error) {
+	return func(ctx context.Context, intakeID uuid.UUID) (_ models.SystemIntakeDelegates, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "SystemIntakeDelegates", intakeID.String(), err) }()
+
+		intake, err := fetchIntake(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch delegates")}
+		}
+		return fetchDelegates(ctx, intake.ID)
+	}
+}
+
+// NewSaveSystemIntakeDelegate is a service to share a SystemIntake with someone,
+// or change their role. Their EUA ID is checked in CEDAR LDAP.
+func NewSaveSystemIntakeDelegate(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	save func(context.Context, *models.SystemIntakeDelegate) (*models.SystemIntakeDelegate, error),
+	saveAction func(context.Context, *models.Action) error,
+) func(context.Context, *models.SystemIntakeDelegate) (*models.SystemIntakeDelegate, error) {
+	return func(ctx context.Context, delegate *models.SystemIntakeDelegate) (_ *models.SystemIntakeDelegate, err error) {
+		defer func() {
+			audit.Record(ctx, authz.ActionShare, "SystemIntakeDelegates", delegate.SystemIntakeID.String(), err)
+		}()
+
+		intake, err := fetchIntake(ctx, delegate.SystemIntakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize save delegate")}
+		}
+
+		valErr := apperrors.NewValidationError(
+			errors.New("delegate failed validation"),
+			models.SystemIntakeDelegate{},
+			intake.ID.String(),
+		)
+		if _, ok := delegateRoleNames[delegate.Role]; !ok {
+			valErr.WithValidation("role", "must be CO_OWNER or VIEWER")
+		}
+		euaUserID := strings.ToUpper(strings.TrimSpace(delegate.EUAUserID))
+		if euaUserID == "" {
+			valErr.WithValidation("euaUserId", "is required")
+		} else if euaUserID == intake.EUAUserID.ValueOrZero() {
+			valErr.WithValidation("euaUserId", "is already the requester")
+		}
+		if len(valErr.Validations) > 0 {
+			return nil, &valErr
+		}
+
+		userInfo, err := fetchUserInfo(ctx, euaUserID)
+		if errors.Is(err, cedarldap.ErrUserNotFound) || (err == nil && (userInfo == nil || userInfo.EuaUserID == "")) {
+			valErr.WithValidation("euaUserId", "must be a valid EUA ID")
+			return nil, &valErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		delegate.EUAUserID = userInfo.EuaUserID
+		delegate.CommonName = userInfo.CommonName
+		delegate.Email = userInfo.Email
+
+		saved, err := save(ctx, delegate)
+		if err != nil {
+			return nil, err
+		}
+		err = saveAction(ctx, &models.Action{
+			IntakeID:   &intake.ID,
+			ActionType: models.ActionTypeADDDELEGATE,
+			Feedback: null.StringFrom(fmt.Sprintf(
+				"Added %s (%s) as a %s",
+				saved.CommonName,
+				saved.EUAUserID,
+				delegateRoleNames[saved.Role],
+			)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return saved, nil
+	}
+}
+
+// NewDeleteSystemIntakeDelegate is a service to stop sharing a SystemIntake with someone
+func NewDeleteSystemIntakeDelegate(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	remove func(context.Context, uuid.UUID, string) error,
+	saveAction func(context.Context, *models.Action) error,
+) func(context.Context, uuid.UUID, string) error {
+	return func(ctx context.Context, intakeID uuid.UUID, euaUserID string) (err error) {
+		defer func() { audit.Record(ctx, authz.ActionShare, "SystemIntakeDelegates", intakeID.String(), err) }()
+
+		intake, err := fetchIntake(ctx, intakeID)
+		if err != nil {
+			return err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize delete delegate")}
+		}
+
+		euaUserID = strings.ToUpper(euaUserID)
+		err = remove(ctx, intake.ID, euaUserID)
+		if err != nil {
+			return err
+		}
+		removed := euaUserID
+		for _, delegate := range intake.Delegates {
+			if delegate.EUAUserID == euaUserID {
+				removed = fmt.Sprintf("%s (%s)", delegate.CommonName, euaUserID)
+			}
+		}
+		return saveAction(ctx, &models.Action{
+			IntakeID:   &intake.ID,
+			ActionType: models.ActionTypeREMOVEDELEGATE,
+			Feedback:   null.StringFrom("Removed " + removed),
+		})
+	}
+}
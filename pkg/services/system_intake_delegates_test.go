@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestSaveSystemIntakeDelegate() {
+	cfg := NewConfig(nil, nil)
+	intake := testhelpers.NewSystemIntake()
+	ctx := appcontext.WithPrincipal(
+		context.Background(),
+		&authn.EUAPrincipal{EUAID: intake.EUAUserID.String, JobCodeEASi: true},
+	)
+	fetchIntake := func(context.Context, uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+		if euaID == "ABCD" {
+			return &models.UserInfo{CommonName: "Adeline Aarons", Email: "adeline@example.com", EuaUserID: "ABCD"}, nil
+		}
+		return nil, &apperrors.ExternalAPIError{Err: cedarldap.ErrUserNotFound, Source: "CEDAR LDAP"}
+	}
+	save := func(_ context.Context, delegate *models.SystemIntakeDelegate) (*models.SystemIntakeDelegate, error) {
+		delegate.ID = uuid.New()
+		return delegate, nil
+	}
+
+	s.Run("shares the intake and records an action", func() {
+		var action *models.Action
+		saveAction := func(_ context.Context, a *models.Action) error {
+			action = a
+			return nil
+		}
+		saveDelegate := NewSaveSystemIntakeDelegate(cfg, fetchIntake, NewAuthorizeSystemIntake(authz.ActionShare), fetchUserInfo, save, saveAction)
+
+		delegate, err := saveDelegate(ctx, &models.SystemIntakeDelegate{
+			SystemIntakeID: intake.ID,
+			EUAUserID:      "abcd",
+			Role:           models.SystemIntakeDelegateRoleCOOWNER,
+		})
+
+		s.NoError(err)
+		s.Equal("ABCD", delegate.EUAUserID)
+		s.Equal("Adeline Aarons", delegate.CommonName)
+		s.Equal("adeline@example.com", delegate.Email)
+		s.Equal(models.ActionTypeADDDELEGATE, action.ActionType)
+		s.Equal(null.StringFrom("Added Adeline Aarons (ABCD) as a co-owner"), action.Feedback)
+	})
+
+	s.Run("returns validation errors", func() {
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		saveDelegate := NewSaveSystemIntakeDelegate(cfg, fetchIntake, NewAuthorizeSystemIntake(authz.ActionShare), fetchUserInfo, save, saveAction)
+		testCases := map[string]struct {
+			delegate models.SystemIntakeDelegate
+			key      string
+		}{
+			"unknown role":      {models.SystemIntakeDelegate{EUAUserID: "ABCD", Role: "OWNER"}, "role"},
+			"missing EUA ID":    {models.SystemIntakeDelegate{Role: models.SystemIntakeDelegateRoleVIEWER}, "euaUserId"},
+			"the requester":     {models.SystemIntakeDelegate{EUAUserID: intake.EUAUserID.String, Role: models.SystemIntakeDelegateRoleVIEWER}, "euaUserId"},
+			"not in CEDAR LDAP": {models.SystemIntakeDelegate{EUAUserID: "ZZZZ", Role: models.SystemIntakeDelegateRoleVIEWER}, "euaUserId"},
+		}
+		for name, tc := range testCases {
+			s.Run(name, func() {
+				delegate := tc.delegate
+				_, err := saveDelegate(ctx, &delegate)
+
+				s.IsType(&apperrors.ValidationError{}, err)
+				s.Contains(err.(*apperrors.ValidationError).Validations, tc.key)
+			})
+		}
+	})
+
+	s.Run("returns unauthorized if the user is not authorized", func() {
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		saveDelegate := NewSaveSystemIntakeDelegate(cfg, fetchIntake, NewAuthorizeSystemIntake(authz.ActionShare), fetchUserInfo, save, saveAction)
+		reviewerCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+		_, err := saveDelegate(reviewerCtx, &models.SystemIntakeDelegate{
+			SystemIntakeID: intake.ID,
+			EUAUserID:      "ABCD",
+			Role:           models.SystemIntakeDelegateRoleVIEWER,
+		})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestDeleteSystemIntakeDelegate() {
+	cfg := NewConfig(nil, nil)
+	intake := testhelpers.NewSystemIntake()
+	intake.Delegates = models.SystemIntakeDelegates{
+		{EUAUserID: "ABCD", CommonName: "Adeline Aarons", Role: models.SystemIntakeDelegateRoleCOOWNER},
+	}
+	fetchIntake := func(context.Context, uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	remove := func(context.Context, uuid.UUID, string) error { return nil }
+
+	s.Run("removes a delegate and records an action", func() {
+		ctx := appcontext.WithPrincipal(
+			context.Background(),
+			&authn.EUAPrincipal{EUAID: intake.EUAUserID.String, JobCodeEASi: true},
+		)
+		var action *models.Action
+		saveAction := func(_ context.Context, a *models.Action) error {
+			action = a
+			return nil
+		}
+		deleteDelegate := NewDeleteSystemIntakeDelegate(cfg, fetchIntake, NewAuthorizeSystemIntake(authz.ActionShare), remove, saveAction)
+
+		err := deleteDelegate(ctx, intake.ID, "abcd")
+
+		s.NoError(err)
+		s.Equal(models.ActionTypeREMOVEDELEGATE, action.ActionType)
+		s.Equal(null.StringFrom("Removed Adeline Aarons (ABCD)"), action.Feedback)
+	})
+
+	s.Run("a co-owner cannot remove delegates", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true})
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		deleteDelegate := NewDeleteSystemIntakeDelegate(cfg, fetchIntake, NewAuthorizeSystemIntake(authz.ActionShare), remove, saveAction)
+
+		err := deleteDelegate(ctx, intake.ID, "ABCD")
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns the error when removing fails", func() {
+		ctx := appcontext.WithPrincipal(
+			context.Background(),
+			&authn.EUAPrincipal{EUAID: intake.EUAUserID.String, JobCodeEASi: true},
+		)
+		failingRemove := func(context.Context, uuid.UUID, string) error { return errors.New("forced error") }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		deleteDelegate := NewDeleteSystemIntakeDelegate(cfg, fetchIntake, NewAuthorizeSystemIntake(authz.ActionShare), failingRemove, saveAction)
+
+		err := deleteDelegate(ctx, intake.ID, "ABCD")
+
+		s.Error(err)
+	})
+}
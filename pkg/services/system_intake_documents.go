@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/appvalidation"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// avStatusTag is the S3 object tag set by the virus scanner
+const avStatusTag = "av-status"
+
+// systemIntakeDocumentKeyPrefix is where the documents of a system intake are uploaded,
+// so a document can only be created for a key issued for its intake
+func systemIntakeDocumentKeyPrefix(intakeID uuid.UUID) string {
+	return "system-intakes/" + intakeID.String() + "/"
+}
+
+// NewCreateSystemIntakeDocumentUploadURL is a service to create a pre-signed S3 URL
+// for uploading a document for a SystemIntake
+func NewCreateSystemIntakeDocumentUploadURL(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	createUploadURL func(prefix string, fileType string) (*models.PreSignedURL, error),
+) func(context.Context, uuid.UUID, string) (*models.PreSignedURL, error) {
+	return func(ctx context.Context, intakeID uuid.UUID, fileType string) (_ *models.PreSignedURL, err error) {
+		defer func() { audit.Record(ctx, authz.ActionCreate, "SystemIntakeDocumentUploadURL", intakeID.String(), err) }()
+
+		intake, err := fetchIntake(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize pre-signed url generation")}
+		}
+		return createUploadURL(systemIntakeDocumentKeyPrefix(intake.ID), fileType)
+	}
+}
+
+// NewCreateSystemIntakeDocument is a service to save the metadata of a document
+// uploaded for a SystemIntake or its BusinessCase.
+// The bucket and virus scan results are the server's to set, not the client's.
+func NewCreateSystemIntakeDocument(
+	config Config,
+	bucket string,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	fetchBusinessCase func(context.Context, uuid.UUID) (*models.BusinessCase, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	create func(context.Context, *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error),
+	createDownloadURL func(string) (*models.PreSignedURL, error),
+) func(context.Context, *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error) {
+	return func(ctx context.Context, document *models.SystemIntakeDocument) (_ *models.SystemIntakeDocument, err error) {
+		var documentID string
+		defer func() { audit.Record(ctx, authz.ActionCreate, "SystemIntakeDocument", documentID, err) }()
+
+		intake, err := fetchIntake(ctx, document.SystemIntakeID)
+		if err != nil {
+			return nil, &apperrors.ResourceConflictError{
+				Err:        errors.New("system intake is required to create a document"),
+				Resource:   models.SystemIntakeDocument{},
+				ResourceID: "",
+			}
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize create system intake document")}
+		}
+		if document.BusinessCaseID != nil {
+			businessCase, err := fetchBusinessCase(ctx, *document.BusinessCaseID)
+			if err != nil {
+				return nil, err
+			}
+			if businessCase.SystemIntakeID != intake.ID {
+				return nil, &apperrors.ResourceConflictError{
+					Err:        errors.New("business case does not belong to system intake"),
+					Resource:   models.SystemIntakeDocument{},
+					ResourceID: businessCase.ID.String(),
+				}
+			}
+		}
+		document.Bucket = bucket
+		document.VirusScanned = null.Bool{}
+		document.VirusClean = null.Bool{}
+		err = appvalidation.SystemIntakeDocumentForCreation(document, systemIntakeDocumentKeyPrefix(intake.ID))
+		if err != nil {
+			return nil, err
+		}
+		created, err := create(ctx, document)
+		if err != nil {
+			return nil, err
+		}
+		documentID = created.ID.String()
+		if url, urlErr := createDownloadURL(created.Key); urlErr == nil {
+			created.URL = url.URL
+		}
+		return created, nil
+	}
+}
+
+// NewFetchSystemIntakeDocuments is a service to fetch the documents of a SystemIntake
+func NewFetchSystemIntakeDocuments(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	fetchDocuments func(context.Context, uuid.UUID) (models.SystemIntakeDocuments, error),
+	createDownloadURL func(string) (*models.PreSignedURL, error),
+	tagValueForKey func(string, string) (string, error),
+) func(context.Context, uuid.UUID) (models.SystemIntakeDocuments, error) {
+	return func(ctx context.Context, intakeID uuid.UUID) (_ models.SystemIntakeDocuments, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "SystemIntakeDocuments", intakeID.String(), err) }()
+
+		intake, err := fetchIntake(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch system intake documents")}
+		}
+		documents, err := fetchDocuments(ctx, intake.ID)
+		if err != nil {
+			return nil, err
+		}
+		err = assignSystemIntakeDocumentsURLAndStatus(documents, createDownloadURL, tagValueForKey)
+		if err != nil {
+			return nil, err
+		}
+		return documents, nil
+	}
+}
+
+// NewFetchBusinessCaseDocuments is a service to fetch the documents attached to a BusinessCase
+func NewFetchBusinessCaseDocuments(
+	config Config,
+	fetchBusinessCase func(context.Context, uuid.UUID) (*models.BusinessCase, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	fetchDocuments func(context.Context, uuid.UUID) (models.SystemIntakeDocuments, error),
+	createDownloadURL func(string) (*models.PreSignedURL, error),
+	tagValueForKey func(string, string) (string, error),
+) func(context.Context, uuid.UUID) (models.SystemIntakeDocuments, error) {
+	return func(ctx context.Context, businessCaseID uuid.UUID) (_ models.SystemIntakeDocuments, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "BusinessCaseDocuments", businessCaseID.String(), err) }()
+
+		businessCase, err := fetchBusinessCase(ctx, businessCaseID)
+		if err != nil {
+			return nil, err
+		}
+		intake, err := fetchIntake(ctx, businessCase.SystemIntakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch business case documents")}
+		}
+		documents, err := fetchDocuments(ctx, businessCase.ID)
+		if err != nil {
+			return nil, err
+		}
+		err = assignSystemIntakeDocumentsURLAndStatus(documents, createDownloadURL, tagValueForKey)
+		if err != nil {
+			return nil, err
+		}
+		return documents, nil
+	}
+}
+
+// NewDeleteSystemIntakeDocument is a service to soft delete a document of a SystemIntake,
+// recording who deleted it
+func NewDeleteSystemIntakeDocument(
+	config Config,
+	fetchDocument func(context.Context, uuid.UUID) (*models.SystemIntakeDocument, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	softDelete func(ctx context.Context, id uuid.UUID, euaUserID string) error,
+) func(context.Context, uuid.UUID, uuid.UUID) error {
+	return func(ctx context.Context, intakeID uuid.UUID, id uuid.UUID) (err error) {
+		defer func() { audit.Record(ctx, authz.ActionDelete, "SystemIntakeDocument", id.String(), err) }()
+
+		existing, err := fetchDocument(ctx, id)
+		if err != nil {
+			return err
+		}
+		if existing.SystemIntakeID != intakeID {
+			appcontext.ZLogger(ctx).Info("document does not belong to system intake")
+			return &apperrors.ResourceNotFoundError{
+				Err:      errors.New("document does not belong to system intake"),
+				Resource: models.SystemIntakeDocument{},
+			}
+		}
+		intake, err := fetchIntake(ctx, existing.SystemIntakeID)
+		if err != nil {
+			return err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize delete system intake document")}
+		}
+		return softDelete(ctx, id, appcontext.Principal(ctx).ID())
+	}
+}
+
+// assignSystemIntakeDocumentsURLAndStatus fills in download URLs and the
+// virus scan status of documents from S3
+func assignSystemIntakeDocumentsURLAndStatus(
+	documents models.SystemIntakeDocuments,
+	createDownloadURL func(string) (*models.PreSignedURL, error),
+	tagValueForKey func(string, string) (string, error),
+) error {
+	for i := range documents {
+		document := &documents[i]
+		if url, urlErr := createDownloadURL(document.Key); urlErr == nil {
+			document.URL = url.URL
+		}
+
+		value, err := tagValueForKey(document.Key, avStatusTag)
+		if err != nil {
+			return err
+		}
+
+		switch value {
+		case "CLEAN":
+			document.Status = models.AccessibilityRequestDocumentStatusAvailable
+		case "INFECTED":
+			document.Status = models.AccessibilityRequestDocumentStatusUnavailable
+		default:
+			document.Status = models.AccessibilityRequestDocumentStatusPending
+		}
+	}
+	return nil
+}
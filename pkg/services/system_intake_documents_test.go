@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestCreateSystemIntakeDocumentUploadURL() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	createUploadURL := func(prefix string, fileType string) (*models.PreSignedURL, error) {
+		return &models.PreSignedURL{URL: "https://signed.example.com/put", Filename: prefix + "abc.pdf"}, nil
+	}
+
+	s.Run("creates an upload URL under the intake's key prefix", func() {
+		createURL := NewCreateSystemIntakeDocumentUploadURL(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), createUploadURL)
+		url, err := createURL(ctx, intake.ID, "application/pdf")
+		s.NoError(err)
+		s.Equal(systemIntakeDocumentKeyPrefix(intake.ID)+"abc.pdf", url.Filename)
+	})
+
+	s.Run("returns unauthorized if the user is not authorized", func() {
+		notAuthorized := func(context.Context, *models.SystemIntake) (bool, error) { return false, nil }
+		createURL := NewCreateSystemIntakeDocumentUploadURL(cfg, fetchIntake, notAuthorized, createUploadURL)
+		_, err := createURL(ctx, intake.ID, "application/pdf")
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestCreateSystemIntakeDocument() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.SystemIntakeID = intake.ID
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		if id == intake.ID {
+			return &intake, nil
+		}
+		return nil, errors.New("forced error")
+	}
+	fetchBusinessCase := func(_ context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+		if id == businessCase.ID {
+			return &businessCase, nil
+		}
+		other := testhelpers.NewBusinessCase()
+		other.ID = id
+		return &other, nil
+	}
+	create := func(_ context.Context, document *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error) {
+		document.ID = uuid.New()
+		return document, nil
+	}
+	createDownloadURL := func(key string) (*models.PreSignedURL, error) {
+		return &models.PreSignedURL{URL: "https://signed.example.com/" + key, Filename: key}, nil
+	}
+	createDocument := NewCreateSystemIntakeDocument(cfg, "easi-uploads", fetchIntake, fetchBusinessCase, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), create, createDownloadURL)
+	key := systemIntakeDocumentKeyPrefix(intake.ID) + "abc.pdf"
+	newDocument := func() *models.SystemIntakeDocument {
+		return &models.SystemIntakeDocument{
+			SystemIntakeID: intake.ID,
+			FileType:       "application/pdf",
+			Key:            key,
+			Name:           "quote.pdf",
+			DocumentType:   models.SystemIntakeDocumentTypeVendorQuote,
+		}
+	}
+
+	s.Run("creates a valid document", func() {
+		document, err := createDocument(ctx, newDocument())
+		s.NoError(err)
+		s.NotEqual(uuid.Nil, document.ID)
+		s.Equal("https://signed.example.com/"+key, document.URL)
+		s.Equal("easi-uploads", document.Bucket)
+	})
+
+	s.Run("ignores forged virus scan results and bucket", func() {
+		document := newDocument()
+		document.Bucket = "another-bucket"
+		document.VirusScanned = null.BoolFrom(true)
+		document.VirusClean = null.BoolFrom(true)
+		created, err := createDocument(ctx, document)
+		s.NoError(err)
+		s.Equal("easi-uploads", created.Bucket)
+		s.False(created.VirusScanned.Valid)
+		s.False(created.VirusClean.Valid)
+	})
+
+	s.Run("rejects a key that wasn't uploaded for the intake", func() {
+		for _, foreignKey := range []string{"abc.pdf", systemIntakeDocumentKeyPrefix(uuid.New()) + "abc.pdf", "pdf-cache/abc.pdf"} {
+			document := newDocument()
+			document.Key = foreignKey
+			_, err := createDocument(ctx, document)
+			s.IsType(&apperrors.ValidationError{}, err, foreignKey)
+		}
+	})
+
+	s.Run("attaches a document to the business case of the intake", func() {
+		document := newDocument()
+		document.BusinessCaseID = &businessCase.ID
+		_, err := createDocument(ctx, document)
+		s.NoError(err)
+	})
+
+	s.Run("rejects a business case of another intake", func() {
+		document := newDocument()
+		otherID := uuid.New()
+		document.BusinessCaseID = &otherID
+		_, err := createDocument(ctx, document)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
+	s.Run("returns a conflict error if the intake does not exist", func() {
+		document := newDocument()
+		document.SystemIntakeID = uuid.New()
+		_, err := createDocument(ctx, document)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
+	s.Run("returns a validation error for an invalid document", func() {
+		document := newDocument()
+		document.DocumentType = "UNKNOWN"
+		_, err := createDocument(ctx, document)
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("returns unauthorized if the user is not authorized", func() {
+		requesterCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+		_, err := createDocument(requesterCtx, newDocument())
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestFetchSystemIntakeDocuments() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.SystemIntakeID = intake.ID
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	fetchBusinessCase := func(_ context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+		return &businessCase, nil
+	}
+	fetchDocuments := func(_ context.Context, id uuid.UUID) (models.SystemIntakeDocuments, error) {
+		return models.SystemIntakeDocuments{
+			{ID: uuid.New(), SystemIntakeID: intake.ID, Key: "clean.pdf"},
+			{ID: uuid.New(), SystemIntakeID: intake.ID, Key: "infected.pdf"},
+			{ID: uuid.New(), SystemIntakeID: intake.ID, Key: "pending.pdf"},
+		}, nil
+	}
+	createDownloadURL := func(key string) (*models.PreSignedURL, error) {
+		return &models.PreSignedURL{URL: "https://signed.example.com/" + key, Filename: key}, nil
+	}
+	tagValueForKey := func(key string, tagName string) (string, error) {
+		s.Equal("av-status", tagName)
+		switch key {
+		case "clean.pdf":
+			return "CLEAN", nil
+		case "infected.pdf":
+			return "INFECTED", nil
+		}
+		return "", nil
+	}
+
+	s.Run("fetches the documents of an intake with their scan status", func() {
+		fetch := NewFetchSystemIntakeDocuments(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), fetchDocuments, createDownloadURL, tagValueForKey)
+		documents, err := fetch(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(documents, 3)
+		s.Equal("https://signed.example.com/clean.pdf", documents[0].URL)
+		s.Equal(models.AccessibilityRequestDocumentStatusAvailable, documents[0].Status)
+		s.Equal(models.AccessibilityRequestDocumentStatusUnavailable, documents[1].Status)
+		s.Equal(models.AccessibilityRequestDocumentStatusPending, documents[2].Status)
+	})
+
+	s.Run("fetches the documents of a business case", func() {
+		fetch := NewFetchBusinessCaseDocuments(cfg, fetchBusinessCase, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), fetchDocuments, createDownloadURL, tagValueForKey)
+		documents, err := fetch(ctx, businessCase.ID)
+		s.NoError(err)
+		s.Len(documents, 3)
+	})
+
+	s.Run("returns an error if the scan status cannot be read", func() {
+		failingTags := func(string, string) (string, error) { return "", errors.New("forced error") }
+		fetch := NewFetchSystemIntakeDocuments(cfg, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), fetchDocuments, createDownloadURL, failingTags)
+		_, err := fetch(ctx, intake.ID)
+		s.Error(err)
+	})
+
+	s.Run("returns unauthorized if the user is not authorized", func() {
+		notAuthorized := func(context.Context, *models.SystemIntake) (bool, error) { return false, nil }
+		fetch := NewFetchSystemIntakeDocuments(cfg, fetchIntake, notAuthorized, fetchDocuments, createDownloadURL, tagValueForKey)
+		_, err := fetch(ctx, intake.ID)
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestDeleteSystemIntakeDocument() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	document := models.SystemIntakeDocument{ID: uuid.New(), SystemIntakeID: intake.ID}
+	fetchDocument := func(_ context.Context, id uuid.UUID) (*models.SystemIntakeDocument, error) {
+		return &document, nil
+	}
+	fetchIntake := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	removed := false
+	var removedBy string
+	remove := func(_ context.Context, id uuid.UUID, euaUserID string) error {
+		removed = true
+		removedBy = euaUserID
+		return nil
+	}
+	deleteDocument := NewDeleteSystemIntakeDocument(cfg, fetchDocument, fetchIntake, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(), remove)
+
+	s.Run("returns not found if the document belongs to another intake", func() {
+		err := deleteDocument(ctx, uuid.New(), document.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+		s.False(removed)
+	})
+
+	s.Run("deletes the document", func() {
+		err := deleteDocument(ctx, intake.ID, document.ID)
+		s.NoError(err)
+		s.True(removed)
+		s.Equal("REV", removedBy)
+	})
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewTransferSystemIntakeOwnership is a service for the GRT to make someone else
+// the requester of a SystemIntake and its business cases.
+// The transfer and its action are saved together; the emails about it are only logged when they fail.
+func NewTransferSystemIntakeOwnership(
+	config Config,
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	transfer func(context.Context, uuid.UUID, *models.UserInfo, *models.Action) error,
+	sendTransferEmail func(ctx context.Context, recipient string, requestName string, previousOwner string, newOwner string, reason string) error,
+) func(context.Context, uuid.UUID, string, string) (*models.SystemIntake, error) {
+	return func(ctx context.Context, intakeID uuid.UUID, euaUserID string, reason string) (_ *models.SystemIntake, err error) {
+		defer func() { audit.Record(ctx, authz.ActionReview, "SystemIntakeOwnership", intakeID.String(), err) }()
+
+		existing, err := fetch(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, existing)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize ownership transfer")}
+		}
+
+		valErr := apperrors.NewValidationError(
+			errors.New("ownership transfer failed validation"),
+			models.SystemIntake{},
+			existing.ID.String(),
+		)
+		euaUserID = strings.ToUpper(strings.TrimSpace(euaUserID))
+		if euaUserID == "" {
+			valErr.WithValidation("euaUserId", "is required")
+		} else if euaUserID == existing.EUAUserID.ValueOrZero() {
+			valErr.WithValidation("euaUserId", "is already the requester")
+		}
+		if strings.TrimSpace(reason) == "" {
+			valErr.WithValidation("reason", "is required")
+		}
+		if len(valErr.Validations) > 0 {
+			return nil, &valErr
+		}
+
+		newOwner, err := fetchUserInfo(ctx, euaUserID)
+		if errors.Is(err, cedarldap.ErrUserNotFound) || (err == nil && (newOwner == nil || newOwner.EuaUserID == "")) {
+			valErr.WithValidation("euaUserId", "must be a valid EUA ID")
+			return nil, &valErr
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// the previous requester has often left CMS by now,
+		// so fall back to what we have on the intake
+		previousOwner := &models.UserInfo{
+			EuaUserID:  existing.EUAUserID.ValueOrZero(),
+			CommonName: existing.Requester,
+			Email:      existing.RequesterEmailAddress.ValueOrZero(),
+		}
+		if previousInfo, lookupErr := fetchUserInfo(ctx, previousOwner.EuaUserID); lookupErr == nil && previousInfo != nil && previousInfo.Email != "" {
+			previousOwner.Email = previousInfo.Email
+		}
+
+		actor, err := fetchUserInfo(ctx, appcontext.Principal(ctx).ID())
+		if err != nil {
+			return nil, err
+		}
+		if actor == nil || actor.Email == "" || actor.CommonName == "" || actor.EuaUserID == "" {
+			return nil, &apperrors.ExternalAPIError{
+				Err:       errors.New("user info fetch was not successful"),
+				Operation: apperrors.Fetch,
+				Source:    "CEDAR LDAP",
+			}
+		}
+		action := &models.Action{
+			IntakeID:       &existing.ID,
+			ActionType:     models.ActionTypeTRANSFEROWNERSHIP,
+			ActorName:      actor.CommonName,
+			ActorEmail:     actor.Email,
+			ActorEUAUserID: actor.EuaUserID,
+			Feedback: null.StringFrom(fmt.Sprintf(
+				"Transferred from %s (%s) to %s (%s). Reason: %s",
+				previousOwner.CommonName,
+				previousOwner.EuaUserID,
+				newOwner.CommonName,
+				newOwner.EuaUserID,
+				reason,
+			)),
+		}
+		if err = transfer(ctx, existing.ID, newOwner, action); err != nil {
+			return nil, err
+		}
+
+		recipients := []string{newOwner.Email}
+		if previousOwner.Email != "" {
+			recipients = append(recipients, previousOwner.Email)
+		} else {
+			appcontext.ZLogger(ctx).Warn(
+				"No email address for the previous requester of a transferred intake",
+				zap.String("intakeID", existing.ID.String()),
+			)
+		}
+		// the transfer has been made, so a failed email doesn't fail it
+		for _, recipient := range recipients {
+			emailErr := sendTransferEmail(
+				ctx,
+				recipient,
+				existing.ProjectName.String,
+				previousOwner.CommonName,
+				newOwner.CommonName,
+				reason,
+			)
+			if emailErr != nil {
+				appcontext.ZLogger(ctx).Error(
+					"Ownership transfer email failed to send",
+					zap.String("intakeID", existing.ID.String()),
+					zap.Error(emailErr),
+				)
+			}
+		}
+
+		return fetch(ctx, existing.ID)
+	}
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestTransferSystemIntakeOwnership() {
+	cfg := NewConfig(nil, nil)
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+	intake := testhelpers.NewSystemIntake()
+	intake.EUAUserID = null.StringFrom("OLDD")
+	intake.Requester = "Old Owner"
+	intake.RequesterEmailAddress = null.StringFrom("old@example.com")
+	intake.ProjectName = null.StringFrom("Easy Access")
+	fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+		switch euaID {
+		case "NEWW":
+			return &models.UserInfo{CommonName: "New Owner", Email: "new@example.com", EuaUserID: "NEWW"}, nil
+		case "REV":
+			return &models.UserInfo{CommonName: "Reviewer", Email: "rev@example.com", EuaUserID: "REV"}, nil
+		}
+		return nil, &apperrors.ExternalAPIError{Err: cedarldap.ErrUserNotFound, Source: "CEDAR LDAP"}
+	}
+
+	s.Run("transfers the intake, records an action and notifies both requesters", func() {
+		var transferredTo *models.UserInfo
+		var action *models.Action
+		transfer := func(_ context.Context, _ uuid.UUID, newOwner *models.UserInfo, a *models.Action) error {
+			transferredTo = newOwner
+			action = a
+			return nil
+		}
+		var recipients []string
+		sendEmail := func(_ context.Context, recipient string, requestName string, previousOwner string, newOwner string, reason string) error {
+			s.Equal("Easy Access", requestName)
+			s.Equal("Old Owner", previousOwner)
+			s.Equal("New Owner", newOwner)
+			recipients = append(recipients, recipient)
+			return nil
+		}
+		transferOwnership := NewTransferSystemIntakeOwnership(cfg, NewAuthorizeSystemIntake(authz.ActionReview), fetch, fetchUserInfo, transfer, sendEmail)
+
+		_, err := transferOwnership(ctx, intake.ID, "neww", "left CMS")
+
+		s.NoError(err)
+		s.Equal("NEWW", transferredTo.EuaUserID)
+		s.Equal(models.ActionTypeTRANSFEROWNERSHIP, action.ActionType)
+		s.Equal("REV", action.ActorEUAUserID)
+		s.Equal(null.StringFrom("Transferred from Old Owner (OLDD) to New Owner (NEWW). Reason: left CMS"), action.Feedback)
+		s.ElementsMatch([]string{"new@example.com", "old@example.com"}, recipients)
+	})
+
+	s.Run("returns validation errors", func() {
+		transfer := func(context.Context, uuid.UUID, *models.UserInfo, *models.Action) error {
+			s.Fail("should not transfer")
+			return nil
+		}
+		sendEmail := func(context.Context, string, string, string, string, string) error { return nil }
+		transferOwnership := NewTransferSystemIntakeOwnership(cfg, NewAuthorizeSystemIntake(authz.ActionReview), fetch, fetchUserInfo, transfer, sendEmail)
+		testCases := map[string]struct {
+			euaUserID string
+			reason    string
+			key       string
+		}{
+			"missing EUA ID":        {"", "left CMS", "euaUserId"},
+			"the current requester": {"OLDD", "left CMS", "euaUserId"},
+			"missing reason":        {"NEWW", " ", "reason"},
+			"not in CEDAR LDAP":     {"ZZZZ", "left CMS", "euaUserId"},
+		}
+		for name, tc := range testCases {
+			s.Run(name, func() {
+				_, err := transferOwnership(ctx, intake.ID, tc.euaUserID, tc.reason)
+
+				s.IsType(&apperrors.ValidationError{}, err)
+				s.Contains(err.(*apperrors.ValidationError).Validations, tc.key)
+			})
+		}
+	})
+
+	s.Run("returns unauthorized if the user is not on the GRT", func() {
+		transfer := func(context.Context, uuid.UUID, *models.UserInfo, *models.Action) error { return nil }
+		sendEmail := func(context.Context, string, string, string, string, string) error { return nil }
+		transferOwnership := NewTransferSystemIntakeOwnership(cfg, NewAuthorizeSystemIntake(authz.ActionReview), fetch, fetchUserInfo, transfer, sendEmail)
+		requesterCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := transferOwnership(requesterCtx, intake.ID, "NEWW", "left CMS")
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns the error when the transfer fails", func() {
+		transfer := func(context.Context, uuid.UUID, *models.UserInfo, *models.Action) error {
+			return errors.New("forced error")
+		}
+		sendEmail := func(context.Context, string, string, string, string, string) error { return nil }
+		transferOwnership := NewTransferSystemIntakeOwnership(cfg, NewAuthorizeSystemIntake(authz.ActionReview), fetch, fetchUserInfo, transfer, sendEmail)
+
+		_, err := transferOwnership(ctx, intake.ID, "NEWW", "left CMS")
+
+		s.Error(err)
+	})
+
+	s.Run("doesn't fail a transfer that was made when an email fails", func() {
+		transferred := false
+		transfer := func(context.Context, uuid.UUID, *models.UserInfo, *models.Action) error {
+			transferred = true
+			return nil
+		}
+		sendEmail := func(context.Context, string, string, string, string, string) error { return errors.New("forced error") }
+		transferOwnership := NewTransferSystemIntakeOwnership(cfg, NewAuthorizeSystemIntake(authz.ActionReview), fetch, fetchUserInfo, transfer, sendEmail)
+
+		_, err := transferOwnership(ctx, intake.ID, "NEWW", "left CMS")
+
+		s.NoError(err)
+		s.True(transferred)
+	})
+}
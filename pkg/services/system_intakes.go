@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -23,7 +26,9 @@ func NewFetchSystemIntakes(
 	fetchByStatusFilter func(context.Context, []models.SystemIntakeStatus) (models.SystemIntakes, error),
 	authorize func(c context.Context) (bool, error),
 ) func(context.Context, models.SystemIntakeStatusFilter) (models.SystemIntakes, error) {
-	return func(ctx context.Context, statusFilter models.SystemIntakeStatusFilter) (models.SystemIntakes, error) {
+	return func(ctx context.Context, statusFilter models.SystemIntakeStatusFilter) (_ models.SystemIntakes, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "SystemIntakes", "", err) }()
+
 		logger := appcontext.ZLogger(ctx)
 		ok, err := authorize(ctx)
 		if err != nil {
@@ -66,7 +71,10 @@ func NewCreateSystemIntake(
 	config Config,
 	create func(c context.Context, intake *models.SystemIntake) (*models.SystemIntake, error),
 ) func(c context.Context, i *models.SystemIntake) (*models.SystemIntake, error) {
-	return func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+	return func(ctx context.Context, intake *models.SystemIntake) (_ *models.SystemIntake, err error) {
+		var intakeID string
+		defer func() { audit.Record(ctx, authz.ActionCreate, "SystemIntake", intakeID, err) }()
+
 		logger := appcontext.ZLogger(ctx)
 		principal := appcontext.Principal(ctx)
 		if !principal.AllowEASi() {
@@ -87,6 +95,7 @@ func NewCreateSystemIntake(
 				Operation: apperrors.QueryPost,
 			}
 		}
+		intakeID = createdIntake.ID.String()
 		return createdIntake, nil
 	}
 }
@@ -97,12 +106,20 @@ func NewUpdateSystemIntake(
 	fetch func(c context.Context, id uuid.UUID) (*models.SystemIntake, error),
 	update func(c context.Context, intake *models.SystemIntake) (*models.SystemIntake, error),
 	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
 ) func(c context.Context, i *models.SystemIntake) (*models.SystemIntake, error) {
-	return func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+	return func(ctx context.Context, intake *models.SystemIntake) (_ *models.SystemIntake, err error) {
+		intakeID := intake.ID.String()
+		defer func() { audit.Record(ctx, authz.ActionUpdate, "SystemIntake", intakeID, err) }()
+
 		existingIntake, err := fetch(ctx, intake.ID)
+		var notFoundErr *apperrors.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, err
+		}
 		if err != nil {
 			return nil, &apperrors.ResourceNotFoundError{
-				Err:      errors.New("business case does not exist"),
+				Err:      fmt.Errorf("system intake does not exist: %w", err),
 				Resource: intake,
 			}
 		}
@@ -119,6 +136,12 @@ func NewUpdateSystemIntake(
 		intake.UpdatedAt = &updatedTime
 
 		intake, err = update(ctx, intake)
+		// a stale version or an intake deleted since it was fetched
+		// keeps its own status rather than becoming a server error
+		var conflictErr *apperrors.ResourceConflictError
+		if errors.As(err, &conflictErr) || errors.As(err, &notFoundErr) {
+			return nil, err
+		}
 		if err != nil {
 			return nil, &apperrors.QueryError{
 				Err:       err,
@@ -127,6 +150,12 @@ func NewUpdateSystemIntake(
 			}
 		}
 
+		// the request can carry fields the update doesn't save,
+		// so compare against what was saved
+		if saved, fetchErr := fetch(ctx, intake.ID); fetchErr == nil {
+			recordChanges(ctx, existingIntake, saved)
+		}
+
 		return intake, nil
 	}
 }
@@ -170,7 +199,9 @@ func NewArchiveSystemIntake(
 	authorize func(context context.Context, intake *models.SystemIntake) (bool, error),
 	sendWithdrawEmail func(ctx context.Context, requestName string) error,
 ) func(context.Context, uuid.UUID) error {
-	return func(ctx context.Context, id uuid.UUID) error {
+	return func(ctx context.Context, id uuid.UUID) (err error) {
+		defer func() { audit.Record(ctx, authz.ActionDelete, "SystemIntake", id.String(), err) }()
+
 		intake, fetchErr := fetch(ctx, id)
 		if fetchErr != nil {
 			return &apperrors.QueryError{
@@ -227,9 +258,11 @@ func NewArchiveSystemIntake(
 func NewFetchSystemIntakeByID(
 	config Config,
 	fetch func(c context.Context, id uuid.UUID) (*models.SystemIntake, error),
-	authorize func(context.Context) (bool, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
 ) func(c context.Context, u uuid.UUID) (*models.SystemIntake, error) {
-	return func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ *models.SystemIntake, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "SystemIntake", id.String(), err) }()
+
 		logger := appcontext.ZLogger(ctx)
 		intake, err := fetch(ctx, id)
 		if err != nil {
@@ -240,7 +273,7 @@ func NewFetchSystemIntakeByID(
 				Operation: apperrors.QueryFetch,
 			}
 		}
-		ok, err := authorize(ctx)
+		ok, err := authorize(ctx, intake)
 		if err != nil {
 			logger.Error("failed to authorize fetch system intake")
 			return &models.SystemIntake{}, err
@@ -263,8 +296,12 @@ func NewUpdateLifecycleFields(
 	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
 	sendIssueLCIDEmail func(context.Context, string, string, *time.Time, string, string, string) error,
 	generateLCID func(context.Context) (string, error),
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
 ) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
-	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
+	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (_ *models.SystemIntake, err error) {
+		intakeID := intake.ID.String()
+		defer func() { audit.Record(ctx, authz.ActionReview, "SystemIntake", intakeID, err) }()
+
 		existing, err := fetch(ctx, intake.ID)
 		if err != nil {
 			return nil, &apperrors.QueryError{
@@ -305,6 +342,8 @@ func NewUpdateLifecycleFields(
 			}
 		}
 
+		before := *existing
+
 		// we only want to bring over the fields specifically
 		// dealing with lifecycleID information
 		updatedTime := config.clock.Now()
@@ -330,6 +369,7 @@ func NewUpdateLifecycleFields(
 		}
 
 		existing.Status = models.SystemIntakeStatusLCIDISSUED
+		existing.DecidedAt = &updatedTime
 		updated, err := update(ctx, existing)
 		if err != nil {
 			return nil, &apperrors.QueryError{
@@ -338,18 +378,23 @@ func NewUpdateLifecycleFields(
 				Operation: apperrors.QuerySave,
 			}
 		}
+		recordChanges(ctx, &before, updated)
 
-		err = sendIssueLCIDEmail(
-			ctx,
-			requesterInfo.Email,
-			updated.LifecycleID.String,
-			updated.LifecycleExpiresAt,
-			updated.LifecycleScope.String,
-			updated.LifecycleNextSteps.String,
-			action.Feedback.String)
+		sendEmail := func(ctx context.Context, recipient string) error {
+			return sendIssueLCIDEmail(
+				ctx,
+				recipient,
+				updated.LifecycleID.String,
+				updated.LifecycleExpiresAt,
+				updated.LifecycleScope.String,
+				updated.LifecycleNextSteps.String,
+				action.Feedback.String)
+		}
+		err = sendEmail(ctx, requesterInfo.Email)
 		if err != nil {
 			return nil, err
 		}
+		notifyCollaborators(ctx, updated, action, requesterInfo.Email, sendEmail)
 
 		return updated, nil
 
@@ -366,8 +411,12 @@ func NewUpdateRejectionFields(
 	saveAction func(context.Context, *models.Action) error,
 	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
 	sendRejectRequestEmail func(ctx context.Context, recipient string, reason string, nextSteps string, feedback string) error,
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
 ) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
-	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
+	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (_ *models.SystemIntake, err error) {
+		intakeID := intake.ID.String()
+		defer func() { audit.Record(ctx, authz.ActionReview, "SystemIntake", intakeID, err) }()
+
 		existing, err := fetch(ctx, intake.ID)
 		if err != nil {
 			return nil, err
@@ -401,6 +450,8 @@ func NewUpdateRejectionFields(
 			return nil, err
 		}
 
+		before := *existing
+
 		// we only want to bring over the fields specifically
 		// dealing with Rejection information
 		updatedTime := config.clock.Now()
@@ -408,21 +459,27 @@ func NewUpdateRejectionFields(
 		existing.RejectionReason = intake.RejectionReason
 		existing.DecisionNextSteps = intake.DecisionNextSteps
 		existing.Status = models.SystemIntakeStatusNOTAPPROVED
+		existing.DecidedAt = &updatedTime
 		updated, err := update(ctx, existing)
 		if err != nil {
 			return nil, err
 		}
+		recordChanges(ctx, &before, updated)
 
-		err = sendRejectRequestEmail(
-			ctx,
-			requesterInfo.Email,
-			existing.RejectionReason.String,
-			existing.DecisionNextSteps.String,
-			action.Feedback.String,
-		)
+		sendEmail := func(ctx context.Context, recipient string) error {
+			return sendRejectRequestEmail(
+				ctx,
+				recipient,
+				existing.RejectionReason.String,
+				existing.DecisionNextSteps.String,
+				action.Feedback.String,
+			)
+		}
+		err = sendEmail(ctx, requesterInfo.Email)
 		if err != nil {
 			return nil, err
 		}
+		notifyCollaborators(ctx, updated, action, requesterInfo.Email, sendEmail)
 
 		return updated, nil
 	}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -14,7 +15,9 @@ import (
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
 	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 )
@@ -150,6 +153,25 @@ func (s ServicesTestSuite) TestNewCreateSystemIntake() {
 		s.Equal(fakeEuaID, intake.EUAUserID.ValueOrZero())
 	})
 
+	s.Run("audits the ID of the created intake", func() {
+		var event *models.AuditEvent
+		recorder := func(_ context.Context, recorded *models.AuditEvent) error {
+			event = recorded
+			return nil
+		}
+		id := uuid.New()
+		create := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id}, nil
+		}
+
+		_, err := NewCreateSystemIntake(serviceConfig, create)(audit.WithRecorder(ctx, recorder), &models.SystemIntake{})
+
+		s.NoError(err)
+		s.Equal("create", event.Action)
+		s.Equal(null.StringFrom(id.String()), event.ResourceID)
+		s.Equal(models.AuditEventOutcomeSUCCEEDED, event.Outcome)
+	})
+
 	s.Run("returns query error when create fails", func() {
 		create := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("creation failed")
@@ -181,7 +203,7 @@ func (s ServicesTestSuite) TestNewUpdateSystemIntake() {
 		return &existing, nil
 	}
 	s.Run("golden path update draft intake", func() {
-		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, update, authorize)
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, update, authorize, noRecordFieldChanges)
 		intake, err := updateDraftSystemIntake(ctx, &incoming)
 
 		s.NoError(err)
@@ -192,19 +214,31 @@ func (s ServicesTestSuite) TestNewUpdateSystemIntake() {
 		failFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
 			return nil, errors.New("fetch error")
 		}
-		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, failFetch, update, authorize)
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, failFetch, update, authorize, noRecordFieldChanges)
 		intake, err := updateDraftSystemIntake(ctx, &incoming)
 
 		s.IsType(&apperrors.ResourceNotFoundError{}, err)
 		s.Equal(nilIntake, intake)
 	})
 
+	s.Run("returns the not found error if the intake doesn't exist", func() {
+		notFoundErr := &apperrors.ResourceNotFoundError{Err: sql.ErrNoRows, Resource: models.SystemIntake{}}
+		missingFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return nil, notFoundErr
+		}
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, missingFetch, update, authorize, noRecordFieldChanges)
+		intake, err := updateDraftSystemIntake(ctx, &incoming)
+
+		s.Equal(notFoundErr, err)
+		s.Equal(nilIntake, intake)
+	})
+
 	s.Run("returns error from authorization if authorization fails", func() {
 		authorizationError := errors.New("authorization failed")
 		failAuthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, authorizationError
 		}
-		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, update, failAuthorize)
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, update, failAuthorize, noRecordFieldChanges)
 		intake, err := updateDraftSystemIntake(ctx, &incoming)
 
 		s.Equal(authorizationError, err)
@@ -215,7 +249,7 @@ func (s ServicesTestSuite) TestNewUpdateSystemIntake() {
 		unauthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, nil
 		}
-		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, update, unauthorize)
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, update, unauthorize, noRecordFieldChanges)
 		intake, err := updateDraftSystemIntake(ctx, &incoming)
 
 		s.IsType(&apperrors.UnauthorizedError{}, err)
@@ -226,12 +260,36 @@ func (s ServicesTestSuite) TestNewUpdateSystemIntake() {
 		failUpdate := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("update error")
 		}
-		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, failUpdate, authorize)
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, failUpdate, authorize, noRecordFieldChanges)
 		intake, err := updateDraftSystemIntake(ctx, &incoming)
 
 		s.IsType(&apperrors.QueryError{}, err)
 		s.Equal(nilIntake, intake)
 	})
+
+	s.Run("returns conflict error if the intake changed since it was read", func() {
+		conflictErr := &apperrors.ResourceConflictError{Err: errors.New("stale"), CurrentVersion: 3}
+		staleUpdate := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			return nil, conflictErr
+		}
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, staleUpdate, authorize, noRecordFieldChanges)
+		intake, err := updateDraftSystemIntake(ctx, &incoming)
+
+		s.Equal(conflictErr, err)
+		s.Equal(nilIntake, intake)
+	})
+
+	s.Run("returns not found error if the intake is deleted before the update", func() {
+		notFoundErr := &apperrors.ResourceNotFoundError{Err: sql.ErrNoRows, Resource: models.SystemIntake{}}
+		deletedUpdate := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			return nil, notFoundErr
+		}
+		updateDraftSystemIntake := NewUpdateSystemIntake(serviceConfig, fetch, deletedUpdate, authorize, noRecordFieldChanges)
+		intake, err := updateDraftSystemIntake(ctx, &incoming)
+
+		s.Equal(notFoundErr, err)
+		s.Equal(nilIntake, intake)
+	})
 }
 
 func (s ServicesTestSuite) TestSystemIntakeByIDFetcher() {
@@ -239,7 +297,7 @@ func (s ServicesTestSuite) TestSystemIntakeByIDFetcher() {
 	fakeID := uuid.New()
 	serviceConfig := NewConfig(logger, nil)
 	serviceConfig.clock = clock.NewMock()
-	authorize := func(context context.Context) (bool, error) { return true, nil }
+	authorize := func(context.Context, *models.SystemIntake) (bool, error) { return true, nil }
 
 	s.Run("successfully fetches System Intake by ID without an error", func() {
 		fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
@@ -265,6 +323,64 @@ func (s ServicesTestSuite) TestSystemIntakeByIDFetcher() {
 		s.IsType(&apperrors.QueryError{}, err)
 		s.Equal(&models.SystemIntake{}, intake)
 	})
+
+	s.Run("returns unauthorized for another requester's intake", func() {
+		fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id, EUAUserID: null.StringFrom("ABCD")}, nil
+		}
+		fetchSystemIntakeByID := NewFetchSystemIntakeByID(serviceConfig, fetch, NewAuthorizeSystemIntake(authz.ActionRead))
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchSystemIntakeByID(ctx, fakeID)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("audits the read of the intake once it's done", func() {
+		var events []*models.AuditEvent
+		recorder := func(_ context.Context, event *models.AuditEvent) error {
+			events = append(events, event)
+			return nil
+		}
+		fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id, EUAUserID: null.StringFrom("ABCD")}, nil
+		}
+		fetchSystemIntakeByID := NewFetchSystemIntakeByID(serviceConfig, fetch, NewAuthorizeSystemIntake(authz.ActionRead))
+		ctx := audit.WithRecorder(appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal()), recorder)
+
+		_, err := fetchSystemIntakeByID(ctx, fakeID)
+		s.Error(err)
+		_, err = fetchSystemIntakeByID(audit.WithRecorder(context.Background(), recorder), fakeID)
+		s.Error(err)
+		_, err = NewFetchSystemIntakeByID(serviceConfig, fetch, authorize)(ctx, fakeID)
+		s.NoError(err)
+
+		s.Len(events, 3)
+		s.Equal("REQ", events[0].EUAUserID)
+		s.Equal("read", events[0].Action)
+		s.Equal("SystemIntake", events[0].ResourceType)
+		s.Equal(null.StringFrom(fakeID.String()), events[0].ResourceID)
+		s.Equal(models.AuditEventOutcomeDENIED, events[0].Outcome)
+		s.Equal(models.AuditEventOutcomeDENIED, events[1].Outcome)
+		s.Equal(models.AuditEventOutcomeSUCCEEDED, events[2].Outcome)
+	})
+
+	s.Run("audits a failed fetch", func() {
+		var event *models.AuditEvent
+		recorder := func(_ context.Context, recorded *models.AuditEvent) error {
+			event = recorded
+			return nil
+		}
+		fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return nil, errors.New("fetch failed")
+		}
+		ctx := audit.WithRecorder(context.Background(), recorder)
+
+		_, err := NewFetchSystemIntakeByID(serviceConfig, fetch, authorize)(ctx, fakeID)
+
+		s.Error(err)
+		s.Equal(models.AuditEventOutcomeFAILED, event.Outcome)
+	})
 }
 
 func (s ServicesTestSuite) TestSystemIntakeArchiver() {
@@ -407,7 +523,7 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 	}
 	fnGenerate := func(context.Context) (string, error) { return "123456", nil }
 	cfg := Config{clock: clock.NewMock()}
-	happy := NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate)
+	happy := NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate, noRecordFieldChanges)
 
 	s.Run("happy path provided lcid", func() {
 		intake, err := happy(context.Background(), input, action)
@@ -416,6 +532,7 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 		s.Equal(intake.LifecycleExpiresAt, expiresAt)
 		s.Equal(intake.DecisionNextSteps, nextSteps)
 		s.Equal(intake.LifecycleScope, scope)
+		s.NotNil(intake.DecidedAt)
 		s.Equal(1, reviewEmailCount)
 		s.Equal("Feedback", feedbackForEmailText)
 	})
@@ -457,28 +574,28 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 		fn func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
 	}{
 		"error path fetch": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate, noRecordFieldChanges),
 		},
 		"error path auth": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate, noRecordFieldChanges),
 		},
 		"error path auth fail": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate, noRecordFieldChanges),
 		},
 		"error path generate": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerateErr),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerateErr, noRecordFieldChanges),
 		},
 		"error path save action": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate, noRecordFieldChanges),
 		},
 		"error path fetch user info": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, fnSendLCIDEmail, fnGenerate, noRecordFieldChanges),
 		},
 		"error path send email": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmailErr, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmailErr, fnGenerate, noRecordFieldChanges),
 		},
 		"error path update": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate, noRecordFieldChanges),
 		},
 	}
 
@@ -536,13 +653,14 @@ func (s ServicesTestSuite) TestUpdateRejectionFields() {
 		return nil
 	}
 	cfg := Config{clock: clock.NewMock()}
-	happy := NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail)
+	happy := NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail, noRecordFieldChanges)
 
 	s.Run("happy path", func() {
 		intake, err := happy(context.Background(), input, action)
 		s.NoError(err)
 		s.Equal(intake.DecisionNextSteps, nextSteps)
 		s.Equal(intake.RejectionReason, reason)
+		s.NotNil(intake.DecidedAt)
 		s.Equal(1, reviewEmailCount)
 		s.Equal("Feedback", feedbackForEmailText)
 	})
@@ -571,25 +689,25 @@ func (s ServicesTestSuite) TestUpdateRejectionFields() {
 		fn func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
 	}{
 		"error path fetch": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail, noRecordFieldChanges),
 		},
 		"error path auth": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail, noRecordFieldChanges),
 		},
 		"error path auth fail": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail, noRecordFieldChanges),
 		},
 		"error path update": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail, noRecordFieldChanges),
 		},
 		"error path fetch user info": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, fnSendRejectRequestEmail, noRecordFieldChanges),
 		},
 		"error path save action": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, fnSendRejectRequestEmail, noRecordFieldChanges),
 		},
 		"error path send email": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmailErr),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmailErr, noRecordFieldChanges),
 		},
 	}
 
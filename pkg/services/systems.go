@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -14,7 +16,9 @@ func NewFetchSystems(
 	fetchAll func(context.Context) ([]*models.System, error),
 	authorize func(context.Context) (bool, error),
 ) func(context.Context) ([]*models.System, error) {
-	return func(ctx context.Context) ([]*models.System, error) {
+	return func(ctx context.Context) (_ []*models.System, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "Systems", "", err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
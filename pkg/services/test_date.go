@@ -4,7 +4,13 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/appvalidation"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -14,7 +20,10 @@ func NewCreateTestDate(
 	authorize func(context.Context) (bool, error),
 	create func(context.Context, *models.TestDate) (*models.TestDate, error),
 ) func(context.Context, *models.TestDate) (*models.TestDate, error) {
-	return func(ctx context.Context, testDate *models.TestDate) (*models.TestDate, error) {
+	return func(ctx context.Context, testDate *models.TestDate) (_ *models.TestDate, err error) {
+		var testDateID string
+		defer func() { audit.Record(ctx, authz.ActionCreate, "TestDate", testDateID, err) }()
+
 		ok, err := authorize(ctx)
 		if err != nil {
 			return nil, err
@@ -22,6 +31,85 @@ func NewCreateTestDate(
 		if !ok {
 			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize create test date")}
 		}
-		return create(ctx, testDate)
+		if err = appvalidation.TestDateForSave(testDate); err != nil {
+			return nil, err
+		}
+		created, err := create(ctx, testDate)
+		if err != nil {
+			return nil, err
+		}
+		testDateID = created.ID.String()
+		return created, nil
+	}
+}
+
+// NewUpdateTestDate is a service to change the date, test type and score of a 508 test date
+func NewUpdateTestDate(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.TestDate, error),
+	update func(context.Context, *models.TestDate) (*models.TestDate, error),
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
+) func(context.Context, *models.TestDate) (*models.TestDate, error) {
+	return func(ctx context.Context, testDate *models.TestDate) (_ *models.TestDate, err error) {
+		defer func() { audit.Record(ctx, authz.ActionUpdate, "TestDate", testDate.ID.String(), err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize update test date")}
+		}
+		existing, err := fetch(ctx, testDate.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		updated := *existing
+		updated.Date = testDate.Date
+		updated.TestType = testDate.TestType
+		updated.Score = testDate.Score
+		if err = appvalidation.TestDateForSave(&updated); err != nil {
+			return nil, err
+		}
+		saved, err := update(ctx, &updated)
+		if err != nil {
+			return nil, err
+		}
+		recordChanges(ctx, existing, saved)
+		return saved, nil
+	}
+}
+
+// NewDeleteTestDate is a service to soft delete a 508 test date, returning it as deleted
+func NewDeleteTestDate(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.TestDate, error),
+	softDelete func(ctx context.Context, id uuid.UUID, euaUserID string) (*models.TestDate, error),
+	recordChanges func(ctx context.Context, before interface{}, after interface{}),
+) func(context.Context, uuid.UUID) (*models.TestDate, error) {
+	return func(ctx context.Context, id uuid.UUID) (_ *models.TestDate, err error) {
+		defer func() { audit.Record(ctx, authz.ActionDelete, "TestDate", id.String(), err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize delete test date")}
+		}
+		existing, err := fetch(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		deleted, err := softDelete(ctx, id, appcontext.Principal(ctx).ID())
+		if err != nil {
+			return nil, err
+		}
+		recordChanges(ctx, existing, deleted)
+		return deleted, nil
 	}
 }
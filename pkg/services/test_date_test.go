@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestUpdateTestDate() {
+	cfg := NewConfig(s.logger, nil)
+	ctx := context.Background()
+	id := uuid.New()
+	requestID := uuid.New()
+	score := 500
+	newScore := 1000
+
+	fetch := func(_ context.Context, fetchedID uuid.UUID) (*models.TestDate, error) {
+		return &models.TestDate{
+			ID:        fetchedID,
+			RequestID: requestID,
+			TestType:  models.TestDateTestTypeInitial,
+			Date:      time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+			Score:     &score,
+		}, nil
+	}
+	update := func(_ context.Context, testDate *models.TestDate) (*models.TestDate, error) {
+		return testDate, nil
+	}
+	authorize := func(context.Context) (bool, error) { return true, nil }
+
+	s.Run("updates the editable fields and records the changes", func() {
+		var before, after interface{}
+		recordChanges := func(_ context.Context, b interface{}, a interface{}) {
+			before, after = b, a
+		}
+		updateTestDate := NewUpdateTestDate(cfg, authorize, fetch, update, recordChanges)
+
+		updated, err := updateTestDate(ctx, &models.TestDate{
+			ID:        id,
+			RequestID: uuid.New(),
+			TestType:  models.TestDateTestTypeRemediation,
+			Date:      time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+			Score:     &newScore,
+		})
+
+		s.NoError(err)
+		s.Equal(requestID, updated.RequestID)
+		s.Equal(models.TestDateTestTypeRemediation, updated.TestType)
+		s.Equal(newScore, *updated.Score)
+		s.Equal(&score, before.(*models.TestDate).Score)
+		s.Equal(updated, after)
+	})
+
+	s.Run("validates the score", func() {
+		outOfRange := 1001
+		updateTestDate := NewUpdateTestDate(cfg, authorize, fetch, update, noRecordFieldChanges)
+
+		_, err := updateTestDate(ctx, &models.TestDate{
+			ID:       id,
+			TestType: models.TestDateTestTypeInitial,
+			Date:     time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+			Score:    &outOfRange,
+		})
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("returns unauthorized without the 508 tester role", func() {
+		unauthorized := func(context.Context) (bool, error) { return false, nil }
+		updateTestDate := NewUpdateTestDate(cfg, unauthorized, fetch, update, noRecordFieldChanges)
+
+		_, err := updateTestDate(ctx, &models.TestDate{ID: id})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns the error when the test date can't be fetched", func() {
+		notFound := func(context.Context, uuid.UUID) (*models.TestDate, error) {
+			return nil, &apperrors.ResourceNotFoundError{Err: errors.New("missing"), Resource: models.TestDate{}}
+		}
+		updateTestDate := NewUpdateTestDate(cfg, authorize, notFound, update, noRecordFieldChanges)
+
+		_, err := updateTestDate(ctx, &models.TestDate{ID: id})
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestDeleteTestDate() {
+	cfg := NewConfig(s.logger, nil)
+	tester := testhelpers.NewReviewerPrincipal()
+	ctx := appcontext.WithPrincipal(context.Background(), tester)
+	id := uuid.New()
+
+	fetch := func(_ context.Context, fetchedID uuid.UUID) (*models.TestDate, error) {
+		return &models.TestDate{ID: fetchedID, TestType: models.TestDateTestTypeInitial}, nil
+	}
+	authorize := func(context.Context) (bool, error) { return true, nil }
+
+	s.Run("soft deletes the test date, recording who deleted it", func() {
+		deletedAt := time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)
+		softDelete := func(_ context.Context, deletedID uuid.UUID, euaUserID string) (*models.TestDate, error) {
+			s.Equal(id, deletedID)
+			return &models.TestDate{
+				ID:        deletedID,
+				TestType:  models.TestDateTestTypeInitial,
+				DeletedAt: &deletedAt,
+				DeletedBy: null.StringFrom(euaUserID),
+			}, nil
+		}
+		var after interface{}
+		recordChanges := func(_ context.Context, _ interface{}, a interface{}) {
+			after = a
+		}
+
+		deleted, err := NewDeleteTestDate(cfg, authorize, fetch, softDelete, recordChanges)(ctx, id)
+
+		s.NoError(err)
+		s.Equal(id, deleted.ID)
+		s.Equal(&deletedAt, deleted.DeletedAt)
+		s.Equal(tester.ID(), deleted.DeletedBy.String)
+		s.Equal(deleted, after)
+	})
+
+	s.Run("returns unauthorized without the 508 tester role", func() {
+		unauthorized := func(context.Context) (bool, error) { return false, nil }
+		softDelete := func(context.Context, uuid.UUID, string) (*models.TestDate, error) {
+			s.Fail("should not delete")
+			return nil, nil
+		}
+
+		_, err := NewDeleteTestDate(cfg, unauthorized, fetch, softDelete, noRecordFieldChanges)(ctx, id)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
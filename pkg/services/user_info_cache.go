@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/audit"
+	"github.com/cmsgov/easi-app/pkg/authz"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewFetchUserInfoCacheStats returns a function that fetches the hit and miss counts of the user lookup cache
+func NewFetchUserInfoCacheStats(
+	config Config,
+	stats func(context.Context) (*models.UserInfoCacheStats, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context) (*models.UserInfoCacheStats, error) {
+	return func(ctx context.Context) (_ *models.UserInfoCacheStats, err error) {
+		defer func() { audit.Record(ctx, authz.ActionRead, "UserInfoCacheStats", "", err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch user info cache stats")}
+		}
+		return stats(ctx)
+	}
+}
+
+// NewEvictUserInfoCacheEntry returns a function that removes a user from the user lookup cache,
+// so their next lookup goes to CEDAR
+func NewEvictUserInfoCacheEntry(
+	config Config,
+	evict func(context.Context, string) (bool, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, string) error {
+	return func(ctx context.Context, euaID string) (err error) {
+		defer func() { audit.Record(ctx, authz.ActionDelete, "UserInfoCacheEntry", euaID, err) }()
+
+		ok, err := authorize(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize evict user info cache entry")}
+		}
+		evicted, err := evict(ctx, euaID)
+		if err != nil {
+			return err
+		}
+		if !evicted {
+			return &apperrors.ResourceNotFoundError{
+				Err:      errors.New("user is not cached"),
+				Resource: models.UserInfo{},
+			}
+		}
+		return nil
+	}
+}
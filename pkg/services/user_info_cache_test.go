@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestUserInfoCacheAdmin() {
+	cfg := NewConfig(nil, nil)
+	reviewerCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+	requesterCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+	stats := func(context.Context) (*models.UserInfoCacheStats, error) {
+		return &models.UserInfoCacheStats{Hits: 1}, nil
+	}
+	evict := func(_ context.Context, euaID string) (bool, error) {
+		return euaID == "ABCD", nil
+	}
+
+	s.Run("reviewers can fetch cache stats", func() {
+		fetchStats := NewFetchUserInfoCacheStats(cfg, stats, NewAuthorizeRequireGRTJobCode())
+
+		cacheStats, err := fetchStats(reviewerCtx)
+
+		s.NoError(err)
+		s.Equal(uint64(1), cacheStats.Hits)
+	})
+
+	s.Run("requesters cannot fetch cache stats", func() {
+		fetchStats := NewFetchUserInfoCacheStats(cfg, stats, NewAuthorizeRequireGRTJobCode())
+
+		_, err := fetchStats(requesterCtx)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("reviewers can evict a cached user", func() {
+		evictEntry := NewEvictUserInfoCacheEntry(cfg, evict, NewAuthorizeRequireGRTJobCode())
+
+		s.NoError(evictEntry(reviewerCtx, "ABCD"))
+		s.IsType(&apperrors.ResourceNotFoundError{}, evictEntry(reviewerCtx, "NONE"))
+	})
+
+	s.Run("requesters cannot evict a cached user", func() {
+		evictEntry := NewEvictUserInfoCacheEntry(cfg, evict, NewAuthorizeRequireGRTJobCode())
+
+		s.IsType(&apperrors.UnauthorizedError{}, evictEntry(requesterCtx, "ABCD"))
+	})
+}
@@ -55,7 +55,7 @@ func (s *Store) CreateAccessibilityRequest(ctx context.Context, request *models.
 func (s *Store) FetchAccessibilityRequestByID(ctx context.Context, id uuid.UUID) (*models.AccessibilityRequest, error) {
 	request := models.AccessibilityRequest{}
 
-	err := s.db.Get(&request, `SELECT * FROM accessibility_requests WHERE id=$1`, id)
+	err := s.db.Get(&request, `SELECT * FROM accessibility_requests WHERE id=$1 AND deleted_at IS NULL`, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.SystemIntake{}}
@@ -76,7 +76,7 @@ func (s *Store) FetchAccessibilityRequestByID(ctx context.Context, id uuid.UUID)
 func (s *Store) FetchAccessibilityRequests(ctx context.Context) ([]models.AccessibilityRequest, error) {
 	requests := []models.AccessibilityRequest{}
 
-	err := s.db.Select(&requests, `SELECT * FROM accessibility_requests`)
+	err := s.db.Select(&requests, `SELECT * FROM accessibility_requests WHERE deleted_at IS NULL`)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return requests, nil
@@ -59,25 +59,29 @@ func (s *Store) CreateAccessibilityRequestDocument(ctx context.Context, file *mo
 }
 
 func assignDocumentStatus(document *models.AccessibilityRequestDocument) {
+	document.Status = documentStatus(document.VirusScanned, document.VirusClean)
+}
+
+func documentStatus(virusScanned null.Bool, virusClean null.Bool) models.AccessibilityRequestDocumentStatus {
 	status := "PENDING"
-	if document.VirusScanned == null.BoolFrom(true) {
-		if document.VirusClean == null.BoolFrom(false) {
+	if virusScanned == null.BoolFrom(true) {
+		if virusClean == null.BoolFrom(false) {
 			status = "UNAVAILABLE"
 		}
 
-		if document.VirusClean == null.BoolFrom(true) {
+		if virusClean == null.BoolFrom(true) {
 			status = "AVAILABLE"
 		}
 	}
 
-	document.Status = models.AccessibilityRequestDocumentStatus(status)
+	return models.AccessibilityRequestDocumentStatus(status)
 }
 
 // FetchAccessibilityRequestDocumentByID retrieves the metadata for a file uploaded to S3
 func (s *Store) FetchAccessibilityRequestDocumentByID(ctx context.Context, id uuid.UUID) (*models.AccessibilityRequestDocument, error) {
 	var document models.AccessibilityRequestDocument
 
-	err := s.db.Get(&document, "SELECT * FROM accessibility_request_documents WHERE id=$1", id)
+	err := s.db.Get(&document, "SELECT * FROM accessibility_request_documents WHERE id=$1 AND deleted_at IS NULL", id)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error("Failed to fetch uploaded file", zap.Error(err))
 
@@ -102,7 +106,7 @@ func (s *Store) FetchDocumentsByAccessibilityRequestID(ctx context.Context, id u
 	results := []*models.AccessibilityRequestDocument{}
 
 	// eventually, we should use the id here, but we don't have the db relationship set up yet
-	err := s.db.Select(&results, "SELECT * FROM accessibility_request_documents where request_id=$1", id)
+	err := s.db.Select(&results, "SELECT * FROM accessibility_request_documents where request_id=$1 AND deleted_at IS NULL", id)
 
 	if err != nil {
 		appcontext.ZLogger(ctx).Error("Failed to fetch uploaded file", zap.Error(err))
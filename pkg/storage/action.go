@@ -12,33 +12,35 @@ import (
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
+// createActionSQL is shared with the updates that record their action in the same transaction
+const createActionSQL = `
+	INSERT INTO actions (
+		id,
+		action_type,
+		actor_name,
+	    actor_email,
+	    actor_eua_user_id,
+		intake_id,
+		feedback,
+		created_at
+	)
+	VALUES (
+		:id,
+		:action_type,
+	    :actor_name,
+	    :actor_email,
+		:actor_eua_user_id,
+	    :intake_id,
+		:feedback,
+	    :created_at
+	)`
+
 // CreateAction creates an Action item in the database
 func (s *Store) CreateAction(ctx context.Context, action *models.Action) (*models.Action, error) {
 	id := uuid.New()
 	action.ID = id
 	createAt := s.clock.Now()
 	action.CreatedAt = &createAt
-	const createActionSQL = `
-		INSERT INTO actions (
-			id,
-			action_type,
-			actor_name,
-		    actor_email,
-		    actor_eua_user_id,
-			intake_id,
-			feedback,
-			created_at
-		)
-		VALUES (
-			:id,
-			:action_type,
-		    :actor_name,
-		    :actor_email,
-			:actor_eua_user_id,
-		    :intake_id,
-			:feedback,
-		    :created_at
-		)`
 	_, err := s.db.NamedExec(
 		createActionSQL,
 		action,
@@ -0,0 +1,128 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// defaultAuditEventLimit caps how many audit events one query returns
+const defaultAuditEventLimit = 1000
+
+// CreateAuditEvent saves an audit event
+func (s *Store) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	event.ID = uuid.New()
+	now := s.clock.Now()
+	event.CreatedAt = &now
+	const createAuditEventSQL = `
+		INSERT INTO audit_events (
+			id,
+			eua_user_id,
+			action,
+			resource_type,
+			resource_id,
+			outcome,
+			trace_id,
+			created_at
+		)
+		VALUES (
+			:id,
+			:eua_user_id,
+			:action,
+			:resource_type,
+			:resource_id,
+			:outcome,
+			:trace_id,
+			:created_at
+		)`
+	_, err := s.db.NamedExec(createAuditEventSQL, event)
+	if err != nil {
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     event,
+			Operation: apperrors.QueryPost,
+		}
+	}
+	return nil
+}
+
+// FetchAuditEvents queries the DB for the newest audit events matching the filter
+func (s *Store) FetchAuditEvents(ctx context.Context, filter models.AuditEventFilter) (models.AuditEvents, error) {
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.EUAUserID != "" {
+		where("eua_user_id = $%d", filter.EUAUserID)
+	}
+	if filter.Action != "" {
+		where("action = $%d", filter.Action)
+	}
+	if filter.ResourceType != "" {
+		where("resource_type = $%d", filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		where("resource_id = $%d", filter.ResourceID)
+	}
+	if filter.Outcome != "" {
+		where("outcome = $%d", filter.Outcome)
+	}
+	if filter.From != nil {
+		where("created_at >= $%d", filter.From)
+	}
+	if filter.To != nil {
+		where("created_at < $%d", filter.To)
+	}
+	limit := filter.Limit
+	if limit <= 0 || limit > defaultAuditEventLimit {
+		limit = defaultAuditEventLimit
+	}
+	args = append(args, limit)
+
+	query := "SELECT * FROM audit_events"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d", len(args))
+
+	events := models.AuditEvents{}
+	err := s.db.Select(&events, query, args...)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to fetch audit events", zap.Error(err))
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.AuditEvents{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return events, nil
+}
+
+// DeleteAuditEventsBefore removes audit events older than the cutoff,
+// returning how many were removed
+func (s *Store) DeleteAuditEventsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := s.db.Exec(`DELETE FROM audit_events WHERE created_at < $1`, cutoff)
+	var deleted int64
+	if err == nil {
+		deleted, err = result.RowsAffected()
+	}
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to delete audit events", zap.Error(err))
+		return 0, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.AuditEvents{},
+			Operation: apperrors.QuerySave,
+		}
+	}
+	return deleted, nil
+}
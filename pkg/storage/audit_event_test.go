@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s StoreTestSuite) TestAuditEventRoundtrip() {
+	ctx := context.Background()
+	resourceID := uuid.New().String()
+	traceID := uuid.New()
+
+	for _, outcome := range []models.AuditEventOutcome{models.AuditEventOutcomeSUCCEEDED, models.AuditEventOutcomeDENIED} {
+		err := s.store.CreateAuditEvent(ctx, &models.AuditEvent{
+			EUAUserID:    "AUDT",
+			Action:       "read",
+			ResourceType: "SystemIntake",
+			ResourceID:   null.StringFrom(resourceID),
+			Outcome:      outcome,
+			TraceID:      &traceID,
+		})
+		s.NoError(err)
+	}
+
+	s.Run("fetches events matching the filter", func() {
+		events, err := s.store.FetchAuditEvents(ctx, models.AuditEventFilter{ResourceID: resourceID})
+		s.NoError(err)
+		s.Len(events, 2)
+
+		denied, err := s.store.FetchAuditEvents(ctx, models.AuditEventFilter{
+			EUAUserID: "AUDT",
+			Outcome:   models.AuditEventOutcomeDENIED,
+			Limit:     1,
+		})
+		s.NoError(err)
+		s.Len(denied, 1)
+		s.Equal(&traceID, denied[0].TraceID)
+
+		future := time.Now().Add(time.Hour)
+		none, err := s.store.FetchAuditEvents(ctx, models.AuditEventFilter{ResourceID: resourceID, From: &future})
+		s.NoError(err)
+		s.Empty(none)
+	})
+
+	s.Run("deletes events before the cutoff", func() {
+		deleted, err := s.store.DeleteAuditEventsBefore(ctx, time.Now().Add(time.Hour))
+		s.NoError(err)
+		s.GreaterOrEqual(deleted, int64(2))
+
+		events, err := s.store.FetchAuditEvents(ctx, models.AuditEventFilter{ResourceID: resourceID})
+		s.NoError(err)
+		s.Empty(events)
+	})
+}
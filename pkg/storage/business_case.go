@@ -34,13 +34,14 @@ func (s *Store) FetchBusinessCaseByID(ctx context.Context, id uuid.UUID) (*model
 		SELECT
 			business_cases.*,
 			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines,
-			system_intakes.status as system_intake_status
+			system_intakes.status as system_intake_status,
+			system_intakes.eua_user_id as system_intake_eua_user_id,` + selectBusinessCaseAlternativesSQL + `
 		FROM
 			business_cases
 			LEFT JOIN estimated_lifecycle_costs ON business_cases.id = estimated_lifecycle_costs.business_case
 			JOIN system_intakes ON business_cases.system_intake = system_intakes.id
 		WHERE
-			business_cases.id = $1
+			business_cases.id = $1 AND business_cases.deleted_at IS NULL
 		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id, system_intakes.id`
 
 	err := s.db.Get(&businessCase, fetchBusinessCaseSQL, id)
@@ -54,6 +55,10 @@ func (s *Store) FetchBusinessCaseByID(ctx context.Context, id uuid.UUID) (*model
 		}
 		return nil, err
 	}
+	businessCase.Delegates, err = s.FetchSystemIntakeDelegatesByIntakeID(ctx, businessCase.SystemIntakeID)
+	if err != nil {
+		return nil, err
+	}
 	return &businessCase, nil
 }
 
@@ -63,12 +68,12 @@ func (s *Store) FetchOpenBusinessCaseByIntakeID(ctx context.Context, intakeID uu
 	const fetchBusinessCaseSQL = `
 		SELECT
 			business_cases.*,
-			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines
+			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines,` + selectBusinessCaseAlternativesSQL + `
 		FROM
 			business_cases
 			LEFT JOIN estimated_lifecycle_costs ON business_cases.id = estimated_lifecycle_costs.business_case
 		WHERE
-			business_cases.system_intake = $1 AND business_cases.status = 'OPEN'
+			business_cases.system_intake = $1 AND business_cases.status = 'OPEN' AND business_cases.deleted_at IS NULL
 		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id`
 	err := s.db.Get(&businessCase, fetchBusinessCaseSQL, intakeID)
 	if err != nil {
@@ -90,12 +95,16 @@ func (s *Store) FetchBusinessCasesByEuaID(ctx context.Context, euaID string) (mo
 	const fetchBusinessCaseSQL = `
 		SELECT
 			business_cases.*,
-			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines
+			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines,` + selectBusinessCaseAlternativesSQL + `
 		FROM
 			business_cases
 			LEFT JOIN estimated_lifecycle_costs ON business_cases.id = estimated_lifecycle_costs.business_case
 		WHERE
-			business_cases.eua_user_id = $1
+			business_cases.deleted_at IS NULL AND (
+				business_cases.eua_user_id = $1 OR business_cases.system_intake IN (
+					SELECT system_intake_id FROM system_intake_delegates WHERE eua_user_id = $1
+				)
+			)
 		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id`
 
 	err := s.db.Select(&businessCases, fetchBusinessCaseSQL, euaID)
@@ -114,6 +123,7 @@ func createEstimatedLifecycleCosts(ctx context.Context, tx *sqlx.Tx, businessCas
 		INSERT INTO estimated_lifecycle_costs (
 			id,
 			business_case,
+			alternative_id,
 			solution,
 			year,
 			phase,
@@ -122,6 +132,7 @@ func createEstimatedLifecycleCosts(ctx context.Context, tx *sqlx.Tx, businessCas
 		VALUES (
 			:id,
 			:business_case,
+			:alternative_id,
 			:solution,
 			:year,
 			:phase,
@@ -208,6 +219,9 @@ func (s *Store) CreateBusinessCase(ctx context.Context, businessCase *models.Bus
 			alternative_b_pros,
 			alternative_b_cons,
 			alternative_b_cost_savings,
+			lifecycle_cost_horizon,
+			lifecycle_cost_phases,
+			lifecycle_cost_fiscal_year,
 		    created_at,
 			updated_at
 		)
@@ -265,9 +279,14 @@ func (s *Store) CreateBusinessCase(ctx context.Context, businessCase *models.Bus
 			:alternative_b_pros,
 			:alternative_b_cons,
 			:alternative_b_cost_savings,
+			:lifecycle_cost_horizon,
+			:lifecycle_cost_phases,
+			:lifecycle_cost_fiscal_year,
 		    :created_at,
 		    :updated_at
 		)`
+	businessCase.SyncAlternatives(nil)
+	businessCase.ApplyLifecycleCostDefaults()
 	logger := appcontext.ZLogger(ctx)
 	tx := s.db.MustBegin()
 	//Rollback only happens if transaction isn't committed
@@ -293,6 +312,14 @@ func (s *Store) CreateBusinessCase(ctx context.Context, businessCase *models.Bus
 			Operation: apperrors.QueryPost,
 		}
 	}
+	err = s.createBusinessCaseAlternatives(ctx, tx, businessCase)
+	if err != nil {
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     businessCase,
+			Operation: apperrors.QueryPost,
+		}
+	}
 	err = createEstimatedLifecycleCosts(ctx, tx, businessCase)
 	if err != nil {
 		logger.Error(
@@ -320,12 +347,15 @@ func (s *Store) CreateBusinessCase(ctx context.Context, businessCase *models.Bus
 		}
 	}
 
+	// new business cases start at the version column's default
+	businessCase.Version = 1
 	return businessCase, nil
 }
 
 // UpdateBusinessCase creates a business case
 func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 	// We are explicitly not updating ID, EUAUserID and SystemIntakeID
+	// The lifecycle cost horizon and phases and the CEDAR ID are kept when they aren't given
 	const updateBusinessCaseSQL = `
 		UPDATE business_cases
 		SET
@@ -378,12 +408,18 @@ func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.Bus
 			alternative_b_pros = :alternative_b_pros,
 			alternative_b_cons = :alternative_b_cons,
 			alternative_b_cost_savings = :alternative_b_cost_savings,
+			lifecycle_cost_horizon = coalesce(nullif(:lifecycle_cost_horizon, 0), lifecycle_cost_horizon),
+			lifecycle_cost_phases = coalesce(:lifecycle_cost_phases, lifecycle_cost_phases),
+			lifecycle_cost_fiscal_year = :lifecycle_cost_fiscal_year,
 			updated_at = :updated_at,
 		  archived_at = :archived_at,
 		  status = :status,
 			initial_submitted_at = :initial_submitted_at,
-		  last_submitted_at = :last_submitted_at
-		WHERE business_cases.id = :id
+		  last_submitted_at = :last_submitted_at,
+			cedar_id = coalesce(:cedar_id, cedar_id),
+			version = version + 1
+		WHERE business_cases.id = :id AND deleted_at IS NULL AND ` + versionMatchClause + `
+		RETURNING version
 	`
 	const deleteLifecycleCostsSQL = `
 		DELETE FROM estimated_lifecycle_costs
@@ -394,21 +430,28 @@ func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.Bus
 	tx := s.db.MustBegin()
 	//Rollback only happens if transaction isn't committed
 	defer tx.Rollback()
-	result, err := tx.NamedExec(updateBusinessCaseSQL, &businessCase)
+	existingAlternatives, err := fetchBusinessCaseAlternatives(ctx, tx, businessCase.ID)
 	if err != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to update business case %s", err),
-			zap.String("id", businessCase.ID.String()),
-		)
 		return businessCase, err
 	}
-	affectedRows, rowsAffectedErr := result.RowsAffected()
-	if affectedRows == 0 || rowsAffectedErr != nil {
+	businessCase.SyncAlternatives(existingAlternatives)
+
+	updateStmt, err := tx.PrepareNamed(updateBusinessCaseSQL)
+	if err != nil {
+		return businessCase, err
+	}
+	defer updateStmt.Close()
+	var version int
+	err = updateStmt.Get(&version, businessCase)
+	if errors.Is(err, sql.ErrNoRows) {
+		return businessCase, versionConflict(ctx, tx, "business_cases", models.BusinessCase{}, businessCase.ID, businessCase.Version)
+	}
+	if err != nil {
 		logger.Error(
 			fmt.Sprintf("Failed to update business case %s", err),
 			zap.String("id", businessCase.ID.String()),
 		)
-		return businessCase, errors.New("business case not found")
+		return businessCase, err
 	}
 
 	_, err = tx.NamedExec(deleteLifecycleCostsSQL, &businessCase)
@@ -420,6 +463,16 @@ func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.Bus
 		return businessCase, err
 	}
 
+	err = deleteBusinessCaseAlternatives(ctx, tx, businessCase.ID)
+	if err != nil {
+		return businessCase, err
+	}
+
+	err = s.createBusinessCaseAlternatives(ctx, tx, businessCase)
+	if err != nil {
+		return businessCase, err
+	}
+
 	err = createEstimatedLifecycleCosts(ctx, tx, businessCase)
 	if err != nil {
 		return businessCase, err
@@ -434,6 +487,6 @@ func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.Bus
 		return businessCase, err
 	}
 
-	// createEstimatedLifecycleCostSQL
+	businessCase.Version = version
 	return businessCase, nil
 }
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// selectBusinessCaseAlternativesSQL aggregates the alternatives of the business case in the outer query
+const selectBusinessCaseAlternativesSQL = `
+	coalesce((
+		SELECT json_agg(json_build_object(
+			'id', alternatives.id,
+			'businessCaseId', alternatives.business_case_id,
+			'position', alternatives.position,
+			'role', alternatives.role,
+			'title', alternatives.title,
+			'summary', alternatives.summary,
+			'acquisitionApproach', alternatives.acquisition_approach,
+			'securityIsApproved', alternatives.security_is_approved,
+			'securityIsBeingReviewed', alternatives.security_is_being_reviewed,
+			'hostingType', alternatives.hosting_type,
+			'hostingLocation', alternatives.hosting_location,
+			'hostingCloudServiceType', alternatives.hosting_cloud_service_type,
+			'hasUI', alternatives.has_ui,
+			'pros', alternatives.pros,
+			'cons', alternatives.cons,
+			'costSavings', alternatives.cost_savings,
+			'legacySolution', alternatives.legacy_solution,
+			'createdAt', alternatives.created_at,
+			'updatedAt', alternatives.updated_at
+		) ORDER BY alternatives.position)
+		FROM business_case_alternatives alternatives
+		WHERE alternatives.business_case_id = business_cases.id
+	), '[]') as alternatives`
+
+func fetchBusinessCaseAlternatives(ctx context.Context, tx *sqlx.Tx, businessCaseID uuid.UUID) (models.BusinessCaseAlternatives, error) {
+	alternatives := models.BusinessCaseAlternatives{}
+	err := tx.Select(
+		&alternatives,
+		`SELECT * FROM business_case_alternatives WHERE business_case_id = $1 ORDER BY position`,
+		businessCaseID,
+	)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch business case alternatives %s", err),
+			zap.String("BusinessCaseID", businessCaseID.String()),
+		)
+		return nil, err
+	}
+	return alternatives, nil
+}
+
+func deleteBusinessCaseAlternatives(ctx context.Context, tx *sqlx.Tx, businessCaseID uuid.UUID) error {
+	_, err := tx.Exec(`DELETE FROM business_case_alternatives WHERE business_case_id = $1`, businessCaseID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to delete business case alternatives %s", err),
+			zap.String("BusinessCaseID", businessCaseID.String()),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *Store) createBusinessCaseAlternatives(ctx context.Context, tx *sqlx.Tx, businessCase *models.BusinessCase) error {
+	const createBusinessCaseAlternativeSQL = `
+		INSERT INTO business_case_alternatives (
+			id,
+			business_case_id,
+			position,
+			role,
+			title,
+			summary,
+			acquisition_approach,
+			security_is_approved,
+			security_is_being_reviewed,
+			hosting_type,
+			hosting_location,
+			hosting_cloud_service_type,
+			has_ui,
+			pros,
+			cons,
+			cost_savings,
+			legacy_solution,
+			created_at,
+			updated_at
+		)
+		VALUES (
+			:id,
+			:business_case_id,
+			:position,
+			:role,
+			:title,
+			:summary,
+			:acquisition_approach,
+			:security_is_approved,
+			:security_is_being_reviewed,
+			:hosting_type,
+			:hosting_location,
+			:hosting_cloud_service_type,
+			:has_ui,
+			:pros,
+			:cons,
+			:cost_savings,
+			:legacy_solution,
+			:created_at,
+			:updated_at
+		)
+	`
+	now := s.clock.Now()
+	for i := range businessCase.Alternatives {
+		alternative := &businessCase.Alternatives[i]
+		if alternative.CreatedAt == nil {
+			alternative.CreatedAt = &now
+		}
+		alternative.UpdatedAt = &now
+		_, err := tx.NamedExec(createBusinessCaseAlternativeSQL, alternative)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error(
+				fmt.Sprintf(
+					"Failed to create alternative %d with error %s",
+					alternative.Position,
+					err,
+				),
+				zap.String("EUAUserID", businessCase.EUAUserID),
+				zap.String("BusinessCaseID", businessCase.ID.String()),
+			)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestBusinessCaseAlternativesRoundtrip() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.SystemIntakeID = intake.ID
+	created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+
+	s.Run("alternatives are created from the fixed fields", func() {
+		fetched, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+		s.NoError(err)
+		s.Len(fetched.Alternatives, 4)
+		s.Equal(businessCase.AsIsTitle, fetched.Alternatives[0].Title)
+		for _, line := range fetched.LifecycleCostLines {
+			s.NotNil(line.AlternativeID)
+		}
+	})
+
+	s.Run("more than four alternatives can be saved", func() {
+		fetched, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+		s.NoError(err)
+		extraID := uuid.New()
+		cost := 100
+		fetched.Alternatives = append(fetched.Alternatives, models.BusinessCaseAlternative{
+			ID:       extraID,
+			Position: 4,
+			Role:     models.BusinessCaseAlternativeRoleOTHER,
+			Title:    null.StringFrom("Alternative C"),
+		})
+		fetched.LifecycleCostLines = append(fetched.LifecycleCostLines, models.EstimatedLifecycleCost{
+			AlternativeID: &extraID,
+			Year:          models.LifecycleCostYear1,
+			Cost:          &cost,
+		})
+
+		_, err = s.store.UpdateBusinessCase(ctx, fetched)
+		s.NoError(err)
+
+		updated, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+		s.NoError(err)
+		s.Len(updated.Alternatives, 5)
+		s.Equal(extraID, updated.Alternatives[4].ID)
+		s.Equal(null.StringFrom("B"), updated.Alternatives[3].LegacySolution)
+		s.False(updated.Alternatives[4].LegacySolution.Valid)
+		s.Len(updated.LifecycleCostLines, len(fetched.LifecycleCostLines))
+	})
+
+	s.Run("updates in the fixed shape keep additional alternatives", func() {
+		fetched, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+		s.NoError(err)
+		fetched.Alternatives = nil
+		fetched.AsIsTitle = null.StringFrom("Updated As Is Title")
+
+		_, err = s.store.UpdateBusinessCase(ctx, fetched)
+		s.NoError(err)
+
+		updated, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+		s.NoError(err)
+		s.Len(updated.Alternatives, 5)
+		s.Equal(null.StringFrom("Updated As Is Title"), updated.Alternatives[0].Title)
+	})
+}
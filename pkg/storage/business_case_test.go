@@ -29,6 +29,8 @@ func (s StoreTestSuite) TestFetchBusinessCaseByID() {
 		s.Equal(businessCase.EUAUserID, fetched.EUAUserID)
 		s.Equal(intake.Status, fetched.SystemIntakeStatus)
 		s.Len(fetched.LifecycleCostLines, 2)
+		s.Equal(models.DefaultLifecycleCostHorizon, fetched.LifecycleCostHorizon)
+		s.Equal(models.DefaultLifecycleCostPhases(), fetched.LifecycleCostPhases)
 	})
 
 	s.Run("fetches an open business case", func() {
@@ -287,6 +289,26 @@ func (s StoreTestSuite) TestUpdateBusinessCase() {
 		s.Equal(euaID, updated.EUAUserID)
 	})
 
+	s.Run("keeps the CEDAR ID when it isn't given", func() {
+		businessCaseToUpdate := models.BusinessCase{
+			ID:      id,
+			Status:  models.BusinessCaseStatusOPEN,
+			CedarID: null.StringFrom("CEDAR-123"),
+		}
+		_, err := s.store.UpdateBusinessCase(ctx, &businessCaseToUpdate)
+		s.NoError(err)
+
+		businessCaseToUpdate = models.BusinessCase{
+			ID:     id,
+			Status: models.BusinessCaseStatusOPEN,
+		}
+		_, err = s.store.UpdateBusinessCase(ctx, &businessCaseToUpdate)
+		s.NoError(err)
+		updated, err := s.store.FetchBusinessCaseByID(context.Background(), id)
+		s.NoError(err)
+		s.Equal(null.StringFrom("CEDAR-123"), updated.CedarID)
+	})
+
 	s.Run("fails if the business case ID doesn't exist", func() {
 		badUUID := uuid.New()
 		businessCaseToUpdate := models.BusinessCase{
@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// QueueCedarSystemIntakeUpdate records that a system intake needs to be sent to CEDAR again.
+// An intake only has one pending update, since every update sends the whole intake,
+// and queueing it again starts its retries over.
+func (s *Store) QueueCedarSystemIntakeUpdate(ctx context.Context, intakeID uuid.UUID, lastError string) error {
+	const queueSQL = `
+		INSERT INTO cedar_system_intake_updates (
+			id,
+			system_intake_id,
+			attempts,
+			last_error,
+			next_attempt_at,
+			created_at,
+			updated_at
+		)
+		VALUES (
+			:id,
+			:system_intake_id,
+			0,
+			:last_error,
+			:next_attempt_at,
+			:created_at,
+			:updated_at
+		)
+		ON CONFLICT (system_intake_id) DO UPDATE SET
+			generation = cedar_system_intake_updates.generation + 1,
+			attempts = 0,
+			last_error = :last_error,
+			next_attempt_at = :next_attempt_at,
+			updated_at = :updated_at
+	`
+	now := s.clock.Now()
+	update := models.CedarSystemIntakeUpdate{
+		ID:             uuid.New(),
+		SystemIntakeID: intakeID,
+		NextAttemptAt:  &now,
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+	if lastError != "" {
+		update.LastError.SetValid(lastError)
+	}
+	_, err := s.db.NamedExec(queueSQL, &update)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to queue CEDAR update %s", err),
+			zap.String("SystemIntakeID", intakeID.String()),
+		)
+		return err
+	}
+	return nil
+}
+
+// ClaimDueCedarSystemIntakeUpdates claims the pending CEDAR updates that should be attempted again,
+// pushing their next attempt back by the lease so other instances skip them while they're sent
+func (s *Store) ClaimDueCedarSystemIntakeUpdates(ctx context.Context, maxAttempts int, limit int, lease time.Duration) (models.CedarSystemIntakeUpdates, error) {
+	updates := models.CedarSystemIntakeUpdates{}
+	now := s.clock.Now()
+	err := s.db.Select(
+		&updates,
+		`UPDATE cedar_system_intake_updates
+		SET next_attempt_at = $4
+		WHERE id IN (
+			SELECT id FROM cedar_system_intake_updates
+			WHERE next_attempt_at <= $1 AND attempts < $2
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now,
+		maxAttempts,
+		limit,
+		now.Add(lease),
+	)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to claim CEDAR updates %s", err))
+		return nil, err
+	}
+	return updates, nil
+}
+
+// FetchCedarSystemIntakeUpdates queries the DB for all pending CEDAR updates
+func (s *Store) FetchCedarSystemIntakeUpdates(ctx context.Context) (models.CedarSystemIntakeUpdates, error) {
+	updates := models.CedarSystemIntakeUpdates{}
+	err := s.db.Select(&updates, `SELECT * FROM cedar_system_intake_updates ORDER BY created_at`)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch CEDAR updates %s", err))
+		return nil, err
+	}
+	return updates, nil
+}
+
+// RecordCedarSystemIntakeUpdateFailure records a failed attempt and when to try again,
+// unless the update was queued again since the attempt started
+func (s *Store) RecordCedarSystemIntakeUpdateFailure(ctx context.Context, id uuid.UUID, generation int, lastError string, nextAttemptAt time.Time) error {
+	_, err := s.db.Exec(
+		`UPDATE cedar_system_intake_updates
+		SET attempts = attempts + 1, last_error = $3, next_attempt_at = $4, updated_at = $5
+		WHERE id = $1 AND generation = $2`,
+		id,
+		generation,
+		lastError,
+		nextAttemptAt,
+		s.clock.Now(),
+	)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to record CEDAR update failure %s", err),
+			zap.String("id", id.String()),
+		)
+		return err
+	}
+	return nil
+}
+
+// DeleteCedarSystemIntakeUpdate removes a pending CEDAR update once it has been sent,
+// keeping it if it was queued again since it was sent
+func (s *Store) DeleteCedarSystemIntakeUpdate(ctx context.Context, id uuid.UUID, generation int) error {
+	_, err := s.db.Exec(`DELETE FROM cedar_system_intake_updates WHERE id = $1 AND generation = $2`, id, generation)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to delete CEDAR update %s", err),
+			zap.String("id", id.String()),
+		)
+		return err
+	}
+	return nil
+}
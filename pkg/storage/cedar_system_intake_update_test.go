@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestCedarSystemIntakeUpdates() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	findUpdate := func(updates models.CedarSystemIntakeUpdates) *models.CedarSystemIntakeUpdate {
+		for i := range updates {
+			if updates[i].SystemIntakeID == intake.ID {
+				return &updates[i]
+			}
+		}
+		return nil
+	}
+
+	var updateID uuid.UUID
+	s.Run("queues one update per intake", func() {
+		err := s.store.QueueCedarSystemIntakeUpdate(ctx, intake.ID, "first")
+		s.NoError(err)
+		err = s.store.QueueCedarSystemIntakeUpdate(ctx, intake.ID, "second")
+		s.NoError(err)
+
+		updates, err := s.store.FetchCedarSystemIntakeUpdates(ctx)
+		s.NoError(err)
+		update := findUpdate(updates)
+		s.NotNil(update)
+		s.Equal("second", update.LastError.String)
+		updateID = update.ID
+	})
+
+	s.Run("failures push back the next attempt and stop after the max attempts", func() {
+		due, err := s.store.ClaimDueCedarSystemIntakeUpdates(ctx, 1, 100, 0)
+		s.NoError(err)
+		update := findUpdate(due)
+		s.NotNil(update)
+
+		err = s.store.RecordCedarSystemIntakeUpdateFailure(ctx, updateID, update.Generation, "third", s.store.clock.Now())
+		s.NoError(err)
+
+		due, err = s.store.ClaimDueCedarSystemIntakeUpdates(ctx, 1, 100, 0)
+		s.NoError(err)
+		s.Nil(findUpdate(due))
+
+		due, err = s.store.ClaimDueCedarSystemIntakeUpdates(ctx, 2, 100, 0)
+		s.NoError(err)
+		update = findUpdate(due)
+		s.NotNil(update)
+		s.Equal(1, update.Attempts)
+		s.Equal("third", update.LastError.String)
+
+		err = s.store.RecordCedarSystemIntakeUpdateFailure(ctx, updateID, update.Generation, "fourth", s.store.clock.Now().Add(time.Hour))
+		s.NoError(err)
+		due, err = s.store.ClaimDueCedarSystemIntakeUpdates(ctx, 5, 100, 0)
+		s.NoError(err)
+		s.Nil(findUpdate(due))
+	})
+
+	s.Run("claimed updates are skipped until their lease runs out", func() {
+		s.NoError(s.store.QueueCedarSystemIntakeUpdate(ctx, intake.ID, "fifth"))
+
+		due, err := s.store.ClaimDueCedarSystemIntakeUpdates(ctx, 5, 100, time.Hour)
+		s.NoError(err)
+		s.NotNil(findUpdate(due))
+
+		due, err = s.store.ClaimDueCedarSystemIntakeUpdates(ctx, 5, 100, time.Hour)
+		s.NoError(err)
+		s.Nil(findUpdate(due))
+	})
+
+	s.Run("keeps an update queued again while it was being sent", func() {
+		s.NoError(s.store.QueueCedarSystemIntakeUpdate(ctx, intake.ID, "sixth"))
+		due, err := s.store.ClaimDueCedarSystemIntakeUpdates(ctx, 5, 100, 0)
+		s.NoError(err)
+		sent := findUpdate(due)
+		s.NotNil(sent)
+
+		s.NoError(s.store.QueueCedarSystemIntakeUpdate(ctx, intake.ID, "seventh"))
+		s.NoError(s.store.RecordCedarSystemIntakeUpdateFailure(ctx, updateID, sent.Generation, "stale", s.store.clock.Now().Add(time.Hour)))
+		s.NoError(s.store.DeleteCedarSystemIntakeUpdate(ctx, updateID, sent.Generation))
+
+		updates, err := s.store.FetchCedarSystemIntakeUpdates(ctx)
+		s.NoError(err)
+		requeued := findUpdate(updates)
+		s.NotNil(requeued)
+		s.Equal(sent.Generation+1, requeued.Generation)
+		s.Equal(0, requeued.Attempts)
+		s.Equal("seventh", requeued.LastError.String)
+	})
+
+	s.Run("deletes sent updates", func() {
+		updates, err := s.store.FetchCedarSystemIntakeUpdates(ctx)
+		s.NoError(err)
+		update := findUpdate(updates)
+		s.NotNil(update)
+		s.NoError(s.store.DeleteCedarSystemIntakeUpdate(ctx, updateID, update.Generation))
+
+		updates, err = s.store.FetchCedarSystemIntakeUpdates(ctx)
+		s.NoError(err)
+		s.Nil(findUpdate(updates))
+	})
+}
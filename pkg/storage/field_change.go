@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// CreateFieldChanges saves the field changes from one update together
+func (s *Store) CreateFieldChanges(ctx context.Context, changes models.FieldChanges) error {
+	const createFieldChangeSQL = `
+		INSERT INTO field_changes (
+			id,
+			resource_type,
+			resource_id,
+			field,
+			old_value,
+			new_value,
+			eua_user_id,
+			changed_at
+		)
+		VALUES (
+			:id,
+			:resource_type,
+			:resource_id,
+			:field,
+			:old_value,
+			:new_value,
+			:eua_user_id,
+			:changed_at
+		)`
+	tx := s.db.MustBegin()
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+	for i := range changes {
+		changes[i].ID = uuid.New()
+		if _, err := tx.NamedExec(createFieldChangeSQL, &changes[i]); err != nil {
+			appcontext.ZLogger(ctx).Error(
+				fmt.Sprintf("Failed to create field change %s", err),
+				zap.String("resourceID", changes[i].ResourceID.String()),
+			)
+			return &apperrors.QueryError{
+				Err:       err,
+				Model:     changes[i],
+				Operation: apperrors.QueryPost,
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     changes,
+			Operation: apperrors.QueryPost,
+		}
+	}
+	return nil
+}
+
+// FetchFieldChanges queries the DB for the changes to a resource, newest first
+func (s *Store) FetchFieldChanges(ctx context.Context, resourceType string, resourceID uuid.UUID) (models.FieldChanges, error) {
+	changes := models.FieldChanges{}
+	const fetchFieldChangesSQL = `
+		SELECT *
+		FROM field_changes
+		WHERE resource_type=$1 AND resource_id=$2
+		ORDER BY changed_at DESC, field
+	`
+	err := s.db.Select(&changes, fetchFieldChangesSQL, resourceType, resourceID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch field changes %s", err),
+			zap.String("resourceID", resourceID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.FieldChanges{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return changes, nil
+}
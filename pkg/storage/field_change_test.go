@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s StoreTestSuite) TestFieldChangeRoundtrip() {
+	ctx := context.Background()
+	resourceID := uuid.New()
+	earlier := time.Now().Add(-time.Hour).UTC()
+	later := time.Now().UTC()
+
+	err := s.store.CreateFieldChanges(ctx, models.FieldChanges{
+		{
+			ResourceType: "SystemIntake",
+			ResourceID:   resourceID,
+			Field:        "projectName",
+			OldValue:     types.JSONText(`null`),
+			NewValue:     types.JSONText(`"Easy Access"`),
+			EUAUserID:    "HIST",
+			ChangedAt:    &earlier,
+		},
+		{
+			ResourceType: "SystemIntake",
+			ResourceID:   resourceID,
+			Field:        "projectName",
+			OldValue:     types.JSONText(`"Easy Access"`),
+			NewValue:     types.JSONText(`"Easier Access"`),
+			EUAUserID:    "HIST",
+			ChangedAt:    &later,
+		},
+	})
+	s.NoError(err)
+
+	changes, err := s.store.FetchFieldChanges(ctx, "SystemIntake", resourceID)
+	s.NoError(err)
+	s.Len(changes, 2)
+	s.JSONEq(`"Easier Access"`, string(changes[0].NewValue))
+	s.JSONEq(`"Easy Access"`, string(changes[1].NewValue))
+
+	none, err := s.store.FetchFieldChanges(ctx, "BusinessCase", resourceID)
+	s.NoError(err)
+	s.Empty(none)
+}
@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// softDeleteTable is a table whose rows are soft deleted by setting deleted_at,
+// following ADR 0024. Fetches leave out rows with deleted_at set.
+type softDeleteTable struct {
+	name  string
+	model interface{}
+	// rows of the child tables that belong to a row are deleted and restored along with it
+	children []softDeleteChild
+}
+
+// softDeleteChild is a table whose rows reference a soft deleted table's row through column
+type softDeleteChild struct {
+	name   string
+	column string
+}
+
+var (
+	systemIntakesTable = softDeleteTable{
+		name:  "system_intakes",
+		model: models.SystemIntake{},
+		children: []softDeleteChild{
+			{name: "business_cases", column: "system_intake"},
+			{name: "system_intake_documents", column: "system_intake_id"},
+		},
+	}
+	businessCasesTable = softDeleteTable{
+		name:  "business_cases",
+		model: models.BusinessCase{},
+		children: []softDeleteChild{
+			{name: "system_intake_documents", column: "business_case_id"},
+		},
+	}
+	accessibilityRequestsTable = softDeleteTable{
+		name:  "accessibility_requests",
+		model: models.AccessibilityRequest{},
+		children: []softDeleteChild{
+			{name: "accessibility_request_documents", column: "request_id"},
+		},
+	}
+	accessibilityRequestDocumentsTable = softDeleteTable{
+		name:  "accessibility_request_documents",
+		model: models.AccessibilityRequestDocument{},
+	}
+	systemIntakeDocumentsTable = softDeleteTable{
+		name:  "system_intake_documents",
+		model: models.SystemIntakeDocument{},
+	}
+	testDatesTable = softDeleteTable{
+		name:  "test_dates",
+		model: models.TestDate{},
+	}
+)
+
+// SoftDeleteSystemIntake soft deletes a system intake, its business cases and its documents
+func (s *Store) SoftDeleteSystemIntake(ctx context.Context, id uuid.UUID, euaUserID string) error {
+	return s.softDelete(ctx, systemIntakesTable, id, euaUserID)
+}
+
+// RestoreSystemIntake undoes SoftDeleteSystemIntake
+func (s *Store) RestoreSystemIntake(ctx context.Context, id uuid.UUID) error {
+	return s.restore(ctx, systemIntakesTable, id)
+}
+
+// SoftDeleteBusinessCase soft deletes a business case and the documents attached to it
+func (s *Store) SoftDeleteBusinessCase(ctx context.Context, id uuid.UUID, euaUserID string) error {
+	return s.softDelete(ctx, businessCasesTable, id, euaUserID)
+}
+
+// RestoreBusinessCase undoes SoftDeleteBusinessCase
+func (s *Store) RestoreBusinessCase(ctx context.Context, id uuid.UUID) error {
+	return s.restore(ctx, businessCasesTable, id)
+}
+
+// SoftDeleteAccessibilityRequest soft deletes an accessibility request and its documents
+func (s *Store) SoftDeleteAccessibilityRequest(ctx context.Context, id uuid.UUID, euaUserID string) error {
+	return s.softDelete(ctx, accessibilityRequestsTable, id, euaUserID)
+}
+
+// RestoreAccessibilityRequest undoes SoftDeleteAccessibilityRequest
+func (s *Store) RestoreAccessibilityRequest(ctx context.Context, id uuid.UUID) error {
+	return s.restore(ctx, accessibilityRequestsTable, id)
+}
+
+// SoftDeleteAccessibilityRequestDocument soft deletes an accessibility request document
+func (s *Store) SoftDeleteAccessibilityRequestDocument(ctx context.Context, id uuid.UUID, euaUserID string) error {
+	return s.softDelete(ctx, accessibilityRequestDocumentsTable, id, euaUserID)
+}
+
+// RestoreAccessibilityRequestDocument undoes SoftDeleteAccessibilityRequestDocument
+func (s *Store) RestoreAccessibilityRequestDocument(ctx context.Context, id uuid.UUID) error {
+	return s.restore(ctx, accessibilityRequestDocumentsTable, id)
+}
+
+// SoftDeleteSystemIntakeDocument soft deletes a system intake document
+func (s *Store) SoftDeleteSystemIntakeDocument(ctx context.Context, id uuid.UUID, euaUserID string) error {
+	return s.softDelete(ctx, systemIntakeDocumentsTable, id, euaUserID)
+}
+
+// SoftDeleteTestDate soft deletes a 508 test date, returning it as deleted
+func (s *Store) SoftDeleteTestDate(ctx context.Context, id uuid.UUID, euaUserID string) (*models.TestDate, error) {
+	if err := s.softDelete(ctx, testDatesTable, id, euaUserID); err != nil {
+		return nil, err
+	}
+	testDate := models.TestDate{}
+	if err := s.db.GetContext(ctx, &testDate, `SELECT * FROM test_dates WHERE id=$1`, id); err != nil {
+		return nil, s.softDeleteError(ctx, testDatesTable, id, err, apperrors.QueryFetch)
+	}
+	return &testDate, nil
+}
+
+// softDelete marks the row and its children deleted with the same timestamp,
+// so that restoring the row brings back only the children deleted with it
+func (s *Store) softDelete(ctx context.Context, table softDeleteTable, id uuid.UUID, euaUserID string) error {
+	deleteSQL := fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = $2, deleted_by = $3
+		WHERE id = $1 AND deleted_at IS NULL
+	`, table.name)
+	const deleteChildrenSQL = `
+		UPDATE %s
+		SET deleted_at = $2, deleted_by = $3
+		WHERE %s = $1 AND deleted_at IS NULL
+	`
+
+	deletedAt := s.clock.Now()
+	tx := s.db.MustBegin()
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+	result, err := tx.Exec(deleteSQL, id, deletedAt, euaUserID)
+	if err != nil {
+		return s.softDeleteError(ctx, table, id, err, apperrors.QueryUpdate)
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return s.softDeleteError(ctx, table, id, err, apperrors.QueryUpdate)
+	}
+	if affectedRows == 0 {
+		return &apperrors.ResourceNotFoundError{
+			Err:      fmt.Errorf("no %s with id %s", table.name, id),
+			Resource: table.model,
+		}
+	}
+	for _, child := range table.children {
+		if _, err = tx.Exec(fmt.Sprintf(deleteChildrenSQL, child.name, child.column), id, deletedAt, euaUserID); err != nil {
+			return s.softDeleteError(ctx, table, id, err, apperrors.QueryUpdate)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return s.softDeleteError(ctx, table, id, err, apperrors.QueryUpdate)
+	}
+	return nil
+}
+
+// restore clears deleted_at on a soft deleted row and the children deleted along with it
+func (s *Store) restore(ctx context.Context, table softDeleteTable, id uuid.UUID) error {
+	fetchDeletedAtSQL := fmt.Sprintf(`
+		SELECT deleted_at FROM %s WHERE id = $1 AND deleted_at IS NOT NULL
+	`, table.name)
+	restoreSQL := fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = NULL, deleted_by = NULL
+		WHERE id = $1
+	`, table.name)
+	const restoreChildrenSQL = `
+		UPDATE %s
+		SET deleted_at = NULL, deleted_by = NULL
+		WHERE %s = $1 AND deleted_at = $2
+	`
+
+	tx := s.db.MustBegin()
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+	var deletedAt time.Time
+	err := tx.Get(&deletedAt, fetchDeletedAtSQL, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &apperrors.ResourceNotFoundError{
+			Err:      fmt.Errorf("no deleted %s with id %s", table.name, id),
+			Resource: table.model,
+		}
+	}
+	if err != nil {
+		return s.softDeleteError(ctx, table, id, err, apperrors.QueryFetch)
+	}
+	if _, err = tx.Exec(restoreSQL, id); err != nil {
+		return s.softDeleteError(ctx, table, id, err, apperrors.QueryUpdate)
+	}
+	for _, child := range table.children {
+		if _, err = tx.Exec(fmt.Sprintf(restoreChildrenSQL, child.name, child.column), id, deletedAt); err != nil {
+			return s.softDeleteError(ctx, table, id, err, apperrors.QueryUpdate)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return s.softDeleteError(ctx, table, id, err, apperrors.QueryUpdate)
+	}
+	return nil
+}
+
+func (s *Store) softDeleteError(ctx context.Context, table softDeleteTable, id uuid.UUID, err error, operation apperrors.QueryOperation) error {
+	appcontext.ZLogger(ctx).Error(
+		fmt.Sprintf("Failed to change deletion of %s %s", table.name, err),
+		zap.String("id", id.String()),
+	)
+	return &apperrors.QueryError{
+		Err:       err,
+		Model:     table.model,
+		Operation: operation,
+	}
+}
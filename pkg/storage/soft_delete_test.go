@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestSoftDeleteSystemIntake() {
+	ctx := context.Background()
+
+	s.Run("hides the intake and its business case until they are restored", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.SystemIntakeID = intake.ID
+		createdBusinessCase, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+
+		err = s.store.SoftDeleteSystemIntake(ctx, intake.ID, "GRTM")
+		s.NoError(err)
+
+		_, err = s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+		_, err = s.store.FetchBusinessCaseByID(ctx, createdBusinessCase.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+		intakes, err := s.store.FetchSystemIntakesByEuaID(ctx, intake.EUAUserID.ValueOrZero())
+		s.NoError(err)
+		for _, fetched := range intakes {
+			s.NotEqual(intake.ID, fetched.ID)
+		}
+
+		err = s.store.SoftDeleteSystemIntake(ctx, intake.ID, "GRTM")
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+
+		err = s.store.RestoreSystemIntake(ctx, intake.ID)
+		s.NoError(err)
+
+		restored, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Nil(restored.DeletedAt)
+		s.Equal(null.String{}, restored.DeletedBy)
+		s.Equal(&createdBusinessCase.ID, restored.BusinessCaseID)
+		_, err = s.store.FetchBusinessCaseByID(ctx, createdBusinessCase.ID)
+		s.NoError(err)
+	})
+
+	s.Run("keeps a business case deleted on its own deleted when restoring the intake", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.SystemIntakeID = intake.ID
+		createdBusinessCase, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+
+		s.NoError(s.store.SoftDeleteBusinessCase(ctx, createdBusinessCase.ID, "GRTM"))
+		s.NoError(s.store.SoftDeleteSystemIntake(ctx, intake.ID, "GRTM"))
+		s.NoError(s.store.RestoreSystemIntake(ctx, intake.ID))
+
+		_, err = s.store.FetchBusinessCaseByID(ctx, createdBusinessCase.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("hides the documents of the intake and its business case until they are restored", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.SystemIntakeID = intake.ID
+		createdBusinessCase, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+		document, err := s.store.CreateSystemIntakeDocument(ctx, &models.SystemIntakeDocument{
+			SystemIntakeID: intake.ID,
+			FileType:       "application/pdf",
+			Key:            "abc.pdf",
+			Name:           "quote.pdf",
+			DocumentType:   models.SystemIntakeDocumentTypeVendorQuote,
+		})
+		s.NoError(err)
+		attached, err := s.store.CreateSystemIntakeDocument(ctx, &models.SystemIntakeDocument{
+			SystemIntakeID: intake.ID,
+			BusinessCaseID: &createdBusinessCase.ID,
+			FileType:       "application/pdf",
+			Key:            "def.pdf",
+			Name:           "estimate.pdf",
+			DocumentType:   models.SystemIntakeDocumentTypeVendorQuote,
+		})
+		s.NoError(err)
+
+		s.NoError(s.store.SoftDeleteBusinessCase(ctx, createdBusinessCase.ID, "GRTM"))
+		_, err = s.store.FetchSystemIntakeDocumentByID(ctx, attached.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+		_, err = s.store.FetchSystemIntakeDocumentByID(ctx, document.ID)
+		s.NoError(err)
+
+		s.NoError(s.store.SoftDeleteSystemIntake(ctx, intake.ID, "GRTM"))
+		_, err = s.store.FetchSystemIntakeDocumentByID(ctx, document.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+
+		s.NoError(s.store.RestoreSystemIntake(ctx, intake.ID))
+		_, err = s.store.FetchSystemIntakeDocumentByID(ctx, document.ID)
+		s.NoError(err)
+		_, err = s.store.FetchSystemIntakeDocumentByID(ctx, attached.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+
+		s.NoError(s.store.RestoreBusinessCase(ctx, createdBusinessCase.ID))
+		_, err = s.store.FetchSystemIntakeDocumentByID(ctx, attached.ID)
+		s.NoError(err)
+	})
+
+	s.Run("cannot restore an intake that is not deleted", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+
+		err = s.store.RestoreSystemIntake(ctx, intake.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
+
+func (s StoreTestSuite) TestSoftDeleteAccessibilityRequest() {
+	ctx := context.Background()
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	request, err := s.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{Name: "Soft", IntakeID: intake.ID})
+	s.NoError(err)
+	document, err := s.store.CreateAccessibilityRequestDocument(ctx, &models.AccessibilityRequestDocument{
+		Name:               "test.pdf",
+		FileType:           "application/pdf",
+		Key:                uuid.New().String() + ".pdf",
+		RequestID:          request.ID,
+		CommonDocumentType: models.AccessibilityRequestDocumentCommonTypeTestPlan,
+	})
+	s.NoError(err)
+
+	s.NoError(s.store.SoftDeleteAccessibilityRequest(ctx, request.ID, "GRTM"))
+
+	_, err = s.store.FetchAccessibilityRequestByID(ctx, request.ID)
+	s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	_, err = s.store.FetchAccessibilityRequestDocumentByID(ctx, document.ID)
+	s.IsType(&apperrors.ResourceNotFoundError{}, err)
+
+	s.NoError(s.store.RestoreAccessibilityRequest(ctx, request.ID))
+
+	_, err = s.store.FetchAccessibilityRequestByID(ctx, request.ID)
+	s.NoError(err)
+	documents, err := s.store.FetchDocumentsByAccessibilityRequestID(ctx, request.ID)
+	s.NoError(err)
+	s.Len(documents, 1)
+}
@@ -78,7 +78,8 @@ const sqlListSystems = `
 	WHERE
 		status='LCID_ISSUED' AND
 		request_type='NEW' AND
-		lcid IS NOT NULL;
+		lcid IS NOT NULL AND
+		deleted_at IS NULL;
 `
 
 func (s *Store) listSystems(ctx context.Context) ([]*models.System, error) {
@@ -107,6 +108,7 @@ const sqlFetchSystemByIntakeID = `
 		status='LCID_ISSUED' AND
 		request_type='NEW' AND
 		lcid IS NOT NULL AND
+		deleted_at IS NULL AND
 		id = $1;
 `
 
@@ -38,6 +38,7 @@ func (s *Store) CreateSystemIntake(ctx context.Context, intake *models.SystemInt
 			component,
 			business_owner,
 			business_owner_component,
+			business_owner_eua_id,
 			product_manager,
 			product_manager_component,
 			isso,
@@ -80,6 +81,7 @@ func (s *Store) CreateSystemIntake(ctx context.Context, intake *models.SystemInt
 			:component,
 			:business_owner,
 			:business_owner_component,
+			:business_owner_eua_id,
 			:product_manager,
 			:product_manager_component,
 			:isso,
@@ -129,7 +131,10 @@ func (s *Store) CreateSystemIntake(ctx context.Context, intake *models.SystemInt
 
 // UpdateSystemIntake does an upsert for a system intake
 func (s *Store) UpdateSystemIntake(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
-	// We are explicitly not updating ID, EUAUserID and SystemIntakeID
+	// We are explicitly not updating ID, EUAUserID and SystemIntakeID,
+	// or the resolved collaborators, which only UpdateSystemIntakeCollaborators saves.
+	// funding_number and funding_source follow the intake's first funding source,
+	// which only the funding source methods save
 	const updateSystemIntakeSQL = `
 		UPDATE system_intakes
 		SET
@@ -139,6 +144,7 @@ func (s *Store) UpdateSystemIntake(ctx context.Context, intake *models.SystemInt
 			component = :component,
 			business_owner = :business_owner,
 			business_owner_component = :business_owner_component,
+			business_owner_eua_id = :business_owner_eua_id,
 			product_manager = :product_manager,
 			product_manager_component = :product_manager_component,
 			isso = :isso,
@@ -152,8 +158,6 @@ func (s *Store) UpdateSystemIntake(ctx context.Context, intake *models.SystemInt
 			project_name = :project_name,
 			project_acronym = :project_acronym,
 			existing_funding = :existing_funding,
-			funding_number = :funding_number,
-			funding_source = :funding_source,
 			business_need = :business_need,
 			solution = :solution,
 			process_status = :process_status,
@@ -180,10 +184,10 @@ func (s *Store) UpdateSystemIntake(ctx context.Context, intake *models.SystemInt
 			lcid_expires_at = :lcid_expires_at,
 			lcid_scope = :lcid_scope,
 			decision_next_steps = :decision_next_steps,
-			rejection_reason = :rejection_reason
-		WHERE system_intakes.id = :id
-	`
-	_, err := s.db.NamedExec(
+			rejection_reason = :rejection_reason,
+			version = version + 1
+		WHERE system_intakes.id = :id AND deleted_at IS NULL AND ` + versionMatchClause
+	result, err := s.db.NamedExec(
 		updateSystemIntakeSQL,
 		intake,
 	)
@@ -199,25 +203,87 @@ func (s *Store) UpdateSystemIntake(ctx context.Context, intake *models.SystemInt
 			Operation: apperrors.QueryUpdate,
 		}
 	}
+	if affectedRows, rowsErr := result.RowsAffected(); rowsErr == nil && affectedRows == 0 {
+		return nil, versionConflict(ctx, s.db, "system_intakes", models.SystemIntake{}, intake.ID, intake.Version)
+	}
 	// the SystemIntake may have been updated to Archived, so we want to use
 	// the un-filtered fetch to return the saved object
-	return s.FetchSystemIntakeByID(ctx, intake.ID)
+	saved, err := s.FetchSystemIntakeByID(ctx, intake.ID)
+	if err != nil {
+		return nil, err
+	}
+	// callers may go on to update the same intake again
+	intake.Version = saved.Version
+	return saved, nil
+}
+
+// UpdateSystemIntakeCollaborators saves the EUA IDs, emails and warnings of an intake's resolved collaborators
+func (s *Store) UpdateSystemIntakeCollaborators(ctx context.Context, intake *models.SystemIntake) error {
+	const updateCollaboratorsSQL = `
+		UPDATE system_intakes
+		SET
+			isso_eua_id = :isso_eua_id,
+			isso_email = :isso_email,
+			trb_collaborator_eua_id = :trb_collaborator_eua_id,
+			trb_collaborator_email = :trb_collaborator_email,
+			oit_security_collaborator_eua_id = :oit_security_collaborator_eua_id,
+			oit_security_collaborator_email = :oit_security_collaborator_email,
+			ea_collaborator_eua_id = :ea_collaborator_eua_id,
+			ea_collaborator_email = :ea_collaborator_email,
+			collaborator_warnings = :collaborator_warnings
+		WHERE system_intakes.id = :id AND deleted_at IS NULL`
+	result, err := s.db.NamedExec(updateCollaboratorsSQL, intake)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to update system intake collaborators %s", err),
+			zap.String("id", intake.ID.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     intake,
+			Operation: apperrors.QueryUpdate,
+		}
+	}
+	if affectedRows, rowsErr := result.RowsAffected(); rowsErr == nil && affectedRows == 0 {
+		return &apperrors.ResourceNotFoundError{Err: sql.ErrNoRows, Resource: models.SystemIntake{}}
+	}
+	return nil
 }
 
 const fetchSystemIntakeSQL = `
 		SELECT
 		       system_intakes.*,
-		       business_cases.id as business_case_id
+		       business_cases.id as business_case_id,
+		       coalesce(
+		           (
+		               SELECT json_agg(
+		                   json_build_object(
+		                       'id', funding_sources.id,
+		                       'systemIntakeId', funding_sources.system_intake_id,
+		                       'source', funding_sources.source,
+		                       'fundingNumber', funding_sources.funding_number,
+		                       'amount', funding_sources.amount,
+		                       'fiscalYear', funding_sources.fiscal_year,
+		                       'createdAt', funding_sources.created_at,
+		                       'updatedAt', funding_sources.updated_at
+		                   ) ORDER BY funding_sources.created_at
+		               )
+		               FROM system_intake_funding_sources funding_sources
+		               WHERE funding_sources.system_intake_id = system_intakes.id
+		           ),
+		           '[]'
+		       ) as funding_sources,`+selectSystemIntakeDocumentsSQL+`
 		FROM
 		     system_intakes
-		     LEFT JOIN business_cases ON business_cases.system_intake = system_intakes.id
+		     LEFT JOIN business_cases ON business_cases.system_intake = system_intakes.id AND business_cases.deleted_at IS NULL
+		WHERE system_intakes.deleted_at IS NULL
 `
 
 // FetchSystemIntakeByID queries the DB for a system intake matching the given ID
 func (s *Store) FetchSystemIntakeByID(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
 	intake := models.SystemIntake{}
 	const idMatchClause = `
-		WHERE system_intakes.id=$1
+		AND system_intakes.id=$1
 `
 	err := s.db.Get(&intake, fetchSystemIntakeSQL+idMatchClause, id)
 	if err != nil {
@@ -234,6 +300,10 @@ func (s *Store) FetchSystemIntakeByID(ctx context.Context, id uuid.UUID) (*model
 			Operation: apperrors.QueryFetch,
 		}
 	}
+	intake.Delegates, err = s.FetchSystemIntakeDelegatesByIntakeID(ctx, intake.ID)
+	if err != nil {
+		return nil, err
+	}
 
 	return &intake, nil
 }
@@ -242,7 +312,11 @@ func (s *Store) FetchSystemIntakeByID(ctx context.Context, id uuid.UUID) (*model
 func (s *Store) FetchSystemIntakesByEuaID(ctx context.Context, euaID string) (models.SystemIntakes, error) {
 	intakes := []models.SystemIntake{}
 	const byEuaIDClause = `
-		WHERE system_intakes.eua_user_id=$1 AND system_intakes.status != 'WITHDRAWN'
+		AND system_intakes.status != 'WITHDRAWN' AND (
+			system_intakes.eua_user_id=$1 OR system_intakes.id IN (
+				SELECT system_intake_id FROM system_intake_delegates WHERE eua_user_id=$1
+			)
+		)
 	`
 	err := s.db.Select(&intakes, fetchSystemIntakeSQL+byEuaIDClause, euaID)
 	if err != nil {
@@ -270,7 +344,7 @@ func (s *Store) FetchSystemIntakes(ctx context.Context) (models.SystemIntakes, e
 func (s *Store) FetchSystemIntakesByStatuses(ctx context.Context, allowedStatuses []models.SystemIntakeStatus) (models.SystemIntakes, error) {
 	intakes := []models.SystemIntake{}
 	const byStatusClause = `
-		WHERE system_intakes.status IN (?)
+		AND system_intakes.status IN (?)
 	`
 	query, args, err := sqlx.In(fetchSystemIntakeSQL+byStatusClause, allowedStatuses)
 	if err != nil {
@@ -286,6 +360,36 @@ func (s *Store) FetchSystemIntakesByStatuses(ctx context.Context, allowedStatuse
 	return intakes, nil
 }
 
+// FetchSubmittedSystemIntakesWithoutAlfabetID queries the DB for submitted system intakes that CEDAR doesn't know about
+func (s *Store) FetchSubmittedSystemIntakesWithoutAlfabetID(ctx context.Context) (models.SystemIntakes, error) {
+	intakes := []models.SystemIntake{}
+	const unlinkedClause = `
+		AND system_intakes.submitted_at IS NOT NULL AND system_intakes.alfabet_id IS NULL
+		ORDER BY system_intakes.submitted_at
+	`
+	err := s.db.Select(&intakes, fetchSystemIntakeSQL+unlinkedClause)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes without alfabet ID %s", err))
+		return models.SystemIntakes{}, err
+	}
+	return intakes, nil
+}
+
+// FetchSystemIntakesWithAlfabetID queries the DB for system intakes that have been linked to CEDAR
+func (s *Store) FetchSystemIntakesWithAlfabetID(ctx context.Context) (models.SystemIntakes, error) {
+	intakes := []models.SystemIntake{}
+	const linkedClause = `
+		AND system_intakes.alfabet_id IS NOT NULL
+		ORDER BY system_intakes.submitted_at
+	`
+	err := s.db.Select(&intakes, fetchSystemIntakeSQL+linkedClause)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes with alfabet ID %s", err))
+		return models.SystemIntakes{}, err
+	}
+	return intakes, nil
+}
+
 func generateLifecyclePrefix(t time.Time, loc *time.Location) string {
 	return t.In(loc).Format("06002")
 }
@@ -322,6 +426,7 @@ func (s *Store) FetchSystemIntakeMetrics(ctx context.Context, startTime time.Tim
 		    FROM system_intakes
 		    WHERE created_at >=  $1
 		      AND created_at < $2
+		      AND deleted_at IS NULL
 		)
 		SELECT count(*) AS started_count,
 		       coalesce(
@@ -343,6 +448,7 @@ func (s *Store) FetchSystemIntakeMetrics(ctx context.Context, startTime time.Tim
 		    FROM system_intakes
 		    WHERE submitted_at >=  $1
 		      AND submitted_at < $2
+		      AND deleted_at IS NULL
 		)
 		SELECT count(*) AS completed_count,
 		       coalesce(sum(CASE WHEN existing_funding IS true THEN 1 ELSE 0 END),0) AS funded_count
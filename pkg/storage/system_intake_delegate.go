@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// SaveSystemIntakeDelegate adds a delegate to a system intake, or changes their role if they already are one
+func (s *Store) SaveSystemIntakeDelegate(ctx context.Context, delegate *models.SystemIntakeDelegate) (*models.SystemIntakeDelegate, error) {
+	delegate.ID = uuid.New()
+	now := s.clock.Now()
+	delegate.CreatedAt = &now
+	delegate.UpdatedAt = &now
+	const saveDelegateSQL = `
+		INSERT INTO system_intake_delegates (
+			id,
+			system_intake_id,
+			eua_user_id,
+			common_name,
+			email,
+			role,
+			created_at,
+			updated_at
+		)
+		VALUES (
+			:id,
+			:system_intake_id,
+			:eua_user_id,
+			:common_name,
+			:email,
+			:role,
+			:created_at,
+			:updated_at
+		)
+		ON CONFLICT (system_intake_id, eua_user_id) DO UPDATE SET
+			common_name = EXCLUDED.common_name,
+			email = EXCLUDED.email,
+			role = EXCLUDED.role,
+			updated_at = EXCLUDED.updated_at`
+	_, err := s.db.NamedExec(saveDelegateSQL, delegate)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to save system intake delegate %s", err),
+			zap.String("intakeID", delegate.SystemIntakeID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     delegate,
+			Operation: apperrors.QuerySave,
+		}
+	}
+	saved := models.SystemIntakeDelegate{}
+	err = s.db.Get(
+		&saved,
+		`SELECT * FROM system_intake_delegates WHERE system_intake_id=$1 AND eua_user_id=$2`,
+		delegate.SystemIntakeID,
+		delegate.EUAUserID,
+	)
+	if err != nil {
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     delegate,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return &saved, nil
+}
+
+// DeleteSystemIntakeDelegate removes a delegate from a system intake
+func (s *Store) DeleteSystemIntakeDelegate(ctx context.Context, intakeID uuid.UUID, euaUserID string) error {
+	result, err := s.db.Exec(
+		`DELETE FROM system_intake_delegates WHERE system_intake_id=$1 AND eua_user_id=$2`,
+		intakeID,
+		euaUserID,
+	)
+	var deleted int64
+	if err == nil {
+		deleted, err = result.RowsAffected()
+	}
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to delete system intake delegate %s", err),
+			zap.String("intakeID", intakeID.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntakeDelegate{},
+			Operation: apperrors.QuerySave,
+		}
+	}
+	if deleted == 0 {
+		return &apperrors.ResourceNotFoundError{
+			Err:      fmt.Errorf("%s is not a delegate", euaUserID),
+			Resource: models.SystemIntakeDelegate{},
+		}
+	}
+	return nil
+}
+
+// FetchSystemIntakeDelegatesByIntakeID queries the DB for the delegates of a system intake
+func (s *Store) FetchSystemIntakeDelegatesByIntakeID(ctx context.Context, intakeID uuid.UUID) (models.SystemIntakeDelegates, error) {
+	delegates := models.SystemIntakeDelegates{}
+	const fetchDelegatesSQL = `
+		SELECT *
+		FROM system_intake_delegates
+		WHERE system_intake_id=$1
+		ORDER BY created_at
+	`
+	err := s.db.Select(&delegates, fetchDelegatesSQL, intakeID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch system intake delegates %s", err),
+			zap.String("intakeID", intakeID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntakeDelegates{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return delegates, nil
+}
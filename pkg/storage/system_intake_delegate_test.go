@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestSystemIntakeDelegateRoundtrip() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	s.Run("save, change role, read and delete", func() {
+		saved, err := s.store.SaveSystemIntakeDelegate(ctx, &models.SystemIntakeDelegate{
+			SystemIntakeID: intake.ID,
+			EUAUserID:      "ABCD",
+			CommonName:     "Adeline Aarons",
+			Email:          "adeline@example.com",
+			Role:           models.SystemIntakeDelegateRoleVIEWER,
+		})
+		s.NoError(err)
+		s.Equal(models.SystemIntakeDelegateRoleVIEWER, saved.Role)
+
+		updated, err := s.store.SaveSystemIntakeDelegate(ctx, &models.SystemIntakeDelegate{
+			SystemIntakeID: intake.ID,
+			EUAUserID:      "ABCD",
+			CommonName:     "Adeline Aarons",
+			Email:          "adeline@example.com",
+			Role:           models.SystemIntakeDelegateRoleCOOWNER,
+		})
+		s.NoError(err)
+		s.Equal(saved.ID, updated.ID)
+		s.Equal(models.SystemIntakeDelegateRoleCOOWNER, updated.Role)
+
+		delegates, err := s.store.FetchSystemIntakeDelegatesByIntakeID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(delegates, 1)
+
+		fetched, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(models.SystemIntakeDelegateRoleCOOWNER, fetched.Delegates.RoleFor("ABCD"))
+
+		shared, err := s.store.FetchSystemIntakesByEuaID(ctx, "ABCD")
+		s.NoError(err)
+		s.Len(shared, 1)
+
+		err = s.store.DeleteSystemIntakeDelegate(ctx, intake.ID, "ABCD")
+		s.NoError(err)
+
+		err = s.store.DeleteSystemIntakeDelegate(ctx, intake.ID, "ABCD")
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("rejects an invalid EUA ID", func() {
+		_, err := s.store.SaveSystemIntakeDelegate(ctx, &models.SystemIntakeDelegate{
+			SystemIntakeID: intake.ID,
+			EUAUserID:      "not an id",
+			Role:           models.SystemIntakeDelegateRoleVIEWER,
+		})
+		s.Error(err)
+	})
+}
@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// selectSystemIntakeDocumentsSQL aggregates the documents of the system intake in the outer query
+const selectSystemIntakeDocumentsSQL = `
+		       coalesce(
+		           (
+		               SELECT json_agg(
+		                   json_build_object(
+		                       'id', documents.id,
+		                       'systemIntakeId', documents.system_intake_id,
+		                       'businessCaseId', documents.business_case_id,
+		                       'fileType', documents.file_type,
+		                       'bucket', documents.bucket,
+		                       'fileKey', documents.file_key,
+		                       'name', documents.file_name,
+		                       'size', documents.file_size,
+		                       'status', CASE
+		                           WHEN documents.virus_scanned AND documents.virus_clean THEN 'AVAILABLE'
+		                           WHEN documents.virus_scanned AND NOT documents.virus_clean THEN 'UNAVAILABLE'
+		                           ELSE 'PENDING'
+		                       END,
+		                       'virusScanned', documents.virus_scanned,
+		                       'virusClean', documents.virus_clean,
+		                       'documentType', documents.document_type,
+		                       'otherType', documents.other_type,
+		                       'createdAt', documents.created_at,
+		                       'updatedAt', documents.updated_at
+		                   ) ORDER BY documents.created_at
+		               )
+		               FROM system_intake_documents documents
+		               WHERE documents.system_intake_id = system_intakes.id AND documents.deleted_at IS NULL
+		           ),
+		           '[]'
+		       ) as documents`
+
+// CreateSystemIntakeDocument stores metadata for a file uploaded to S3 for a system intake
+func (s *Store) CreateSystemIntakeDocument(ctx context.Context, document *models.SystemIntakeDocument) (*models.SystemIntakeDocument, error) {
+	document.ID = uuid.New()
+	createdAt := s.clock.Now()
+	document.CreatedAt = &createdAt
+	document.UpdatedAt = &createdAt
+	const createSystemIntakeDocumentSQL = `
+		INSERT INTO system_intake_documents (
+			id,
+			system_intake_id,
+			business_case_id,
+			file_type,
+			bucket,
+			file_key,
+			file_name,
+			file_size,
+			document_type,
+			other_type,
+			virus_scanned,
+			virus_clean,
+			created_at,
+			updated_at
+		)
+		VALUES (
+			:id,
+			:system_intake_id,
+			:business_case_id,
+			:file_type,
+			:bucket,
+			:file_key,
+			:file_name,
+			:file_size,
+			:document_type,
+			:other_type,
+			:virus_scanned,
+			:virus_clean,
+			:created_at,
+			:updated_at
+		)`
+	_, err := s.db.NamedExec(createSystemIntakeDocumentSQL, document)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to create system intake document with error %s", err),
+			zap.String("intakeID", document.SystemIntakeID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     document,
+			Operation: apperrors.QueryPost,
+		}
+	}
+	return s.FetchSystemIntakeDocumentByID(ctx, document.ID)
+}
+
+// FetchSystemIntakeDocumentByID queries the DB for a system intake document matching the given ID
+func (s *Store) FetchSystemIntakeDocumentByID(ctx context.Context, id uuid.UUID) (*models.SystemIntakeDocument, error) {
+	document := models.SystemIntakeDocument{}
+	err := s.db.Get(&document, `SELECT * FROM system_intake_documents WHERE id=$1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch system intake document %s", err),
+			zap.String("id", id.String()),
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.SystemIntakeDocument{}}
+		}
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     id,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	document.Status = documentStatus(document.VirusScanned, document.VirusClean)
+	return &document, nil
+}
+
+// FetchSystemIntakeDocumentsByIntakeID queries the DB for all documents of a system intake,
+// including those attached to its business case
+func (s *Store) FetchSystemIntakeDocumentsByIntakeID(ctx context.Context, intakeID uuid.UUID) (models.SystemIntakeDocuments, error) {
+	return s.fetchSystemIntakeDocuments(ctx, "system_intake_id", intakeID)
+}
+
+// FetchSystemIntakeDocumentsByBusinessCaseID queries the DB for all documents attached to a business case
+func (s *Store) FetchSystemIntakeDocumentsByBusinessCaseID(ctx context.Context, businessCaseID uuid.UUID) (models.SystemIntakeDocuments, error) {
+	return s.fetchSystemIntakeDocuments(ctx, "business_case_id", businessCaseID)
+}
+
+func (s *Store) fetchSystemIntakeDocuments(ctx context.Context, column string, id uuid.UUID) (models.SystemIntakeDocuments, error) {
+	documents := models.SystemIntakeDocuments{}
+	fetchDocumentsSQL := fmt.Sprintf(`
+		SELECT *
+		FROM system_intake_documents
+		WHERE %s=$1 AND deleted_at IS NULL
+		ORDER BY created_at
+	`, column)
+	err := s.db.Select(&documents, fetchDocumentsSQL, id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch system intake documents %s", err),
+			zap.String(column, id.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntakeDocuments{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	for i := range documents {
+		documents[i].Status = documentStatus(documents[i].VirusScanned, documents[i].VirusClean)
+	}
+	return documents, nil
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestSystemIntakeDocumentRoundtrip() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.SystemIntakeID = intake.ID
+	_, err = s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+
+	s.Run("missing system intake foreign key", func() {
+		_, err := s.store.CreateSystemIntakeDocument(ctx, &models.SystemIntakeDocument{
+			SystemIntakeID: uuid.Nil,
+			FileType:       "application/pdf",
+			Key:            "abc.pdf",
+			Name:           "quote.pdf",
+			DocumentType:   models.SystemIntakeDocumentTypeVendorQuote,
+		})
+		s.Error(err)
+	})
+
+	s.Run("create, read and delete", func() {
+		created, err := s.store.CreateSystemIntakeDocument(ctx, &models.SystemIntakeDocument{
+			SystemIntakeID: intake.ID,
+			FileType:       "application/pdf",
+			Key:            "abc.pdf",
+			Name:           "quote.pdf",
+			Size:           1024,
+			DocumentType:   models.SystemIntakeDocumentTypeVendorQuote,
+		})
+		s.NoError(err)
+		s.NotEqual(uuid.Nil, created.ID)
+		s.Equal(models.AccessibilityRequestDocumentStatusPending, created.Status)
+
+		attached, err := s.store.CreateSystemIntakeDocument(ctx, &models.SystemIntakeDocument{
+			SystemIntakeID: intake.ID,
+			BusinessCaseID: &businessCase.ID,
+			FileType:       "image/png",
+			Key:            "def.png",
+			Name:           "diagram.png",
+			Size:           2048,
+			DocumentType:   models.SystemIntakeDocumentTypeOther,
+			OtherType:      null.StringFrom("Whiteboard photo"),
+			VirusScanned:   null.BoolFrom(true),
+			VirusClean:     null.BoolFrom(true),
+		})
+		s.NoError(err)
+		s.Equal(models.AccessibilityRequestDocumentStatusAvailable, attached.Status)
+
+		fetched, err := s.store.FetchSystemIntakeDocumentsByIntakeID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fetched, 2)
+
+		fetched, err = s.store.FetchSystemIntakeDocumentsByBusinessCaseID(ctx, businessCase.ID)
+		s.NoError(err)
+		s.Len(fetched, 1)
+		s.Equal(attached.ID, fetched[0].ID)
+
+		fetchedIntake, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fetchedIntake.Documents, 2)
+		s.Equal(models.AccessibilityRequestDocumentStatusAvailable, fetchedIntake.Documents[1].Status)
+
+		err = s.store.SoftDeleteSystemIntakeDocument(ctx, created.ID, "ABCD")
+		s.NoError(err)
+
+		_, err = s.store.FetchSystemIntakeDocumentByID(ctx, created.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+
+		fetched, err = s.store.FetchSystemIntakeDocumentsByIntakeID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fetched, 1)
+		s.Equal(attached.ID, fetched[0].ID)
+
+		fetchedIntake, err = s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fetchedIntake.Documents, 1)
+		s.Equal(attached.ID, fetchedIntake.Documents[0].ID)
+
+		var deletedBy string
+		err = s.db.Get(&deletedBy, `SELECT deleted_by FROM system_intake_documents WHERE id=$1`, created.ID)
+		s.NoError(err)
+		s.Equal("ABCD", deletedBy)
+
+		err = s.store.SoftDeleteSystemIntakeDocument(ctx, created.ID, "ABCD")
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
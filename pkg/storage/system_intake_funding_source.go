@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// syncLegacyFundingSourceSQL copies an intake's first funding source into the single
+// funding_source and funding_number columns that CEDAR and the intake form still read
+const syncLegacyFundingSourceSQL = `
+	UPDATE system_intakes
+	SET (funding_source, funding_number) = (
+		SELECT source, funding_number
+		FROM system_intake_funding_sources
+		WHERE system_intake_id = $1
+		ORDER BY created_at
+		LIMIT 1
+	)
+	WHERE id = $1
+`
+
+// CreateSystemIntakeFundingSource adds a funding source to a system intake
+func (s *Store) CreateSystemIntakeFundingSource(ctx context.Context, fundingSource *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+	fundingSource.ID = uuid.New()
+	createAt := s.clock.Now()
+	fundingSource.CreatedAt = &createAt
+	fundingSource.UpdatedAt = &createAt
+	const createFundingSourceSQL = `
+		INSERT INTO system_intake_funding_sources (
+			id,
+			system_intake_id,
+			source,
+			funding_number,
+			amount,
+			fiscal_year,
+			created_at,
+			updated_at
+		)
+		VALUES (
+			:id,
+			:system_intake_id,
+			:source,
+			:funding_number,
+			:amount,
+			:fiscal_year,
+			:created_at,
+			:updated_at
+		)`
+	queryError := func(err error) error {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to create funding source with error %s", err),
+			zap.String("intakeID", fundingSource.SystemIntakeID.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     fundingSource,
+			Operation: apperrors.QueryPost,
+		}
+	}
+
+	tx := s.db.MustBegin()
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+	if _, err := tx.NamedExec(createFundingSourceSQL, fundingSource); err != nil {
+		return nil, queryError(err)
+	}
+	if _, err := tx.Exec(syncLegacyFundingSourceSQL, fundingSource.SystemIntakeID); err != nil {
+		return nil, queryError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, queryError(err)
+	}
+	return s.FetchSystemIntakeFundingSourceByID(ctx, fundingSource.ID)
+}
+
+// UpdateSystemIntakeFundingSource updates a funding source of a system intake
+func (s *Store) UpdateSystemIntakeFundingSource(ctx context.Context, fundingSource *models.SystemIntakeFundingSource) (*models.SystemIntakeFundingSource, error) {
+	// We are explicitly not updating ID and SystemIntakeID
+	const updateFundingSourceSQL = `
+		UPDATE system_intake_funding_sources
+		SET
+			source = :source,
+			funding_number = :funding_number,
+			amount = :amount,
+			fiscal_year = :fiscal_year,
+			updated_at = :updated_at
+		WHERE system_intake_funding_sources.id = :id
+	`
+	queryError := func(err error) error {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to update funding source %s", err),
+			zap.String("id", fundingSource.ID.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     fundingSource,
+			Operation: apperrors.QueryUpdate,
+		}
+	}
+
+	tx := s.db.MustBegin()
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+	_, err := tx.NamedExec(updateFundingSourceSQL, fundingSource)
+	if err != nil {
+		return nil, queryError(err)
+	}
+	if _, err = tx.Exec(syncLegacyFundingSourceSQL, fundingSource.SystemIntakeID); err != nil {
+		return nil, queryError(err)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, queryError(err)
+	}
+	return s.FetchSystemIntakeFundingSourceByID(ctx, fundingSource.ID)
+}
+
+// DeleteSystemIntakeFundingSource removes a funding source from a system intake
+func (s *Store) DeleteSystemIntakeFundingSource(ctx context.Context, id uuid.UUID) error {
+	queryError := func(err error) error {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to delete funding source %s", err),
+			zap.String("id", id.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     id,
+			Operation: apperrors.QuerySave,
+		}
+	}
+
+	tx := s.db.MustBegin()
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+	var intakeID uuid.UUID
+	err := tx.Get(&intakeID, `DELETE FROM system_intake_funding_sources WHERE id=$1 RETURNING system_intake_id`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return queryError(err)
+	}
+	if _, err = tx.Exec(syncLegacyFundingSourceSQL, intakeID); err != nil {
+		return queryError(err)
+	}
+	if err = tx.Commit(); err != nil {
+		return queryError(err)
+	}
+	return nil
+}
+
+// FetchSystemIntakeFundingSourceByID queries the DB for a funding source matching the given ID
+func (s *Store) FetchSystemIntakeFundingSourceByID(ctx context.Context, id uuid.UUID) (*models.SystemIntakeFundingSource, error) {
+	fundingSource := models.SystemIntakeFundingSource{}
+	err := s.db.Get(&fundingSource, `SELECT * FROM system_intake_funding_sources WHERE id=$1`, id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch funding source %s", err),
+			zap.String("id", id.String()),
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.SystemIntakeFundingSource{}}
+		}
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     id,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return &fundingSource, nil
+}
+
+// FetchSystemIntakeFundingSourcesByIntakeID queries the DB for all funding sources of a system intake
+func (s *Store) FetchSystemIntakeFundingSourcesByIntakeID(ctx context.Context, intakeID uuid.UUID) (models.SystemIntakeFundingSources, error) {
+	fundingSources := models.SystemIntakeFundingSources{}
+	const fetchFundingSourcesSQL = `
+		SELECT *
+		FROM system_intake_funding_sources
+		WHERE system_intake_id=$1
+		ORDER BY created_at
+	`
+	err := s.db.Select(&fundingSources, fetchFundingSourcesSQL, intakeID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch funding sources %s", err),
+			zap.String("intakeID", intakeID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntakeFundingSources{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return fundingSources, nil
+}
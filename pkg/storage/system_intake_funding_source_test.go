@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestSystemIntakeFundingSourceRoundtrip() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	s.Run("create error cases", func() {
+		testCases := map[string]*models.SystemIntakeFundingSource{
+			"missing system intake foreign key": {
+				SystemIntakeID: uuid.Nil,
+				FundingNumber:  null.StringFrom("123456"),
+			},
+			"funding number is not 6 digits": {
+				SystemIntakeID: intake.ID,
+				FundingNumber:  null.StringFrom("12345"),
+			},
+		}
+
+		for name, tc := range testCases {
+			s.Run(name, func() {
+				_, err := s.store.CreateSystemIntakeFundingSource(ctx, tc)
+				s.Error(err, name)
+			})
+		}
+	})
+
+	s.Run("create, read, update and delete", func() {
+		amount := 1000
+		fiscalYear := 2021
+		created, err := s.store.CreateSystemIntakeFundingSource(ctx, &models.SystemIntakeFundingSource{
+			SystemIntakeID: intake.ID,
+			Source:         null.StringFrom("CLIA"),
+			FundingNumber:  null.StringFrom("123456"),
+			Amount:         &amount,
+			FiscalYear:     &fiscalYear,
+		})
+		s.NoError(err)
+		s.NotEqual(uuid.Nil, created.ID)
+		s.Equal(null.StringFrom("CLIA"), created.Source)
+		s.Equal(&amount, created.Amount)
+
+		second, err := s.store.CreateSystemIntakeFundingSource(ctx, &models.SystemIntakeFundingSource{
+			SystemIntakeID: intake.ID,
+			Source:         null.StringFrom("Fed Admin"),
+			FundingNumber:  null.StringFrom("654321"),
+		})
+		s.NoError(err)
+
+		fetched, err := s.store.FetchSystemIntakeFundingSourcesByIntakeID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fetched, 2)
+
+		fetchedIntake, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fetchedIntake.FundingSources, 2)
+		s.Equal(null.StringFrom("CLIA"), fetchedIntake.FundingSource)
+		s.Equal(null.StringFrom("123456"), fetchedIntake.FundingNumber)
+
+		created.FundingNumber = null.StringFrom("111111")
+		updated, err := s.store.UpdateSystemIntakeFundingSource(ctx, created)
+		s.NoError(err)
+		s.Equal(null.StringFrom("111111"), updated.FundingNumber)
+
+		fetchedIntake, err = s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(null.StringFrom("111111"), fetchedIntake.FundingNumber)
+
+		err = s.store.DeleteSystemIntakeFundingSource(ctx, created.ID)
+		s.NoError(err)
+
+		fetchedIntake, err = s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(null.StringFrom("Fed Admin"), fetchedIntake.FundingSource)
+		s.Equal(null.StringFrom("654321"), fetchedIntake.FundingNumber)
+
+		err = s.store.DeleteSystemIntakeFundingSource(ctx, second.ID)
+		s.NoError(err)
+
+		_, err = s.store.FetchSystemIntakeFundingSourceByID(ctx, second.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+
+		fetched, err = s.store.FetchSystemIntakeFundingSourcesByIntakeID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(fetched, 0)
+
+		fetchedIntake, err = s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.False(fetchedIntake.FundingSource.Valid)
+		s.False(fetchedIntake.FundingNumber.Valid)
+	})
+
+	s.Run("an intake without funding sources has an empty list", func() {
+		other := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &other)
+		s.NoError(err)
+
+		fetched, err := s.store.FetchSystemIntakeByID(ctx, other.ID)
+		s.NoError(err)
+		s.Len(fetched.FundingSources, 0)
+	})
+}
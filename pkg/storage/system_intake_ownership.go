@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// TransferSystemIntakeOwnership makes someone else the requester of a system intake and its business cases,
+// recording the action along with it. If they were a delegate on the intake, they stop being one.
+func (s *Store) TransferSystemIntakeOwnership(ctx context.Context, intakeID uuid.UUID, newOwner *models.UserInfo, action *models.Action) error {
+	const updateIntakeSQL = `
+		UPDATE system_intakes
+		SET
+			eua_user_id = $2,
+			requester = $3,
+			requester_email_address = $4,
+			updated_at = $5,
+			version = version + 1
+		WHERE id = $1
+	`
+	const updateBusinessCasesSQL = `
+		UPDATE business_cases
+		SET
+			eua_user_id = $2,
+			requester = $3,
+			updated_at = $4,
+			version = version + 1
+		WHERE system_intake = $1
+	`
+	const deleteDelegateSQL = `
+		DELETE FROM system_intake_delegates
+		WHERE system_intake_id = $1 AND eua_user_id = $2
+	`
+
+	logger := appcontext.ZLogger(ctx)
+	queryError := func(err error) error {
+		logger.Error(
+			fmt.Sprintf("Failed to transfer system intake ownership %s", err),
+			zap.String("id", intakeID.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntake{},
+			Operation: apperrors.QuerySave,
+		}
+	}
+	now := s.clock.Now()
+
+	tx := s.db.MustBegin()
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+	result, err := tx.Exec(updateIntakeSQL, intakeID, newOwner.EuaUserID, newOwner.CommonName, newOwner.Email, now)
+	if err != nil {
+		return queryError(err)
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return queryError(err)
+	}
+	if affectedRows == 0 {
+		return &apperrors.ResourceNotFoundError{
+			Err:      fmt.Errorf("no system intake with id %s", intakeID),
+			Resource: models.SystemIntake{},
+		}
+	}
+	if _, err = tx.Exec(updateBusinessCasesSQL, intakeID, newOwner.EuaUserID, newOwner.CommonName, now); err != nil {
+		return queryError(err)
+	}
+	if _, err = tx.Exec(deleteDelegateSQL, intakeID, newOwner.EuaUserID); err != nil {
+		return queryError(err)
+	}
+	action.ID = uuid.New()
+	action.CreatedAt = &now
+	if _, err = tx.NamedExec(createActionSQL, action); err != nil {
+		return queryError(err)
+	}
+	if err = tx.Commit(); err != nil {
+		return queryError(err)
+	}
+	return nil
+}
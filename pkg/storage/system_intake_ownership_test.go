@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestTransferSystemIntakeOwnership() {
+	ctx := context.Background()
+	newOwner := &models.UserInfo{CommonName: "New Owner", Email: "new@example.com", EuaUserID: "NEWW"}
+
+	s.Run("moves the intake, its business case and drops the new owner as a delegate", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.SystemIntakeID = intake.ID
+		createdBusinessCase, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+		_, err = s.store.SaveSystemIntakeDelegate(ctx, &models.SystemIntakeDelegate{
+			SystemIntakeID: intake.ID,
+			EUAUserID:      "NEWW",
+			Role:           models.SystemIntakeDelegateRoleCOOWNER,
+		})
+		s.NoError(err)
+
+		action := &models.Action{
+			IntakeID:       &intake.ID,
+			ActionType:     models.ActionTypeTRANSFEROWNERSHIP,
+			ActorName:      "Reviewer",
+			ActorEmail:     "rev@example.com",
+			ActorEUAUserID: "REV",
+		}
+		err = s.store.TransferSystemIntakeOwnership(ctx, intake.ID, newOwner, action)
+		s.NoError(err)
+
+		actions, err := s.store.GetActionsByRequestID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(actions, 1)
+		s.Equal(models.ActionTypeTRANSFEROWNERSHIP, actions[0].ActionType)
+
+		fetchedIntake, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(null.StringFrom("NEWW"), fetchedIntake.EUAUserID)
+		s.Equal("New Owner", fetchedIntake.Requester)
+		s.Equal(null.StringFrom("new@example.com"), fetchedIntake.RequesterEmailAddress)
+		s.Empty(fetchedIntake.Delegates)
+
+		fetchedBusinessCase, err := s.store.FetchBusinessCaseByID(ctx, createdBusinessCase.ID)
+		s.NoError(err)
+		s.Equal("NEWW", fetchedBusinessCase.EUAUserID)
+		s.Equal(null.StringFrom("New Owner"), fetchedBusinessCase.Requester)
+	})
+
+	s.Run("returns not found for a missing intake", func() {
+		err := s.store.TransferSystemIntakeOwnership(ctx, uuid.New(), newOwner, &models.Action{ActionType: models.ActionTypeTRANSFEROWNERSHIP})
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
@@ -99,6 +99,34 @@ func (s StoreTestSuite) TestUpdateSystemIntake() {
 		s.Equal(intake.ISSO, updated.ISSO)
 	})
 
+	s.Run("update the EUA ID of the business owner but not the resolved collaborators", func() {
+		intake, err := s.store.CreateSystemIntake(ctx, &models.SystemIntake{
+			EUAUserID:   testhelpers.RandomEUAIDNull(),
+			Status:      models.SystemIntakeStatusINTAKEDRAFT,
+			RequestType: models.SystemIntakeRequestTypeNEW,
+			Requester:   "Test requester",
+		})
+		s.NoError(err)
+
+		intake.ISSOName = null.StringFrom("ISSO")
+		intake.ISSOEUAUserID = null.StringFrom("ISSO")
+		intake.ISSOEmail = null.StringFrom("isso@example.com")
+		s.NoError(s.store.UpdateSystemIntakeCollaborators(ctx, intake))
+
+		intake.BusinessOwner = null.StringFrom("Business Owner")
+		intake.BusinessOwnerEUAUserID = null.StringFrom("BOWN")
+		intake.ISSOEUAUserID = null.String{}
+		intake.ISSOEmail = null.StringFrom("elsewhere@example.com")
+		intake.EACollaboratorEUAUserID = null.StringFrom("EACO")
+
+		updated, err := s.store.UpdateSystemIntake(ctx, intake)
+		s.NoError(err)
+		s.Equal("BOWN", updated.BusinessOwnerEUAUserID.String)
+		s.Equal("ISSO", updated.ISSOEUAUserID.String)
+		s.Equal("isso@example.com", updated.ISSOEmail.String)
+		s.False(updated.EACollaboratorEUAUserID.Valid)
+	})
+
 	s.Run("EUA ID will not update", func() {
 		originalIntake := models.SystemIntake{
 			EUAUserID:   testhelpers.RandomEUAIDNull(),
@@ -260,8 +288,9 @@ func (s StoreTestSuite) TestUpdateSystemIntake() {
 
 		s.Equal(processStatus, updated.ProcessStatus.String)
 		s.Equal(existingFunding, updated.ExistingFunding.Bool)
-		s.Equal(fundingNumber, updated.FundingNumber.String)
-		s.Equal(fundingSource, updated.FundingSource.String)
+		// the single funding fields only follow the intake's funding sources
+		s.Equal("", updated.FundingNumber.String)
+		s.Equal("", updated.FundingSource.String)
 		s.Equal(existingContract, updated.ExistingContract.String)
 		s.Equal(contractor, updated.Contractor.String)
 		s.Equal(contractVehicle, updated.ContractVehicle.String)
@@ -598,6 +627,51 @@ func (s StoreTestSuite) TestFetchSystemIntakes() {
 	})
 }
 
+func (s StoreTestSuite) TestFetchSystemIntakesByAlfabetID() {
+	ctx := context.Background()
+	contains := func(intakes models.SystemIntakes, id uuid.UUID) bool {
+		for _, intake := range intakes {
+			if intake.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	submittedAt := time.Now()
+	intake.SubmittedAt = &submittedAt
+	intake.AlfabetID = null.String{}
+	_, err = s.store.UpdateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	s.Run("fetches submitted intakes without an alfabet ID", func() {
+		unlinked, err := s.store.FetchSubmittedSystemIntakesWithoutAlfabetID(ctx)
+		s.NoError(err)
+		s.True(contains(unlinked, intake.ID))
+
+		linked, err := s.store.FetchSystemIntakesWithAlfabetID(ctx)
+		s.NoError(err)
+		s.False(contains(linked, intake.ID))
+	})
+
+	s.Run("fetches intakes with an alfabet ID", func() {
+		intake.AlfabetID = null.StringFrom("000-000-0")
+		_, err := s.store.UpdateSystemIntake(ctx, &intake)
+		s.NoError(err)
+
+		unlinked, err := s.store.FetchSubmittedSystemIntakesWithoutAlfabetID(ctx)
+		s.NoError(err)
+		s.False(contains(unlinked, intake.ID))
+
+		linked, err := s.store.FetchSystemIntakesWithAlfabetID(ctx)
+		s.NoError(err)
+		s.True(contains(linked, intake.ID))
+	})
+}
+
 func (s StoreTestSuite) TestFetchSystemIntakesByFilter() {
 	s.Run("ensure positive and negative cases", func() {
 		ctx := context.Background()
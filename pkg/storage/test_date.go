@@ -57,7 +57,7 @@ func (s *Store) FetchTestDateByID(ctx context.Context, id uuid.UUID) (*models.Te
 	err := s.db.GetContext(ctx, &testDate, `SELECT * FROM test_dates WHERE id=$1 AND deleted_at IS NULL`, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.SystemIntake{}}
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.TestDate{}}
 		}
 		appcontext.ZLogger(ctx).Error("Failed to fetch test date", zap.Error(err), zap.String("id", id.String()))
 		return nil, &apperrors.QueryError{
@@ -85,3 +85,38 @@ func (s *Store) FetchTestDatesByRequestID(ctx context.Context, requestID uuid.UU
 	}
 	return results, nil
 }
+
+// UpdateTestDate updates the date, test type and score of a test date that hasn't been deleted
+func (s *Store) UpdateTestDate(ctx context.Context, testDate *models.TestDate) (*models.TestDate, error) {
+	updatedAt := s.clock.Now()
+	testDate.UpdatedAt = &updatedAt
+	const updateTestDateSQL = `
+		UPDATE test_dates
+		SET
+			test_type = :test_type,
+			date = :date,
+			score = :score,
+			updated_at = :updated_at
+		WHERE id = :id AND deleted_at IS NULL`
+	result, err := s.db.NamedExecContext(ctx, updateTestDateSQL, testDate)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to update test date", zap.Error(err), zap.String("id", testDate.ID.String()))
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     testDate,
+			Operation: apperrors.QueryUpdate,
+		}
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     testDate,
+			Operation: apperrors.QueryUpdate,
+		}
+	}
+	if affectedRows == 0 {
+		return nil, &apperrors.ResourceNotFoundError{Err: sql.ErrNoRows, Resource: models.TestDate{}}
+	}
+	return s.FetchTestDateByID(ctx, testDate.ID)
+}
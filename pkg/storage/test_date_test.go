@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestUpdateAndSoftDeleteTestDate() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	accessibilityRequest, err := s.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
+		Name:     "Test dates",
+		IntakeID: intake.ID,
+	})
+	s.NoError(err)
+
+	s.Run("updates the date, test type and score", func() {
+		score := 500
+		testDate, err := s.store.CreateTestDate(ctx, &models.TestDate{
+			RequestID: accessibilityRequest.ID,
+			TestType:  models.TestDateTestTypeInitial,
+			Date:      time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+			Score:     &score,
+		})
+		s.NoError(err)
+
+		newScore := 1000
+		testDate.TestType = models.TestDateTestTypeRemediation
+		testDate.Score = &newScore
+		updated, err := s.store.UpdateTestDate(ctx, testDate)
+
+		s.NoError(err)
+		s.Equal(models.TestDateTestTypeRemediation, updated.TestType)
+		s.Equal(newScore, *updated.Score)
+		s.Equal(accessibilityRequest.ID, updated.RequestID)
+	})
+
+	s.Run("hides a deleted test date and refuses to update it", func() {
+		testDate, err := s.store.CreateTestDate(ctx, &models.TestDate{
+			RequestID: accessibilityRequest.ID,
+			TestType:  models.TestDateTestTypeInitial,
+			Date:      time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		})
+		s.NoError(err)
+
+		deleted, err := s.store.SoftDeleteTestDate(ctx, testDate.ID, "TEST")
+		s.NoError(err)
+		s.Equal(testDate.ID, deleted.ID)
+		s.NotNil(deleted.DeletedAt)
+		s.Equal("TEST", deleted.DeletedBy.String)
+
+		_, err = s.store.FetchTestDateByID(ctx, testDate.ID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+		testDates, err := s.store.FetchTestDatesByRequestID(ctx, accessibilityRequest.ID)
+		s.NoError(err)
+		for _, fetched := range testDates {
+			s.NotEqual(testDate.ID, fetched.ID)
+		}
+		_, err = s.store.UpdateTestDate(ctx, testDate)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("updating a missing test date is not found", func() {
+		_, err := s.store.UpdateTestDate(ctx, &models.TestDate{
+			ID:       uuid.New(),
+			TestType: models.TestDateTestTypeInitial,
+			Date:     time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		})
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+// Versioned tables have a version column that every update increments.
+// An update with a version of 0 is applied to whatever version is current;
+// otherwise it only applies when the version is still current.
+const versionMatchClause = `(:version = 0 OR version = :version)`
+
+// versionConflict explains why an update to a versioned table changed no rows:
+// either the record doesn't exist, or the update was made to an older version of it
+func versionConflict(ctx context.Context, q sqlx.Queryer, table string, model interface{}, id uuid.UUID, version int) error {
+	var current int
+	err := sqlx.Get(q, &current, fmt.Sprintf(`SELECT version FROM %s WHERE id = $1 AND deleted_at IS NULL`, table), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &apperrors.ResourceNotFoundError{Err: err, Resource: model}
+	}
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch version of %s %s", table, err),
+			zap.String("id", id.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     model,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return &apperrors.ResourceConflictError{
+		Err:            fmt.Errorf("version %d has been replaced by version %d", version, current),
+		Resource:       model,
+		ResourceID:     id.String(),
+		CurrentVersion: current,
+	}
+}
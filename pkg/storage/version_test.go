@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestVersionedUpdates() {
+	ctx := context.Background()
+
+	s.Run("system intake updates bump the version and refuse stale writes", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		created, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(1, created.Version)
+
+		created.ISSO = null.StringFrom("first")
+		updated, err := s.store.UpdateSystemIntake(ctx, created)
+		s.NoError(err)
+		s.Equal(2, updated.Version)
+
+		stale := *updated
+		stale.Version = 1
+		stale.ISSO = null.StringFrom("stale")
+		_, err = s.store.UpdateSystemIntake(ctx, &stale)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+		s.Equal(2, err.(*apperrors.ResourceConflictError).CurrentVersion)
+
+		unconditional := *updated
+		unconditional.Version = 0
+		unconditional.ISSO = null.StringFrom("unconditional")
+		updated, err = s.store.UpdateSystemIntake(ctx, &unconditional)
+		s.NoError(err)
+		s.Equal(3, updated.Version)
+		s.Equal("unconditional", updated.ISSO.String)
+	})
+
+	s.Run("business case updates bump the version and refuse stale writes", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.EUAUserID = intake.EUAUserID.ValueOrZero()
+		businessCase.SystemIntakeID = intake.ID
+		created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+		s.Equal(1, created.Version)
+
+		created.ProjectName = null.StringFrom("first")
+		updated, err := s.store.UpdateBusinessCase(ctx, created)
+		s.NoError(err)
+		s.Equal(2, updated.Version)
+
+		stale := *updated
+		stale.Version = 1
+		_, err = s.store.UpdateBusinessCase(ctx, &stale)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+		s.Equal(2, err.(*apperrors.ResourceConflictError).CurrentVersion)
+
+		fetched, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+		s.NoError(err)
+		s.Equal(2, fetched.Version)
+		s.Equal("first", fetched.ProjectName.String)
+	})
+
+	s.Run("updating a missing business case is not found", func() {
+		missing := testhelpers.NewBusinessCase()
+		missing.Version = 1
+		_, err := s.store.UpdateBusinessCase(ctx, &missing)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("ownership transfers bump the version", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+
+		err = s.store.TransferSystemIntakeOwnership(ctx, intake.ID, &models.UserInfo{
+			EuaUserID:  testhelpers.RandomEUAID(),
+			CommonName: "New Owner",
+			Email:      "new.owner@example.com",
+		}, &models.Action{IntakeID: &intake.ID, ActionType: models.ActionTypeTRANSFEROWNERSHIP})
+		s.NoError(err)
+		fetched, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(2, fetched.Version)
+	})
+}
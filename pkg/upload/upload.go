@@ -1,6 +1,9 @@
 package upload
 
 import (
+	"bytes"
+	"errors"
+	"io/ioutil"
 	"mime"
 	"net/url"
 	"os"
@@ -8,6 +11,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -64,8 +68,13 @@ func NewS3ClientUsingClient(s3Client s3iface.S3API, config Config) S3Client {
 
 // NewPutPresignedURL returns a pre-signed URL used for PUT-ing objects
 func (c S3Client) NewPutPresignedURL(fileType string) (*models.PreSignedURL, error) {
+	return c.NewPutPresignedURLWithPrefix("", fileType)
+}
+
+// NewPutPresignedURLWithPrefix returns a pre-signed URL used for PUT-ing objects under the key prefix
+func (c S3Client) NewPutPresignedURLWithPrefix(prefix string, fileType string) (*models.PreSignedURL, error) {
 	// generate a uuid for file name storage on s3
-	key := uuid.New().String()
+	key := prefix + uuid.New().String()
 
 	// get the file extension from the mime type
 	extensions, err := mime.ExtensionsByType(fileType)
@@ -134,3 +143,37 @@ func (c S3Client) TagValueForKey(key string, tagName string) (string, error) {
 	}
 	return "", nil
 }
+
+// UploadObject stores the content in the bucket under the specified key
+func (c S3Client) UploadObject(key string, contentType string, content []byte) error {
+	_, err := c.client.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(c.config.Bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+		Body:        bytes.NewReader(content),
+	})
+	return err
+}
+
+// DownloadObject returns the content stored in the bucket under the specified key,
+// and whether an object was found for that key
+func (c S3Client) DownloadObject(key string) ([]byte, bool, error) {
+	output, err := c.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(c.config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	defer output.Body.Close()
+
+	content, err := ioutil.ReadAll(output.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	return content, true, nil
+}
@@ -67,6 +67,14 @@ func FundingNumberInvalid(fundingNumber string) bool {
 	return true
 }
 
+// FiscalYearInvalid checks if it's not a four digit year
+func FiscalYearInvalid(fiscalYear int) bool {
+	if fiscalYear >= 1000 && fiscalYear <= 9999 {
+		return false
+	}
+	return true
+}
+
 // RequireCostPhase checks if it's not nil
 func RequireCostPhase(p *models.LifecycleCostPhase) bool {
 	if p == nil {
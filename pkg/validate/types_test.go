@@ -80,6 +80,18 @@ func (s ValidateTestSuite) TestFundingNumberInvalid() {
 	})
 }
 
+func (s ValidateTestSuite) TestFiscalYearInvalid() {
+	s.Run("fiscal year is fewer than 4 digits", func() {
+		s.True(FiscalYearInvalid(21))
+	})
+	s.Run("fiscal year is greater than 4 digits", func() {
+		s.True(FiscalYearInvalid(20210))
+	})
+	s.Run("fiscal year is valid", func() {
+		s.False(FiscalYearInvalid(2021))
+	})
+}
+
 func (s ValidateTestSuite) TestRequireCostPhase() {
 	s.Run("cost phase pointer is nil", func() {
 		var p *models.LifecycleCostPhase
@@ -18,8 +18,8 @@ const BusinessCaseReview = ({ values }: BusinessCaseReviewProps) => {
   return (
     <>
       <PDFExport
-        title="Business Case"
         filename={filename}
+        url={`/business_case/${values.id}/pdf`}
         label="Download Business Case as PDF"
       >
         <div className="grid-container">
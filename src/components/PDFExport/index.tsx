@@ -1,14 +1,12 @@
-import React, { useRef } from 'react';
+import React from 'react';
 import axios from 'axios';
-import { Base64 } from 'js-base64';
 import { useFlags } from 'launchdarkly-react-client-sdk';
-import escape from 'lodash';
 
 import downloadSVG from './download.svg';
 
 type PDFExportProps = {
   filename: string;
-  title: string;
+  url: string;
   children: React.ReactNode;
   label?: string;
 };
@@ -33,16 +31,12 @@ function downloadBlob(filename: string, blob: Blob) {
   document.body.removeChild(link);
 }
 
-function generatePDF(filename: string, content: string) {
+function downloadPDF(filename: string, url: string) {
   axios
     .request({
-      url: `${process.env.REACT_APP_API_ADDRESS}/pdf/generate`,
+      url: `${process.env.REACT_APP_API_ADDRESS}${url}`,
       responseType: 'blob',
-      method: 'POST',
-      data: {
-        html: Base64.encode(content),
-        filename: 'input.html'
-      }
+      method: 'GET'
     })
     .then(response => {
       const blob = new Blob([response.data], { type: 'application/pdf' });
@@ -54,71 +48,20 @@ function generatePDF(filename: string, content: string) {
     });
 }
 
-const downloadRefAsPDF = (
-  title: string,
-  filename: string,
-  ref: React.Ref<HTMLDivElement>
-): void => {
-  // Collect any stylesheets that are linked to. These are used in production.
-  const { origin } = document.location;
-  const stylesheetRequests = Array.prototype.slice
-    .apply(document.styleSheets)
-    .filter(
-      // don't load google fonts stylesheets
-      stylesheet => stylesheet.href && stylesheet.href.startsWith(origin)
-    )
-    .map(stylesheet => axios.get(stylesheet.href));
-
-  // Also grab any inline styles, used predominantly in development.
-  const styleBlocks = Array.prototype.slice
-    .apply(document.querySelectorAll('style'))
-    .map(node => node.innerText);
-
-  // Combine external and inline styles
-  Promise.all(stylesheetRequests)
-    .then(stylesheets => {
-      stylesheets.forEach(response => styleBlocks.push(response.data));
-
-      const markupToRender = `<html lang="en">
-        <head>
-          <title>${escape(title)}</title>
-          <style>
-            ${styleBlocks.join('\n\n')}
-          </style>
-        </head>
-        <body>
-          <h1>
-            ${escape(title)}
-          </h1>
-          ${ref && ref.current && ref.current.outerHTML}
-        </body>
-      </html>`;
-
-      generatePDF(filename, markupToRender);
-    })
-    .catch(e => {
-      console.error(e); // eslint-disable-line
-      // TODO add error handling: display a modal if things fail?
-    });
-};
-
 // PDFExport adds a "Download PDF" button to the screen. When this button is clicked,
-// the HTML content of child elements is sent to the server and converted
-// to PDF format.
-const PDFExport = ({ title, filename, children, label }: PDFExportProps) => {
+// the PDF the server renders of the record at url is downloaded.
+const PDFExport = ({ filename, url, children, label }: PDFExportProps) => {
   const flags = useFlags();
 
-  const divEl = useRef<HTMLDivElement>(null);
-
   return flags.pdfExport ? (
-    <div className="easi-pdf-export" ref={divEl}>
+    <div className="easi-pdf-export">
       {children}
 
       <div className="easi-pdf-export__controls">
         <button
           className="usa-button usa-button--unstyled easi-no-print"
           type="button"
-          onClick={() => downloadRefAsPDF(title, filename, divEl)}
+          onClick={() => downloadPDF(filename, url)}
         >
           <img
             src={downloadSVG}
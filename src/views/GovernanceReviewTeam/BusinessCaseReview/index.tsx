@@ -29,8 +29,8 @@ const BusinessCaseReview = ({ businessCase }: BusinessCaseReviewProps) => {
   return (
     <div>
       <PDFExport
-        title="System Intake"
         filename={filename}
+        url={`/business_case/${businessCase.id}/pdf`}
         label="Download Business Case as PDF"
       >
         <h1 className="margin-top-0">{t('general:businessCase')}</h1>
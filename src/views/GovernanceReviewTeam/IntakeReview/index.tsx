@@ -22,8 +22,8 @@ const IntakeReview = ({ systemIntake, now }: IntakeReviewProps) => {
     <div>
       <h1 className="margin-top-0">{t('general:intake')}</h1>
       <PDFExport
-        title="System Intake"
         filename={filename}
+        url={`/system_intake/${systemIntake.id}/pdf`}
         label="Download System Intake as PDF"
       >
         <SystemIntakeReview systemIntake={systemIntake} now={now} />
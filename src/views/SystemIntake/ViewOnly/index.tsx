@@ -15,8 +15,8 @@ const SystemIntakeView = ({ systemIntake }: SystemIntakeViewOnlyProps) => {
     <>
       <h1>Review your Intake Request</h1>
       <PDFExport
-        title="System Intake"
         filename={filename}
+        url={`/system_intake/${systemIntake.id}/pdf`}
         label="Download System Intake as PDF"
       >
         <SystemIntakeReview